@@ -0,0 +1,124 @@
+//go:build devnet
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	clibuilder "github.com/berachain/beacon-kit/mod/cli/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/db"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/config"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDBCommands runs the db commands against the data directory of a
+// stopped devnet node.
+func TestDBCommands(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	network, cfg := newNetwork(t, 1)
+	require.NoError(t, network.Start(ctx))
+	require.NoError(t, network.WaitForHeight(ctx, 0, 5))
+	require.NoError(t, network.Stop())
+	node, err := network.Node(0)
+	require.NoError(t, err)
+
+	out, err := runDB(ctx, cfg.ChainSpec, node.Home, "verify")
+	require.NoError(t, err)
+	require.Contains(t, out, "State verified")
+
+	out, err = runDB(ctx, cfg.ChainSpec, node.Home, "stats")
+	require.NoError(t, err)
+	require.Contains(t, out, "Deposit store")
+	require.Contains(t, out, "Block store")
+
+	_, err = runDB(ctx, cfg.ChainSpec, node.Home, "get", "balance", "0")
+	require.NoError(t, err)
+	_, err = runDB(ctx, cfg.ChainSpec, node.Home, "get", "payload-header")
+	require.NoError(t, err)
+
+	out, err = runDB(ctx, cfg.ChainSpec, node.Home, "compact")
+	require.NoError(t, err)
+	require.Contains(t, out, "Compacted application")
+	_, err = runDB(ctx, cfg.ChainSpec, node.Home, "verify")
+	require.NoError(t, err)
+
+	// A CometBFT state diverging from the application store is reported.
+	cmtCfg := cmtcfg.DefaultConfig()
+	cmtCfg.SetRoot(node.Home)
+	cmtCfg.DBBackend = "pebbledb"
+	stateDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "state", Config: cmtCfg},
+	)
+	require.NoError(t, err)
+	store := sm.NewStore(stateDB, sm.StoreOptions{})
+	state, err := store.Load()
+	require.NoError(t, err)
+	state.AppHash = bytes.Repeat([]byte{0xff}, len(state.AppHash))
+	require.NoError(t, store.Save(state))
+	require.NoError(t, store.Close())
+
+	_, err = runDB(ctx, cfg.ChainSpec, node.Home, "verify")
+	require.ErrorIs(t, err, db.ErrAppHashMismatch)
+}
+
+// runDB runs a db command against the given home directory, returning its
+// output.
+func runDB(
+	ctx context.Context,
+	chainSpec common.ChainSpec,
+	home string,
+	args ...string,
+) (string, error) {
+	var out bytes.Buffer
+	root := &cobra.Command{
+		Use: "beacond",
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			return config.SetupCommand(
+				cmd,
+				clibuilder.DefaultAppConfigTemplate(),
+				clibuilder.DefaultAppConfig(),
+				clibuilder.DefaultCometConfig(),
+				phuslu.NewLogger(io.Discard, nil),
+			)
+		},
+	}
+	root.PersistentFlags().String(flags.FlagHome, home, "")
+	root.AddCommand(db.Commands(chainSpec))
+	root.SetArgs(append([]string{"db"}, args...))
+	root.SetOut(&out)
+	root.SetErr(&out)
+	err := root.ExecuteContext(ctx)
+	return out.String(), err
+}
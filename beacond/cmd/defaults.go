@@ -44,8 +44,14 @@ func DefaultComponents() []any {
 		components.ProvideBeaconDepositContract[
 			*Deposit, *ExecutionPayload, *ExecutionPayloadHeader,
 		],
+		components.ProvideBlobArchive,
+		components.ProvideBlobArchiveService[
+			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
+			*BeaconBlockHeader, *Logger,
+		],
 		components.ProvideBlockStore[
-			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Logger,
+			*BeaconBlock, *BlindedBeaconBlock, *ExecutionPayloadBodyV1,
+			*Logger,
 		],
 		components.ProvideBlockStoreService[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
@@ -76,10 +82,12 @@ func DefaultComponents() []any {
 		// 	*BeaconStateMarshallable, *BlockStore, *KVStore, *StorageBackend,
 		// ],
 		components.ProvideDAService[
-			*AvailabilityStore, *BeaconBlockBody, *BlobSidecar,
+			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody, *BlobSidecar,
 			*BlobSidecars, *Logger,
 		],
-		components.ProvideDBManager[*AvailabilityStore, *DepositStore, *Logger],
+		components.ProvideDBManager[
+			*AvailabilityStore, *BlockStore, *DepositStore, *Logger,
+		],
 		components.ProvideDepositPruner[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*Deposit, *DepositStore, *Logger,
@@ -89,7 +97,7 @@ func DefaultComponents() []any {
 			*DepositContract, *DepositStore, *ExecutionPayload,
 			*ExecutionPayloadHeader, *Logger,
 		],
-		components.ProvideDepositStore[*Deposit],
+		components.ProvideDepositStore[*Deposit, *Logger],
 		components.ProvideDispatcher[
 			*BeaconBlock, *BlobSidecars, *Genesis, *Logger,
 		],
@@ -99,13 +107,26 @@ func DefaultComponents() []any {
 		components.ProvideExecutionEngine[
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 		],
+		components.ProvideFeeRecipients[*Logger],
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
 		],
+		components.ProvideRelay[
+			*BeaconBlock, *BlindedBeaconBlock, *BlindedBeaconBlockBody,
+			*BuilderBid, *ExecutionPayload, *ExecutionPayloadAndBlobsBundle,
+			*ExecutionPayloadHeader, *Logger, *SignedBlindedBeaconBlock,
+			*SignedBuilderBid, *SignedValidatorRegistration,
+		],
 		components.ProvideReportingService[*Logger],
+		components.ProvideCheckpointSyncer[
+			*BeaconBlockHeader, *BeaconState, *Logger, *StorageBackend,
+		],
 		components.ProvideCometBFTService[*Logger],
+		components.ProvideValidatorResolver[
+			*BeaconBlockHeader, *BeaconState, *StorageBackend,
+		],
 		components.ProvideServiceRegistry[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BlockStore, *BeaconState,
@@ -117,6 +138,8 @@ func DefaultComponents() []any {
 		components.ProvideSidecarFactory[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 		],
+		components.ProvideSlashingProtection,
+		components.ProvideSlashingProtectionService[*BeaconBlock, *Logger],
 		components.ProvideStateProcessor[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*BeaconState, *BeaconStateMarshallable, *Deposit, *ExecutionPayload,
@@ -129,6 +152,7 @@ func DefaultComponents() []any {
 		],
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
+		components.ProvideTracingService,
 		components.ProvideTrustedSetup,
 		components.ProvideValidatorService[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
@@ -147,8 +171,9 @@ func DefaultComponents() []any {
 		components.ProvideNodeAPIBackend[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BlockStore, *BeaconState,
-			*BeaconStateMarshallable, *BlobSidecars, *Deposit, *DepositStore,
-			*ExecutionPayloadHeader, *KVStore, *CometBFTService, *StorageBackend,
+			*BeaconStateMarshallable, *BlobSidecar, *BlobSidecars, *Deposit,
+			*DepositStore, *ExecutionPayloadHeader, *KVStore, *CometBFTService,
+			*StorageBackend,
 		],
 	)
 
@@ -162,13 +187,19 @@ func DefaultComponents() []any {
 		],
 		components.ProvideNodeAPIBuilderHandler[NodeAPIContext],
 		components.ProvideNodeAPIConfigHandler[NodeAPIContext],
-		components.ProvideNodeAPIDebugHandler[NodeAPIContext],
+		components.ProvideNodeAPIDebugHandler[
+			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
+			*ExecutionPayloadHeader, *KVStore, *CometBFTService, NodeAPIContext,
+		],
 		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
 		components.ProvideNodeAPINodeHandler[NodeAPIContext],
 		components.ProvideNodeAPIProofHandler[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayloadHeader, *KVStore, *CometBFTService, NodeAPIContext,
 		],
+		components.ProvideNodeAPIValidatorHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
 	)
 
 	return c
@@ -0,0 +1,238 @@
+//go:build devnet
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	nodebuilder "github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
+	nodecomponents "github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/devnet"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/stretchr/testify/require"
+)
+
+// newNetwork creates a network of the given number of beacond nodes against
+// mock execution clients, logging to files of a temporary directory.
+func newNetwork(t *testing.T, numNodes int) (
+	*devnet.Network[Node, *Logger, *LoggerConfig], devnet.Config[*Logger],
+) {
+	t.Helper()
+
+	// The devnet spec avoids the bArtio specific deposit processing.
+	t.Setenv(
+		nodecomponents.ChainSpecTypeEnvVar, nodecomponents.DevnetChainSpecType,
+	)
+	logDir := t.TempDir()
+	cfg := devnet.DefaultConfig[*Logger]()
+	cfg.Dir = t.TempDir()
+	cfg.NumNodes = numNodes
+	cfg.KZGTrustedSetupPath = "../../testing/files/kzg-trusted-setup.json"
+	cfg.NewLogger = func(moniker string) *Logger {
+		//#nosec:G304 // the path is built by the test.
+		out, err := os.OpenFile(
+			filepath.Join(logDir, moniker+".log"),
+			os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600,
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = out.Close() })
+		return phuslu.NewLogger(out, nil)
+	}
+
+	nb := nodebuilder.New(
+		nodebuilder.WithComponents[Node, *Logger, *LoggerConfig](
+			DefaultComponents(),
+		),
+	)
+	network, err := devnet.New(cfg, nb)
+	require.NoError(t, err)
+	return network, cfg
+}
+
+// TestDevnet runs a network of beacond nodes in-process against mock
+// execution clients, exercising deposits, blobs and node restarts.
+func TestDevnet(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	network, cfg := newNetwork(t, devnet.DefaultConfig[*Logger]().NumNodes)
+	require.NoError(t, network.Start(ctx))
+	defer func() { require.NoError(t, network.Stop()) }()
+	require.NoError(t, network.WaitForHeight(ctx, 0, 3))
+
+	// A new validator joins through a deposit.
+	joinerHome := t.TempDir()
+	cmtcfg.EnsureRoot(joinerHome)
+	joinerCfg := cmtcfg.DefaultConfig()
+	joinerCfg.SetRoot(joinerHome)
+	_, _, err := genutil.InitializeNodeValidatorFiles(
+		joinerCfg, crypto.CometBLSType,
+	)
+	require.NoError(t, err)
+	genesisValidatorsRoot, err := network.GenesisValidatorsRoot(ctx, 0)
+	require.NoError(t, err)
+	deposit, err := devnet.NewDeposit(
+		cfg.ChainSpec, joinerCfg, genesisValidatorsRoot,
+		types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0xbe},
+		),
+		math.Gwei(1e9), 0,
+	)
+	require.NoError(t, err)
+	require.NoError(t, network.SubmitDeposit(deposit))
+
+	// A blob is included and made available.
+	_, err = network.SubmitBlob([]byte("devnet"))
+	require.NoError(t, err)
+
+	// Deposits are processed once the block they are logged in is followed.
+	joiner := math.ValidatorIndex(cfg.NumNodes)
+	require.Eventually(t, func() bool {
+		balances, balancesErr := network.Balances(ctx, 0, "head", joiner)
+		return balancesErr == nil && balances[joiner] == math.Gwei(1e9)
+	}, time.Minute, time.Second)
+
+	// A killed node catches up once restarted.
+	const restarted = 3
+	require.NoError(t, network.Kill(restarted))
+	require.NoError(t, network.AdvanceSlots(ctx, 0, 3))
+	require.NoError(t, network.Restart(ctx, restarted))
+	height, err := network.Height(ctx, 0)
+	require.NoError(t, err)
+	require.NoError(t, network.WaitForHeight(ctx, restarted, height))
+
+	// Every node agrees on the state at that height.
+	slot := devnet.Slot(uint64(height))
+	expected, err := network.StateRoot(ctx, 0, slot)
+	require.NoError(t, err)
+	for i := 1; i < cfg.NumNodes; i++ {
+		var root common.Root
+		root, err = network.StateRoot(ctx, i, slot)
+		require.NoError(t, err)
+		require.Equal(t, expected, root, "node %d", i)
+	}
+
+	// Every committed block is final.
+	checkpoints, err := network.FinalityCheckpoints(ctx, 0, slot)
+	require.NoError(t, err)
+	require.Equal(t, checkpoints.CurrentJustified, checkpoints.Finalized)
+	validatorSet, err := network.ValidatorSet(ctx, 0, slot)
+	require.NoError(t, err)
+	require.EqualValues(t, height, validatorSet.Height)
+	require.NotEmpty(t, validatorSet.AppHash)
+	require.GreaterOrEqual(t, len(validatorSet.Validators), cfg.NumNodes)
+	var committees []*beacontypes.CommitteeData
+	require.NoError(t, network.GetJSON(
+		ctx, 0, "/eth/v1/beacon/states/"+slot+"/committees?slot="+slot,
+		&committees,
+	))
+	require.Len(t, committees, 1)
+	require.Len(t, committees[0].Validators, len(validatorSet.Validators))
+
+	// The proposers of the next epoch are predicted from the CometBFT
+	// validator set.
+	var duties []*validatortypes.ProposerDutyData
+	nextEpoch := cfg.ChainSpec.SlotToEpoch(math.Slot(height)) + 1
+	require.NoError(t, network.GetJSON(
+		ctx, 0, "/eth/v1/validator/duties/proposer/"+nextEpoch.Base10(),
+		&duties,
+	))
+	require.Len(t, duties, int(cfg.ChainSpec.SlotsPerEpoch()))
+	require.True(t, duties[len(duties)-1].Predicted)
+
+	// The finality update proves the root of its header against a signed
+	// CometBFT header.
+	update, err := network.LightClientFinalityUpdate(ctx, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update.Header)
+	require.NotNil(t, update.HeaderProof)
+	headerRoot := update.Header.Beacon.HashTreeRoot()
+	require.Equal(t, headerRoot[:], []byte(update.HeaderProof.Value))
+	require.NotEmpty(t, update.HeaderProof.ProofOps)
+	require.NotEmpty(t, update.SignedHeader)
+	require.NotEmpty(t, update.ValidatorSet)
+
+	// Blocks are only signed from DenebPlus on, which the devnet does not
+	// activate.
+	var header beacontypes.BlockHeaderResponse[*types.BeaconBlockHeader]
+	require.NoError(t, network.GetJSON(
+		ctx, 0, "/eth/v1/beacon/headers/1", &header,
+	))
+	require.Equal(t, crypto.BLSSignature{}, header.Header.Signature)
+
+	// Nor are the historical summaries accumulated before DenebPlus, so a
+	// block that fell out of the block roots window is not provable.
+	require.NoError(t, network.WaitForHeight(
+		ctx, 0, int64(cfg.ChainSpec.SlotsPerHistoricalRoot())+1,
+	))
+	_, err = network.HistoricalBlockRootProof(ctx, 0, "head", 1)
+	require.Error(t, err)
+}
+
+// TestCheckpointSync bootstraps a fresh node from a running network through
+// checkpoint sync, restoring its state from the snapshots of its peers.
+func TestCheckpointSync(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	network, cfg := newNetwork(t, 2)
+	require.NoError(t, network.Start(ctx))
+	defer func() { require.NoError(t, network.Stop()) }()
+	//#nosec:G115 // the snapshot interval is small.
+	require.NoError(t, network.WaitForHeight(
+		ctx, 0, int64(2*cfg.SnapshotInterval),
+	))
+
+	joined, err := network.JoinFromCheckpoint(ctx, 0)
+	require.NoError(t, err)
+	height, err := network.Height(ctx, 0)
+	require.NoError(t, err)
+	require.NoError(t, network.WaitForHeight(ctx, joined, height+2))
+
+	// The joined node agrees on the state of the network at its head.
+	height, err = network.Height(ctx, joined)
+	require.NoError(t, err)
+	require.NoError(t, network.WaitForHeight(ctx, 0, height))
+	slot := devnet.Slot(uint64(height))
+	expected, err := network.StateRoot(ctx, 0, slot)
+	require.NoError(t, err)
+	root, err := network.StateRoot(ctx, joined, slot)
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	// Without replaying the chain from genesis.
+	_, err = network.StateRoot(ctx, joined, devnet.Slot(1))
+	require.Error(t, err)
+}
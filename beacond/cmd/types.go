@@ -28,6 +28,7 @@ import (
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
 	consruntimetypes "github.com/berachain/beacon-kit/mod/consensus/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	"github.com/berachain/beacon-kit/mod/da/pkg/da"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
@@ -46,6 +47,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
@@ -78,6 +80,9 @@ type (
 		*Withdrawal,
 	]
 
+	// BlobArchiveService is a type alias for the blob archive service.
+	BlobArchiveService = archive.Service[*BeaconBlock]
+
 	// BlobProcessor is a type alias for the blob processor.
 	BlobProcessor = dablob.Processor[
 		*AvailabilityStore,
@@ -118,7 +123,9 @@ type (
 	ConsensusMiddleware = cometbft.MiddlewareI
 
 	// DAService is a type alias for the DA service.
-	DAService = da.Service[*AvailabilityStore, *BlobSidecars]
+	DAService = da.Service[
+		*AvailabilityStore, *BeaconBlock, *BeaconBlockBody, *BlobSidecars,
+	]
 
 	// DBManager is a type alias for the database manager.
 	DBManager = manager.DBManager
@@ -175,6 +182,21 @@ type (
 	// NodeAPIServer is a type alias for the node API server.
 	NodeAPIServer = server.Server[NodeAPIContext]
 
+	// Relay is a type alias for the external block builder relay.
+	Relay = relay.Builder[
+		*BeaconBlock,
+		*BlindedBeaconBlock,
+		*BlindedBeaconBlockBody,
+		*BuilderBid,
+		*ExecutionPayload,
+		*ExecutionPayloadAndBlobsBundle,
+		*ExecutionPayloadHeader,
+		*ForkData,
+		*SignedBlindedBeaconBlock,
+		*SignedBuilderBid,
+		*SignedValidatorRegistration,
+	]
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 
@@ -220,6 +242,7 @@ type (
 		*AttestationData,
 		*BeaconBlock,
 		*BeaconBlockBody,
+		*BeaconBlockHeader,
 		*BeaconState,
 		*BlobSidecars,
 		*Deposit,
@@ -249,6 +272,20 @@ type (
 	BeaconBlockBody   = types.BeaconBlockBody
 	BeaconBlockHeader = types.BeaconBlockHeader
 
+	// BlindedBeaconBlock type aliases.
+	BlindedBeaconBlock       = types.BlindedBeaconBlock
+	BlindedBeaconBlockBody   = types.BlindedBeaconBlockBody
+	SignedBlindedBeaconBlock = types.SignedBlindedBeaconBlock
+
+	// BuilderBid type aliases.
+	BuilderBid                     = types.BuilderBid
+	SignedBuilderBid               = types.SignedBuilderBid
+	ExecutionPayloadAndBlobsBundle = types.ExecutionPayloadAndBlobsBundle
+
+	// SignedValidatorRegistration is a type alias for the signed validator
+	// registration.
+	SignedValidatorRegistration = types.SignedValidatorRegistration
+
 	// BeaconState is a type alias for the BeaconState.
 	BeaconState = statedb.StateDB[
 		*BeaconBlockHeader,
@@ -284,7 +321,9 @@ type (
 	BlobSidecars = datypes.BlobSidecars
 
 	// BlockStore is a type alias for the block store.
-	BlockStore = block.KVStore[*BeaconBlock]
+	BlockStore = block.KVStore[
+		*BeaconBlock, *BlindedBeaconBlock, *ExecutionPayloadBodyV1,
+	]
 
 	// Context is a type alias for the transition context.
 	Context = transition.Context
@@ -306,6 +345,7 @@ type (
 
 	// ExecutionPayload type aliases.
 	ExecutionPayload       = types.ExecutionPayload
+	ExecutionPayloadBodyV1 = engineprimitives.ExecutionPayloadBodyV1
 	ExecutionPayloadHeader = types.ExecutionPayloadHeader
 
 	// Fork is a type alias for the fork.
@@ -276,6 +276,12 @@ test-unit-fuzz: ## run fuzz tests
 	go test ./mod/payload/pkg/cache/... -fuzz=FuzzPayloadIDCacheConcurrency -fuzztime=${SHORT_FUZZ_TIME}
 	go test -fuzz=FuzzHashTreeRoot ./mod/primitives/pkg/merkle -fuzztime=${MEDIUM_FUZZ_TIME}
 
+test-devnet: ## run the in-process multi-node devnet tests
+	go test -tags devnet,bls12381,pebbledb ./beacond/cmd/. -run "Devnet|DBCommands" -v
+
+test-spec: ## run the consensus-spec tests, of CONSENSUS_SPEC_TESTS_DIR if set
+	go test -tags conformance ./testing/spec/. -run Conformance -v
+
 test-e2e: ## run e2e tests
 	@$(MAKE) build-docker VERSION=kurtosis-local test-e2e-no-build
 
@@ -192,11 +192,20 @@ func (s *Service[
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subGenDataReceived:
+		case event, ok := <-s.subGenDataReceived:
+			if !ok {
+				return
+			}
 			s.handleGenDataReceived(event)
-		case event := <-s.subBlockReceived:
+		case event, ok := <-s.subBlockReceived:
+			if !ok {
+				return
+			}
 			s.handleBeaconBlockReceived(event)
-		case event := <-s.subFinalBlkReceived:
+		case event, ok := <-s.subFinalBlkReceived:
+			if !ok {
+				return
+			}
 			s.handleBeaconBlockFinalization(event)
 		}
 	}
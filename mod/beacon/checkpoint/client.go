@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// headerPath is the beacon API path serving block headers.
+const headerPath = "/eth/v1/beacon/headers/"
+
+// client downloads checkpoint data from the node API of another node.
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// newClient creates a new client for the node API served at url.
+func newClient(url string, httpClient *http.Client) *client {
+	return &client{
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// blockHeader downloads the block header for the given block ID and decodes
+// its message into header.
+func blockHeader[BeaconBlockHeaderT any](
+	ctx context.Context,
+	c *client,
+	blockID string,
+) (BeaconBlockHeaderT, error) {
+	var resp struct {
+		Data struct {
+			Header struct {
+				Message BeaconBlockHeaderT `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+
+	body, err := c.get(ctx, headerPath+blockID)
+	if err != nil {
+		return resp.Data.Header.Message, err
+	}
+	defer body.Close()
+
+	err = json.NewDecoder(body).Decode(&resp)
+	return resp.Data.Header.Message, err
+}
+
+// get issues a GET request for the given path, returning the JSON response
+// body if the request succeeded.
+func (c *client) get(
+	ctx context.Context,
+	path string,
+) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, c.url+path, http.NoBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		//#nosec:G104 // the status error takes precedence.
+		resp.Body.Close()
+		return nil, errors.Wrapf(
+			ErrUnexpectedStatus, "GET %s: %s", path, resp.Status,
+		)
+	}
+	return resp.Body, nil
+}
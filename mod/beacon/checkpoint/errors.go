@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package checkpoint
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUnexpectedStatus is returned when the checkpoint source responds
+	// with a non-200 status code.
+	ErrUnexpectedStatus = errors.New("unexpected response status")
+	// ErrSlotMismatch is returned when the downloaded block header is not at
+	// the slot of the restored state.
+	ErrSlotMismatch = errors.New("checkpoint state and block slot mismatch")
+	// ErrStateRootMismatch is returned when the hash tree root of the
+	// restored state does not match the state root of the block header.
+	ErrStateRootMismatch = errors.New("checkpoint state root mismatch")
+	// ErrBlockHeaderMismatch is returned when the latest block header of the
+	// restored state does not match the downloaded block header.
+	ErrBlockHeaderMismatch = errors.New("checkpoint block header mismatch")
+)
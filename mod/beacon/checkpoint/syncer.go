@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package checkpoint
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Syncer verifies the beacon state a new node restored from a snapshot
+// against the blocks served by the node API of a trusted node.
+type Syncer[
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconBlockHeaderT],
+] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// client downloads the checkpoint from the trusted node.
+	client *client
+	// storageBackend provides the restored state.
+	storageBackend StorageBackend[BeaconStateT]
+}
+
+// NewSyncer creates a new checkpoint syncer downloading from the node API
+// served at url.
+func NewSyncer[
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconBlockHeaderT],
+](
+	logger log.Logger,
+	url string,
+	httpClient *http.Client,
+	storageBackend StorageBackend[BeaconStateT],
+) *Syncer[BeaconBlockHeaderT, BeaconStateT] {
+	return &Syncer[BeaconBlockHeaderT, BeaconStateT]{
+		logger:         logger,
+		client:         newClient(url, httpClient),
+		storageBackend: storageBackend,
+	}
+}
+
+// Verify downloads the block header at the slot of the beacon state behind
+// ctx and verifies that the state is the post state of that block. It
+// returns the slot of the checkpoint.
+func (s *Syncer[BeaconBlockHeaderT, _]) Verify(
+	ctx context.Context,
+) (math.Slot, error) {
+	st := s.storageBackend.StateFromContext(ctx)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read restored state slot")
+	}
+	latest, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return 0, errors.Wrap(
+			err, "failed to read restored latest block header",
+		)
+	}
+
+	header, err := blockHeader[BeaconBlockHeaderT](
+		ctx, s.client, slot.Base10(),
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to download checkpoint block")
+	}
+
+	if err = verify(header, slot, latest, st.HashTreeRoot()); err != nil {
+		return 0, err
+	}
+
+	s.logger.Info(
+		"Verified beacon state against checkpoint",
+		"slot", slot.Base10(),
+		"state_root", header.GetStateRoot(),
+	)
+	return slot, nil
+}
+
+// verify checks that the restored state, at the given slot with the given
+// latest block header and root, is the post state of the downloaded block
+// header.
+func verify[BeaconBlockHeaderT BeaconBlockHeader](
+	header BeaconBlockHeaderT,
+	slot math.Slot,
+	latest BeaconBlockHeaderT,
+	root common.Root,
+) error {
+	if slot != header.GetSlot() {
+		return errors.Wrapf(
+			ErrSlotMismatch, "state: %d, block: %d", slot, header.GetSlot(),
+		)
+	}
+
+	if root != header.GetStateRoot() {
+		return errors.Wrapf(
+			ErrStateRootMismatch, "state: %s, block: %s",
+			root, header.GetStateRoot(),
+		)
+	}
+
+	// The latest block header in the state is the header of the block with
+	// its state root left empty, as it cannot commit to itself.
+	if latest.GetSlot() != header.GetSlot() ||
+		latest.GetParentBlockRoot() != header.GetParentBlockRoot() ||
+		latest.GetBodyRoot() != header.GetBodyRoot() {
+		return ErrBlockHeaderMismatch
+	}
+	return nil
+}
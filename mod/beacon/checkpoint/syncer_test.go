@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package checkpoint_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/checkpoint"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type syncer = checkpoint.Syncer[*types.BeaconBlockHeader, *beaconState]
+
+func TestSyncer_Verify(t *testing.T) {
+	st := restoredState()
+	srv := serveCheckpoint(t, postStateHeader(st))
+
+	slot, err := newSyncer(srv.URL, st).Verify(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, st.slot, slot)
+}
+
+func TestSyncer_Verify_SlotMismatch(t *testing.T) {
+	st := restoredState()
+	header := postStateHeader(st)
+	header.Slot = st.slot + 1
+	srv := serveCheckpoint(t, header)
+
+	_, err := newSyncer(srv.URL, st).Verify(context.Background())
+	require.ErrorIs(t, err, checkpoint.ErrSlotMismatch)
+}
+
+func TestSyncer_Verify_StateRootMismatch(t *testing.T) {
+	st := restoredState()
+	header := postStateHeader(st)
+	header.StateRoot = common.Root{0xff}
+	srv := serveCheckpoint(t, header)
+
+	_, err := newSyncer(srv.URL, st).Verify(context.Background())
+	require.ErrorIs(t, err, checkpoint.ErrStateRootMismatch)
+}
+
+func TestSyncer_Verify_BlockHeaderMismatch(t *testing.T) {
+	st := restoredState()
+	header := postStateHeader(st)
+	header.BodyRoot = common.Root{0xff}
+	srv := serveCheckpoint(t, header)
+
+	_, err := newSyncer(srv.URL, st).Verify(context.Background())
+	require.ErrorIs(t, err, checkpoint.ErrBlockHeaderMismatch)
+}
+
+func TestSyncer_Verify_UnexpectedStatus(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(srv.Close)
+
+	_, err := newSyncer(srv.URL, restoredState()).Verify(
+		context.Background(),
+	)
+	require.ErrorIs(t, err, checkpoint.ErrUnexpectedStatus)
+}
+
+func newSyncer(url string, st *beaconState) *syncer {
+	return checkpoint.NewSyncer[*types.BeaconBlockHeader, *beaconState](
+		noop.NewLogger[any](), url, http.DefaultClient,
+		&storageBackend{st: st},
+	)
+}
+
+// serveCheckpoint starts a stub node API serving the given block header at
+// its slot.
+func serveCheckpoint(
+	t *testing.T,
+	header *types.BeaconBlockHeader,
+) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"/eth/v1/beacon/headers/42",
+		func(w http.ResponseWriter, _ *http.Request) {
+			resp := map[string]any{
+				"data": map[string]any{
+					"header": map[string]any{"message": header},
+				},
+			}
+			//#nosec:G104 // test server.
+			_ = json.NewEncoder(w).Encode(resp)
+		},
+	)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// postStateHeader returns the header of the block st is the post state of.
+func postStateHeader(st *beaconState) *types.BeaconBlockHeader {
+	header := *st.latestBlockHeader
+	header.StateRoot = st.root
+	return &header
+}
+
+func restoredState() *beaconState {
+	return &beaconState{
+		slot: 42,
+		latestBlockHeader: &types.BeaconBlockHeader{
+			Slot:            42,
+			ProposerIndex:   1,
+			ParentBlockRoot: common.Root{0x02},
+			BodyRoot:        common.Root{0x03},
+		},
+		root: common.Root{0x04},
+	}
+}
+
+type storageBackend struct {
+	st *beaconState
+}
+
+func (b *storageBackend) StateFromContext(context.Context) *beaconState {
+	return b.st
+}
+
+// beaconState is the beacon state restored from a snapshot.
+type beaconState struct {
+	slot              math.Slot
+	latestBlockHeader *types.BeaconBlockHeader
+	root              common.Root
+}
+
+func (s *beaconState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *beaconState) GetLatestBlockHeader() (
+	*types.BeaconBlockHeader, error,
+) {
+	return s.latestBlockHeader, nil
+}
+
+func (s *beaconState) HashTreeRoot() common.Root {
+	return s.root
+}
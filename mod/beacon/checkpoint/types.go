@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package checkpoint
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlockHeader is the interface for a beacon block header.
+type BeaconBlockHeader interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetParentBlockRoot returns the root of the parent block.
+	GetParentBlockRoot() common.Root
+	// GetStateRoot returns the post state root of the block.
+	GetStateRoot() common.Root
+	// GetBodyRoot returns the root of the block body.
+	GetBodyRoot() common.Root
+}
+
+// BeaconState is the interface for the beacon state restored from a
+// snapshot, which is verified against the checkpoint.
+type BeaconState[BeaconBlockHeaderT any] interface {
+	// GetSlot returns the slot of the beacon state.
+	GetSlot() (math.Slot, error)
+	// GetLatestBlockHeader returns the header of the latest block processed
+	// into the beacon state.
+	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
+	// HashTreeRoot returns the hash tree root of the beacon state.
+	HashTreeRoot() common.Root
+}
+
+// StorageBackend is the interface for the storage backend holding the
+// restored beacon state.
+type StorageBackend[BeaconStateT any] interface {
+	// StateFromContext retrieves the beacon state from the given context.
+	StateFromContext(ctx context.Context) BeaconStateT
+}
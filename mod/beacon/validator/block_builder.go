@@ -27,6 +27,7 @@ import (
 
 	payloadtime "github.com/berachain/beacon-kit/mod/beacon/payload-time"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -38,7 +39,8 @@ import (
 
 // buildBlockAndSidecars builds a new beacon block.
 func (s *Service[
-	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+	_, BeaconBlockT, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _,
+	SlotDataT,
 ]) buildBlockAndSidecars(
 	ctx context.Context,
 	slotData SlotDataT,
@@ -83,6 +85,10 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
+	// Request a bid from the external builder while the local payload is
+	// retrieved, so that the local block can be compared against it.
+	bids := s.requestExternalBid(ctx, st, blk)
+
 	// Get the payload for the block.
 	envelope, err := s.retrieveExecutionPayload(ctx, st, blk)
 	if err != nil {
@@ -122,6 +128,37 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
+	// Propose the payload of the external builder if it outbids the local
+	// payload, falling back to the fully built local block unless the
+	// blinded block was already signed.
+	if bid := <-bids; bid != nil &&
+		s.prefersExternalBid(blk, envelope, bid) {
+		var externalSidecars BlobSidecarsT
+		externalSidecars, err = s.useExternalPayload(
+			ctx, st, blk, envelope, bid,
+		)
+		switch {
+		case errors.Is(err, ErrCommittedToExternalPayload):
+			s.metrics.failedToUseExternalPayload(blk.GetSlot(), err)
+			return blk, sidecars, err
+		case err != nil:
+			s.metrics.failedToUseExternalPayload(blk.GetSlot(), err)
+			s.logger.Warn(
+				"Failed to use external payload, falling back to local payload",
+				"slot", slotData.GetSlot().Base10(),
+				"error", err,
+			)
+		default:
+			s.metrics.markExternalPayloadUsed(blk.GetSlot())
+			sidecars = externalSidecars
+		}
+	}
+
+	// Sign the block as its proposer now that its contents are final.
+	if err = s.signBlock(st, blk); err != nil {
+		return blk, sidecars, err
+	}
+
 	s.logger.Info(
 		"Beacon block successfully built",
 		"slot", slotData.GetSlot().Base10(),
@@ -134,7 +171,7 @@ func (s *Service[
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) getEmptyBeaconBlockForSlot(
 	st BeaconStateT, requestedSlot math.Slot,
 ) (BeaconBlockT, error) {
@@ -166,7 +203,7 @@ func (s *Service[
 
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
 ]) buildRandaoReveal(
 	st BeaconStateT,
 	slot math.Slot,
@@ -189,19 +226,57 @@ func (s *Service[
 		s.chainSpec.DomainTypeRandao(),
 		epoch,
 	)
-	return s.signer.Sign(signingRoot[:])
+	return crypto.SignAt(
+		s.signer, s.chainSpec.DomainTypeRandao(), slot, signingRoot[:],
+	)
+}
+
+// signBlock signs the block under the proposer domain, guarded by the
+// slashing protection of the signer if any. Blocks are only signed from
+// DenebPlus on, earlier forks exchange them without a signature.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+]) signBlock(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	var forkData ForkDataT
+
+	forkVersion := s.chainSpec.ActiveForkVersionForSlot(blk.GetSlot())
+	if forkVersion < version.DenebPlus {
+		return nil
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	signingRoot := forkData.New(
+		version.FromUint32[common.Version](forkVersion),
+		genesisValidatorsRoot,
+	).ComputeBlockSigningRoot(
+		s.chainSpec.DomainTypeProposer(),
+		blk.HashTreeRoot(),
+	)
+	signature, err := crypto.SignAt(
+		s.signer, s.chainSpec.DomainTypeProposer(), blk.GetSlot(),
+		signingRoot[:],
+	)
+	if err != nil {
+		return err
+	}
+	blk.SetSignature(signature)
+	return nil
 }
 
 // retrieveExecutionPayload retrieves the execution payload for the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
-	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, ExecutionPayloadT,
+	ExecutionPayloadHeaderT, _, _, _,
 ]) retrieveExecutionPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
 	// Get the payload for the block.
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
@@ -246,8 +321,8 @@ func (s *Service[
 
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, Eth1DataT, ExecutionPayloadT, _,
-	_, _, SlotDataT,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, Eth1DataT, ExecutionPayloadT,
+	_, _, _, SlotDataT,
 ]) buildBlockBody(
 	_ context.Context,
 	st BeaconStateT,
@@ -327,7 +402,7 @@ func (s *Service[
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) computeAndSetStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -348,7 +423,7 @@ func (s *Service[
 
 // computeStateRoot computes the state root of an outgoing block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) computeStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -366,6 +441,8 @@ func (s *Service[
 			SkipPayloadVerification: true,
 			SkipValidateResult:      true,
 			SkipValidateRandao:      true,
+			// The block is signed once its state root is set.
+			SkipValidateProposerSignature: true,
 		},
 		st, blk,
 	); err != nil {
@@ -27,6 +27,14 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// defaultProposalCompression is the default compression used for the
+	// beacon block and blob sidecars txs in a proposal.
+	defaultProposalCompression = "snappy"
+
+	// defaultSlashingProtectionPath is the default path of the slashing
+	// protection database.
+	defaultSlashingProtectionPath = "data/slashing_protection.json"
 )
 
 // Config is the validator configuration.
@@ -39,6 +47,16 @@ type Config struct {
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// ProposalCompression is the compression used for the beacon block and
+	// blob sidecars txs in a proposal once they are enveloped. One of "none",
+	// "snappy" or "zstd".
+	ProposalCompression string `mapstructure:"proposal-compression"`
+
+	// SlashingProtectionPath is the path of the slashing protection
+	// database, relative to the home directory unless absolute. Empty
+	// disables slashing protection.
+	SlashingProtectionPath string `mapstructure:"slashing-protection-path"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -46,5 +64,7 @@ func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		ProposalCompression:           defaultProposalCompression,
+		SlashingProtectionPath:        defaultSlashingProtectionPath,
 	}
 }
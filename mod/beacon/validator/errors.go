@@ -37,4 +37,18 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrBidAttributesMismatch is an error for when the payload offered by an
+	// external builder was not built with the attributes of the local
+	// payload.
+	ErrBidAttributesMismatch = errors.New(
+		"bid payload attributes do not match the local payload",
+	)
+
+	// ErrCommittedToExternalPayload is an error for when the external builder
+	// fails to reveal its payload after the blinded block was signed, which
+	// binds the proposer to the bid.
+	ErrCommittedToExternalPayload = errors.New(
+		"committed to an external payload that was not revealed",
+	)
 )
@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// externalBid is a bid of an external builder for the payload of a block.
+type externalBid[ExecutionPayloadHeaderT any] struct {
+	// header is the header of the offered payload.
+	header ExecutionPayloadHeaderT
+	// commitments are the commitments to the blobs of the offered payload.
+	commitments eip4844.KZGCommitments[common.ExecutionHash]
+	// value is the value of the offered payload in Wei.
+	value *math.U256
+}
+
+// requestExternalBid requests a bid from the external builder for the
+// payload of the given block. The returned channel yields the bid, or nil if
+// no valid bid was received in time.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
+	ExecutionPayloadHeaderT, _, _, _,
+]) requestExternalBid(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) <-chan *externalBid[ExecutionPayloadHeaderT] {
+	bids := make(chan *externalBid[ExecutionPayloadHeaderT], 1)
+	if s.externalPayloadBuilder == nil ||
+		!s.externalPayloadBuilder.Enabled() {
+		close(bids)
+		return bids
+	}
+
+	// The latest execution payload header is the one of the parent block
+	// until the block being built is processed.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		close(bids)
+		return bids
+	}
+
+	go func() {
+		defer close(bids)
+		header, commitments, value, bidErr := s.externalPayloadBuilder.
+			GetHeader(ctx, blk.GetSlot(), lph.GetBlockHash())
+		if bidErr != nil {
+			s.logger.Warn(
+				"No bid received from external builder",
+				"slot", blk.GetSlot().Base10(),
+				"error", bidErr,
+			)
+			return
+		}
+		bids <- &externalBid[ExecutionPayloadHeaderT]{
+			header:      header,
+			commitments: commitments,
+			value:       value,
+		}
+	}()
+	return bids
+}
+
+// prefersExternalBid returns true if the bid of the external builder should
+// be proposed instead of the local payload.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, ExecutionPayloadT,
+	ExecutionPayloadHeaderT, _, _, _,
+]) prefersExternalBid(
+	blk BeaconBlockT,
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	bid *externalBid[ExecutionPayloadHeaderT],
+) bool {
+	if envelope.ShouldOverrideBuilder() {
+		s.logger.Info(
+			"Execution client requested to propose the local payload",
+			"slot", blk.GetSlot().Base10(),
+		)
+		return false
+	}
+
+	if localValue := envelope.GetValue(); localValue != nil &&
+		bid.value.Cmp(localValue) <= 0 {
+		s.logger.Info(
+			"Local payload outbids the external builder",
+			"slot", blk.GetSlot().Base10(),
+			"local_value", localValue.Dec(),
+			"bid_value", bid.value.Dec(),
+		)
+		return false
+	}
+	return true
+}
+
+// useExternalPayload replaces the local payload of the fully built block
+// with the payload of the external builder. The block is committed to the
+// header of the bid and revealed by the builder in exchange for the signed
+// blinded block. If it fails before the blinded block is signed, the block
+// and the state are reverted to the local payload, so that the block can
+// still be proposed. Once the blinded block is signed, the proposer is bound
+// to the bid and any failure is wrapped in ErrCommittedToExternalPayload, as
+// falling back would sign a second block for the slot.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT,
+	_, _, _, ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
+]) useExternalPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	bid *externalBid[ExecutionPayloadHeaderT],
+) (BlobSidecarsT, error) {
+	var (
+		sidecars       BlobSidecarsT
+		body           = blk.GetBody()
+		localStateRoot = blk.GetStateRoot()
+	)
+
+	// The state has been transitioned with the local payload, hence its
+	// latest execution payload header is the one of the local payload.
+	localHeader, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return sidecars, err
+	}
+	if err = verifyBidAttributes(localHeader, bid.header); err != nil {
+		return sidecars, err
+	}
+
+	localBlockHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return sidecars, err
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return sidecars, err
+	}
+
+	body.SetBlobKzgCommitments(bid.commitments)
+	if err = s.commitToExternalPayload(
+		st, blk, localBlockHeader, bid.header,
+	); err != nil {
+		body.SetBlobKzgCommitments(envelope.GetBlobsBundle().GetCommitments())
+		blk.SetStateRoot(localStateRoot)
+		return sidecars, errors.Join(
+			err,
+			st.SetLatestExecutionPayloadHeader(localHeader),
+			st.SetLatestBlockHeader(localBlockHeader),
+		)
+	}
+
+	// The blinded block may be signed even if the submission fails, e.g. if
+	// the relay does not answer in time.
+	payload, blobsBundle, err := s.externalPayloadBuilder.SubmitBlindedBlock(
+		ctx, blk, bid.header, genesisValidatorsRoot,
+	)
+	if err != nil {
+		return sidecars, errors.Join(ErrCommittedToExternalPayload, err)
+	}
+	body.SetExecutionPayload(payload)
+	if sidecars, err = s.blobFactory.BuildSidecars(
+		blk, blobsBundle,
+	); err != nil {
+		return sidecars, errors.Join(ErrCommittedToExternalPayload, err)
+	}
+	return sidecars, nil
+}
+
+// commitToExternalPayload updates the post state of the block, computed
+// with the local payload, as if the block carried the payload of the given
+// header and sets the resulting state root on the block. This holds since
+// the payloads share their withdrawals, which are the only other effect of
+// the payload on the state.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT,
+	_, _, _, _, _, ExecutionPayloadHeaderT, _, _, _,
+]) commitToExternalPayload(
+	st BeaconStateT,
+	blk BeaconBlockT,
+	localBlockHeader BeaconBlockHeaderT,
+	header ExecutionPayloadHeaderT,
+) error {
+	if err := st.SetLatestExecutionPayloadHeader(header); err != nil {
+		return err
+	}
+
+	var lbh BeaconBlockHeaderT
+	if err := st.SetLatestBlockHeader(
+		lbh.New(
+			localBlockHeader.GetSlot(),
+			localBlockHeader.GetProposerIndex(),
+			localBlockHeader.GetParentBlockRoot(),
+			localBlockHeader.GetStateRoot(),
+			blk.GetBody().BlindedHashTreeRoot(header),
+		),
+	); err != nil {
+		return err
+	}
+
+	blk.SetStateRoot(st.HashTreeRoot())
+	return nil
+}
+
+// verifyBidAttributes verifies that the payload of the bid was built with the
+// same attributes as the local payload.
+func verifyBidAttributes[ExecutionPayloadHeaderT ExecutionPayloadHeader](
+	localHeader, bidHeader ExecutionPayloadHeaderT,
+) error {
+	switch {
+	case localHeader.GetParentHash() != bidHeader.GetParentHash():
+		return errors.Wrapf(
+			ErrBidAttributesMismatch, "parent hash expected %s, got %s",
+			localHeader.GetParentHash(), bidHeader.GetParentHash(),
+		)
+	case localHeader.GetTimestamp() != bidHeader.GetTimestamp():
+		return errors.Wrapf(
+			ErrBidAttributesMismatch, "timestamp expected %d, got %d",
+			localHeader.GetTimestamp(), bidHeader.GetTimestamp(),
+		)
+	case localHeader.GetPrevRandao() != bidHeader.GetPrevRandao():
+		return errors.Wrapf(
+			ErrBidAttributesMismatch, "prev randao expected %s, got %s",
+			localHeader.GetPrevRandao(), bidHeader.GetPrevRandao(),
+		)
+	case localHeader.GetWithdrawalsRoot() != bidHeader.GetWithdrawalsRoot():
+		return errors.Wrapf(
+			ErrBidAttributesMismatch, "withdrawals root expected %s, got %s",
+			localHeader.GetWithdrawalsRoot(), bidHeader.GetWithdrawalsRoot(),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// testState is a beacon state holding only the fields touched when
+// switching to an external payload.
+type testState struct {
+	blockHeader   *types.BeaconBlockHeader
+	payloadHeader *types.ExecutionPayloadHeader
+}
+
+func (*testState) GetBlockRootAtIndex(uint64) (common.Root, error) {
+	return common.Root{}, nil
+}
+
+func (s *testState) GetLatestBlockHeader() (*types.BeaconBlockHeader, error) {
+	return s.blockHeader, nil
+}
+
+func (s *testState) SetLatestBlockHeader(h *types.BeaconBlockHeader) error {
+	s.blockHeader = h
+	return nil
+}
+
+func (s *testState) GetLatestExecutionPayloadHeader() (
+	*types.ExecutionPayloadHeader, error,
+) {
+	return s.payloadHeader, nil
+}
+
+func (s *testState) SetLatestExecutionPayloadHeader(
+	h *types.ExecutionPayloadHeader,
+) error {
+	s.payloadHeader = h
+	return nil
+}
+
+func (*testState) GetSlot() (math.Slot, error) { return 1, nil }
+
+func (s *testState) HashTreeRoot() common.Root {
+	return s.payloadHeader.HashTreeRoot()
+}
+
+func (*testState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+
+func (*testState) GetEth1DepositIndex() (uint64, error) { return 0, nil }
+
+func (*testState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return common.Root{}, nil
+}
+
+// testExternalBuilder is an external builder whose blinded block submission
+// fails with a fixed error.
+type testExternalBuilder struct {
+	submitErr error
+	submitted int
+}
+
+func (*testExternalBuilder) Enabled() bool { return true }
+
+func (*testExternalBuilder) GetHeader(
+	context.Context, math.Slot, common.ExecutionHash,
+) (
+	*types.ExecutionPayloadHeader,
+	eip4844.KZGCommitments[common.ExecutionHash],
+	*math.U256,
+	error,
+) {
+	return nil, nil, nil, nil
+}
+
+func (b *testExternalBuilder) SubmitBlindedBlock(
+	context.Context,
+	*types.BeaconBlock,
+	*types.ExecutionPayloadHeader,
+	common.Root,
+) (*types.ExecutionPayload, engineprimitives.BlobsBundle, error) {
+	b.submitted++
+	return nil, nil, b.submitErr
+}
+
+// testSlotData is the slot data of the block being built.
+type testSlotData struct{}
+
+func (testSlotData) GetSlot() math.Slot { return 1 }
+
+func (testSlotData) GetAttestationData() []*types.AttestationData {
+	return nil
+}
+
+func (testSlotData) GetSlashingInfo() []*types.SlashingInfo { return nil }
+
+// testDepositStore is a deposit store without deposits.
+type testDepositStore struct{}
+
+func (testDepositStore) GetDepositsByIndex(
+	uint64, uint64,
+) ([]*types.Deposit, error) {
+	return nil, nil
+}
+
+type testService = Service[
+	*types.AttestationData, *types.BeaconBlock, *types.BeaconBlockBody,
+	*types.BeaconBlockHeader, *testState, any, *types.Deposit,
+	testDepositStore, *types.Eth1Data, *types.ExecutionPayload,
+	*types.ExecutionPayloadHeader, *types.ForkData, *types.SlashingInfo,
+	testSlotData,
+]
+
+// newExternalPayloadTest returns a block built with a local payload, its
+// post state and the envelope of the local payload.
+func newExternalPayloadTest() (
+	*types.BeaconBlock,
+	*testState,
+	engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload],
+) {
+	body := (&types.BeaconBlockBody{}).Empty(version.Deneb)
+	body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	blk := &types.BeaconBlock{
+		Slot:      1,
+		Body:      body,
+		StateRoot: common.Root{0x01},
+	}
+	st := &testState{
+		blockHeader: &types.BeaconBlockHeader{Slot: 1},
+		payloadHeader: &types.ExecutionPayloadHeader{
+			ParentHash:    common.ExecutionHash{0x02},
+			Timestamp:     10,
+			BaseFeePerGas: math.NewU256(0),
+			BlockHash:     common.ExecutionHash{0x03},
+		},
+	}
+	envelope := &engineprimitives.ExecutionPayloadEnvelope[
+		*types.ExecutionPayload, *engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: body.ExecutionPayload,
+		BlobsBundle: &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{},
+	}
+	return blk, st, envelope
+}
+
+func TestUseExternalPayload_TimeoutAfterSigning(t *testing.T) {
+	blk, st, envelope := newExternalPayloadTest()
+	builder := &testExternalBuilder{submitErr: context.DeadlineExceeded}
+	s := &testService{externalPayloadBuilder: builder}
+
+	bidHeader := *st.payloadHeader
+	bidHeader.BlockHash = common.ExecutionHash{0x04}
+	_, err := s.useExternalPayload(
+		context.Background(), st, blk, envelope,
+		&externalBid[*types.ExecutionPayloadHeader]{
+			header: &bidHeader,
+			value:  math.NewU256(1),
+		},
+	)
+
+	// The proposer is bound to the bid once the blinded block is signed, so
+	// the block must not fall back to the local payload.
+	require.ErrorIs(t, err, ErrCommittedToExternalPayload)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 1, builder.submitted)
+	require.Equal(t, &bidHeader, st.payloadHeader)
+	require.NotEqual(t, common.Root{0x01}, blk.GetStateRoot())
+}
+
+func TestUseExternalPayload_FallbackBeforeSigning(t *testing.T) {
+	blk, st, envelope := newExternalPayloadTest()
+	localHeader := st.payloadHeader
+	builder := &testExternalBuilder{}
+	s := &testService{externalPayloadBuilder: builder}
+
+	bidHeader := *st.payloadHeader
+	bidHeader.Timestamp++
+	_, err := s.useExternalPayload(
+		context.Background(), st, blk, envelope,
+		&externalBid[*types.ExecutionPayloadHeader]{
+			header: &bidHeader,
+			value:  math.NewU256(1),
+		},
+	)
+
+	// A bid rejected before signing leaves the local block untouched.
+	require.ErrorIs(t, err, ErrBidAttributesMismatch)
+	require.NotErrorIs(t, err, ErrCommittedToExternalPayload)
+	require.Zero(t, builder.submitted)
+	require.Equal(t, localHeader, st.payloadHeader)
+	require.Equal(t, common.Root{0x01}, blk.GetStateRoot())
+}
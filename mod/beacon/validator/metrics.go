@@ -74,3 +74,28 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// markExternalPayloadUsed increments the counter for the number of times
+// the validator proposed the payload of an external builder.
+func (cm *validatorMetrics) markExternalPayloadUsed(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.external_payload_used",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+// failedToUseExternalPayload increments the counter for the number of times
+// the validator fell back to the local payload after preferring the bid of an
+// external builder.
+func (cm *validatorMetrics) failedToUseExternalPayload(
+	slot math.Slot, err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.failed_to_use_external_payload",
+		"slot",
+		slot.Base10(),
+		"error",
+		err.Error(),
+	)
+}
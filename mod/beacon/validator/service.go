@@ -36,9 +36,11 @@ type Service[
 	AttestationDataT any,
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT, SlashingInfoT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[BeaconBlockHeaderT, ExecutionPayloadHeaderT],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
@@ -78,6 +80,12 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// externalPayloadBuilder represents a builder outside of this node that
+	// bids for the payload of the blocks it proposes. The local payload is
+	// used whenever it fails to deliver a better payload in time.
+	externalPayloadBuilder ExternalPayloadBuilder[
+		BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	]
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 	// subNewSlot is a channel to hold NewSlot events.
@@ -89,9 +97,11 @@ func NewService[
 	AttestationDataT any,
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT, SlashingInfoT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[BeaconBlockHeaderT, ExecutionPayloadHeaderT],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
@@ -116,37 +126,42 @@ func NewService[
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	externalPayloadBuilder ExternalPayloadBuilder[
+		BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	],
 	ts TelemetrySink,
 	dispatcher asynctypes.EventDispatcher,
 ) *Service[
-	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
-	BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT,
+	SlotDataT,
 ] {
 	return &Service[
-		AttestationDataT, BeaconBlockT, BeaconBlockBodyT,
+		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT,
 		SlotDataT,
 	]{
-		cfg:                   cfg,
-		logger:                logger,
-		sb:                    sb,
-		chainSpec:             chainSpec,
-		signer:                signer,
-		stateProcessor:        stateProcessor,
-		blobFactory:           blobFactory,
-		localPayloadBuilder:   localPayloadBuilder,
-		remotePayloadBuilders: remotePayloadBuilders,
-		metrics:               newValidatorMetrics(ts),
-		dispatcher:            dispatcher,
-		subNewSlot:            make(chan async.Event[SlotDataT]),
+		cfg:                    cfg,
+		logger:                 logger,
+		sb:                     sb,
+		chainSpec:              chainSpec,
+		signer:                 signer,
+		stateProcessor:         stateProcessor,
+		blobFactory:            blobFactory,
+		localPayloadBuilder:    localPayloadBuilder,
+		remotePayloadBuilders:  remotePayloadBuilders,
+		externalPayloadBuilder: externalPayloadBuilder,
+		metrics:                newValidatorMetrics(ts),
+		dispatcher:             dispatcher,
+		subNewSlot:             make(chan async.Event[SlotDataT]),
 	}
 }
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "validator"
 }
@@ -154,7 +169,7 @@ func (s *Service[
 // Start listens for NewSlot events and builds a block and sidecars for the
 // requested slot data.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(
 	ctx context.Context,
 ) error {
@@ -169,14 +184,19 @@ func (s *Service[
 }
 
 // eventLoop is the main event loop for the validator service.
-func (s *Service[_, _, _, _, _, _, _, _, _, _, _, _, _]) eventLoop(
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) eventLoop(
 	ctx context.Context,
 ) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subNewSlot:
+		case event, ok := <-s.subNewSlot:
+			if !ok {
+				return
+			}
 			s.handleNewSlot(event)
 		}
 	}
@@ -186,7 +206,8 @@ func (s *Service[_, _, _, _, _, _, _, _, _, _, _, _, _]) eventLoop(
 // emits BuiltBeaconBlock and BuiltSidecars events containing the built block
 // and sidecars.
 func (s *Service[
-	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+	_, BeaconBlockT, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _,
+	SlotDataT,
 ]) handleNewSlot(req async.Event[SlotDataT]) {
 	var (
 		blk      BeaconBlockT
@@ -56,14 +56,22 @@ type BeaconBlock[
 	GetStateRoot() common.Root
 	// GetBody returns the body of the beacon block.
 	GetBody() BeaconBlockBodyT
+	// HashTreeRoot returns the hash tree root of the beacon block.
+	HashTreeRoot() common.Root
+	// SetSignature sets the proposer's signature over the beacon block.
+	SetSignature(crypto.BLSSignature)
 }
 
 // BeaconBlockBody represents a beacon block body interface.
 type BeaconBlockBody[
-	AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT any,
+	AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+	ExecutionPayloadHeaderT, SlashingInfoT any,
 ] interface {
 	constraints.SSZMarshallable
 	constraints.Nillable
+	// BlindedHashTreeRoot returns the hash tree root of the beacon block body
+	// with its execution payload replaced by the given header.
+	BlindedHashTreeRoot(ExecutionPayloadHeaderT) common.Root
 	// SetRandaoReveal sets the Randao reveal of the beacon block body.
 	SetRandaoReveal(crypto.BLSSignature)
 	// SetEth1Data sets the Eth1 data of the beacon block body.
@@ -83,15 +91,43 @@ type BeaconBlockBody[
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
 }
 
+// BeaconBlockHeader represents a beacon block header interface.
+type BeaconBlockHeader[T any] interface {
+	// New creates a new beacon block header with the given parameters.
+	New(
+		slot math.Slot,
+		proposerIndex math.ValidatorIndex,
+		parentBlockRoot common.Root,
+		stateRoot common.Root,
+		bodyRoot common.Root,
+	) T
+	// GetSlot returns the slot of the beacon block header.
+	GetSlot() math.Slot
+	// GetProposerIndex returns the proposer index of the beacon block header.
+	GetProposerIndex() math.ValidatorIndex
+	// GetParentBlockRoot returns the parent block root of the beacon block
+	// header.
+	GetParentBlockRoot() common.Root
+	// GetStateRoot returns the state root of the beacon block header.
+	GetStateRoot() common.Root
+}
+
 // BeaconState represents a beacon state interface.
-type BeaconState[ExecutionPayloadHeaderT any] interface {
+type BeaconState[BeaconBlockHeaderT, ExecutionPayloadHeaderT any] interface {
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (common.Root, error)
+	// GetLatestBlockHeader returns the latest block header.
+	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
+	// SetLatestBlockHeader sets the latest block header.
+	SetLatestBlockHeader(BeaconBlockHeaderT) error
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
 	// header.
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
+	// SetLatestExecutionPayloadHeader sets the latest execution payload
+	// header.
+	SetLatestExecutionPayloadHeader(ExecutionPayloadHeaderT) error
 	// GetSlot returns the current slot of the beacon state.
 	GetSlot() (math.Slot, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
@@ -138,6 +174,13 @@ type Eth1Data[T any] interface {
 
 // ExecutionPayloadHeader represents the execution payload header interface.
 type ExecutionPayloadHeader interface {
+	// HashTreeRoot returns the hash tree root of the execution payload header.
+	HashTreeRoot() common.Root
+	// GetPrevRandao returns the prev randao of the execution payload header.
+	GetPrevRandao() common.Bytes32
+	// GetWithdrawalsRoot returns the withdrawals root of the execution payload
+	// header.
+	GetWithdrawalsRoot() common.Root
 	// GetTimestamp returns the timestamp of the execution payload header.
 	GetTimestamp() math.U64
 	// GetBlockHash returns the block hash of the execution payload header.
@@ -146,6 +189,36 @@ type ExecutionPayloadHeader interface {
 	GetParentHash() common.ExecutionHash
 }
 
+// ExternalPayloadBuilder represents a builder outside of this node, such as
+// a relay, that sells execution payloads to the proposer through a blinded
+// block flow.
+type ExternalPayloadBuilder[
+	BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT any,
+] interface {
+	// Enabled returns true if the external builder is configured.
+	Enabled() bool
+	// GetHeader returns the header of the best payload offered for the given
+	// slot, the commitments to its blobs and the value of the offer.
+	GetHeader(
+		ctx context.Context,
+		slot math.Slot,
+		parentHash common.ExecutionHash,
+	) (
+		ExecutionPayloadHeaderT,
+		eip4844.KZGCommitments[common.ExecutionHash],
+		*math.U256,
+		error,
+	)
+	// SubmitBlindedBlock signs the block blinded over the given header and
+	// returns the payload and blobs revealed in exchange.
+	SubmitBlindedBlock(
+		ctx context.Context,
+		blk BeaconBlockT,
+		header ExecutionPayloadHeaderT,
+		genesisValidatorsRoot common.Root,
+	) (ExecutionPayloadT, engineprimitives.BlobsBundle, error)
+}
+
 // ForkData represents the fork data interface.
 type ForkData[T any] interface {
 	// New creates a new fork data with the given parameters.
@@ -158,6 +231,12 @@ type ForkData[T any] interface {
 		common.DomainType,
 		math.Epoch,
 	) common.Root
+	// ComputeBlockSigningRoot computes the signing root of the beacon block
+	// with the given root.
+	ComputeBlockSigningRoot(
+		common.DomainType,
+		common.Root,
+	) common.Root
 }
 
 // PayloadBuilder represents a service that is responsible for
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	cmtdb "github.com/cometbft/cometbft-db"
+	"github.com/spf13/cobra"
+)
+
+// cometBFTDBs are the names of the databases of CometBFT.
+//
+//nolint:gochecknoglobals // list of names.
+var cometBFTDBs = []string{"blockstore", "state", "tx_index", "evidence"}
+
+// compactable is a database to compact.
+type compactable struct {
+	// name is the name of the database, stored at dir/name.db.
+	name string
+	// dir is the directory of the database.
+	dir string
+	// backend is the backend of the database.
+	backend cmtdb.BackendType
+}
+
+// NewCompactCmd creates a command compacting the databases.
+func NewCompactCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "compact",
+		Short: "Compacts the databases of the data directory",
+		Long: `Compacts the application database, the CometBFT databases, the
+block store and the availability and deposit stores when they run on a
+key-value backend, which reclaims the space of deleted and overwritten keys.
+The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmtCfg := clicontext.GetConfigFromCmd(cmd)
+			cfg, err := config.ReadConfigFromAppOpts(
+				clicontext.GetViperFromCmd(cmd),
+			)
+			if err != nil {
+				return err
+			}
+
+			dir := dataDir(cmtCfg.RootDir)
+			dbs := []compactable{{"application", dir, cmtdb.PebbleDBBackend}}
+			for _, name := range cometBFTDBs {
+				dbs = append(dbs, compactable{
+					name, cmtCfg.DBDir(), cmtdb.BackendType(cmtCfg.DBBackend),
+				})
+			}
+			for _, store := range []struct {
+				name, backend string
+			}{
+				{blobsName, cfg.Storage.AvailabilityBackend},
+				{depositsName, cfg.Storage.DepositBackend},
+				{blocksName, string(storagedb.BackendPebbleDB)},
+			} {
+				switch backend := storagedb.Backend(store.backend); backend {
+				case storagedb.BackendPebbleDB, storagedb.BackendGoLevelDB:
+					dbs = append(dbs, compactable{
+						store.name, dir, cmtdb.BackendType(backend),
+					})
+				default:
+					cmd.Printf("Skipping %s on the %s backend\n",
+						store.name, backend,
+					)
+				}
+			}
+
+			for _, db := range dbs {
+				// Only some of the CometBFT databases may be in use.
+				if !dbExists(db.dir, db.name) {
+					continue
+				}
+				var compacted bool
+				compacted, err = compactDB(db)
+				if err != nil {
+					return errors.Wrapf(err, "compacting %s", db.name)
+				}
+				if compacted {
+					cmd.Printf("Compacted %s\n", db.name)
+				} else {
+					cmd.Printf("Skipping %s, which is empty\n", db.name)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// compactDB compacts the database, if it holds any key, and returns whether
+// it did.
+func compactDB(c compactable) (bool, error) {
+	db, err := cmtdb.NewDB(c.name, c.backend, c.dir)
+	if err != nil {
+		return false, err
+	}
+	defer db.Close()
+
+	first, last, err := keyRange(db)
+	if err != nil || first == nil {
+		return false, err
+	}
+	// The end of the range is exclusive.
+	return true, db.Compact(first, append(last, 0))
+}
+
+// keyRange returns the first and last keys of the database, or nil if it is
+// empty.
+func keyRange(db cmtdb.DB) ([]byte, []byte, error) {
+	first, err := firstKey(db.Iterator(nil, nil))
+	if err != nil || first == nil {
+		return nil, nil, err
+	}
+	last, err := firstKey(db.ReverseIterator(nil, nil))
+	return first, last, err
+}
+
+// firstKey returns a copy of the first key of the iterator, or nil if it is
+// exhausted, and closes it.
+func firstKey(it cmtdb.Iterator, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return nil, it.Error()
+	}
+	return append([]byte{}, it.Key()...), nil
+}
+
+// dbExists returns true if the database with the given name exists in dir.
+func dbExists(dir, name string) bool {
+	_, err := os.Stat(filepath.Join(dir, name+".db"))
+	return err == nil
+}
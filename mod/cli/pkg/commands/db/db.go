@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// blobsName is the name of the database of the availability store.
+const blobsName = "blobs"
+
+// Commands creates the commands inspecting and maintaining the databases of
+// the data directory.
+func Commands(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "db",
+		Short:                      "Database subcommands",
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewStatsCmd(),
+		NewGetCmd(),
+		NewVerifyCmd(chainSpec),
+		NewCompactCmd(),
+		NewMigrateBlobsCmd(),
+	)
+
+	return cmd
+}
+
+// NewMigrateBlobsCmd creates a command copying the blob sidecars of the file
+// backend into another backend.
+func NewMigrateBlobsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate-blobs [backend]",
+		Short: "Copies the blob sidecars stored one file per blob to a backend",
+		Long: `Copies the blob sidecars of the availability store from the file
+backend, which stores every blob in a file of its own, to the given backend
+("segment", "pebbledb" or "goleveldb"). The node must be stopped while
+migrating. Once done, set beacon-kit.storage.availability-backend to the
+backend and remove the data/blobs directory.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			backend, err := storagedb.BackendFromString(args[0])
+			if err != nil {
+				return err
+			}
+			if backend == storagedb.BackendFile {
+				return errors.Wrapf(
+					storagedb.ErrUnsupportedBackend,
+					"%q is the backend migrated from", backend,
+				)
+			}
+
+			dir := dataDir(homeDir(cmd))
+			logger := noop.NewLogger[any]()
+			src, err := storage.OpenDB(
+				storagedb.BackendFile, dir, blobsName, logger,
+			)
+			if err != nil {
+				return err
+			}
+			dst, err := storage.OpenDB(backend, dir, blobsName, logger)
+			if err != nil {
+				return err
+			}
+			defer dst.Close()
+
+			iterable, ok := src.(storagedb.Iterable)
+			if !ok {
+				return errors.New("file backend cannot be iterated")
+			}
+			copied, err := storagedb.Copy(dst, iterable)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Copied %d blob sidecars to %s\n", copied, backend)
+			return nil
+		},
+	}
+}
+
+// homeDir returns the home directory of the node.
+func homeDir(cmd *cobra.Command) string {
+	return cast.ToString(
+		clicontext.GetViperFromCmd(cmd).Get(flags.FlagHome),
+	)
+}
+
+// dataDir returns the data directory of the node at home.
+func dataDir(home string) string {
+	return filepath.Join(home, "data")
+}
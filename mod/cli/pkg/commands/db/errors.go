@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrHeightMismatch is returned when the application store and CometBFT
+	// are not at the same height.
+	ErrHeightMismatch = errors.New("application and CometBFT heights differ")
+	// ErrAppHashMismatch is returned when the app hash recomputed from the
+	// application store does not match the stored one.
+	ErrAppHashMismatch = errors.New("app hash mismatch")
+	// ErrStateRootMismatch is returned when the beacon state root recomputed
+	// from the application store does not match the state root of the
+	// beacon block.
+	ErrStateRootMismatch = errors.New("beacon state root mismatch")
+	// ErrSlotMismatch is returned when the slot of the application store is
+	// not the slot of the beacon block at the same height.
+	ErrSlotMismatch = errors.New("slot mismatch")
+	// ErrBlockNotFound is returned when CometBFT has no block at a height.
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrUnsupportedStore is returned when a store of the application is not
+	// an IAVL tree.
+	ErrUnsupportedStore = errors.New("store is not an IAVL tree")
+	// ErrUnsupportedDB is returned when a database cannot be compacted.
+	ErrUnsupportedDB = errors.New("database cannot be compacted")
+)
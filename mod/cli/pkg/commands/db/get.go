@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// NewGetCmd creates the commands printing entries of the beacon state at the
+// latest height as JSON.
+func NewGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Prints an entry of the beacon state as JSON",
+		Long: `Prints an entry of the beacon state at the latest height as JSON.
+The node must be stopped.`,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		newGetEntryCmd(
+			"validator [index]",
+			"Prints the validator at the given index",
+			cobra.ExactArgs(1),
+			func(kv *kvStore, args []string) (any, error) {
+				index, err := parseIndex(args[0])
+				if err != nil {
+					return nil, err
+				}
+				return kv.ValidatorByIndex(index)
+			},
+		),
+		newGetEntryCmd(
+			"balance [index]",
+			"Prints the balance of the validator at the given index",
+			cobra.ExactArgs(1),
+			func(kv *kvStore, args []string) (any, error) {
+				index, err := parseIndex(args[0])
+				if err != nil {
+					return nil, err
+				}
+				balance, err := kv.GetBalance(index)
+				return struct {
+					Index   math.ValidatorIndex `json:"index"`
+					Balance math.Gwei           `json:"balance"`
+				}{index, balance}, err
+			},
+		),
+		newGetEntryCmd(
+			"payload-header",
+			"Prints the latest execution payload header",
+			cobra.NoArgs,
+			func(kv *kvStore, _ []string) (any, error) {
+				return kv.GetLatestExecutionPayloadHeader()
+			},
+		),
+	)
+
+	return cmd
+}
+
+// newGetEntryCmd creates a command printing the entry get reads from the
+// beacon store given the arguments of the command.
+func newGetEntryCmd(
+	use string,
+	short string,
+	args cobra.PositionalArgs,
+	get func(kv *kvStore, args []string) (any, error),
+) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			st, err := openState(homeDir(cmd))
+			if err != nil {
+				return err
+			}
+			defer st.Close()
+
+			entry, err := get(st.KVStore(cmd.Context()), args)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(entry, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+// parseIndex parses a validator index.
+func parseIndex(s string) (math.ValidatorIndex, error) {
+	index, err := strconv.ParseUint(s, 10, 64)
+	return math.ValidatorIndex(index), err
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"context"
+
+	"cosmossdk.io/log"
+	iavlstore "cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/iavl"
+	iavldb "github.com/cosmos/iavl/db"
+)
+
+// kvStore is the beacon store of the application.
+type kvStore = beacondb.KVStore[
+	*types.BeaconBlockHeader, *types.Eth1Data, *types.ExecutionPayloadHeader,
+	*types.Fork, *types.Validator, types.Validators,
+]
+
+// state is the application store opened at its latest version.
+type state struct {
+	// db is the application database.
+	db dbm.DB
+	// cms is the multistore of the application.
+	cms *rootmulti.Store
+	// key is the key of the beacon store.
+	key *storetypes.KVStoreKey
+}
+
+// openState opens the application store of the node at rootDir at its latest
+// version. The node must be stopped.
+func openState(rootDir string) (*state, error) {
+	appDB, err := db.OpenDB(rootDir, dbm.PebbleDBBackend)
+	if err != nil {
+		return nil, err
+	}
+
+	key := components.ProvideKVStoreKey()
+	cms := rootmulti.NewStore(
+		appDB, log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	return &state{db: appDB, cms: cms, key: key}, nil
+}
+
+// Height returns the height the application store has been committed at.
+func (s *state) Height() int64 {
+	return s.cms.LatestVersion()
+}
+
+// AppHash recomputes the app hash from the trees of the stores of the
+// application, rehashing their nodes rather than using the root hashes
+// stored along with them.
+func (s *state) AppHash() ([]byte, error) {
+	hash, err := s.rehashTree()
+	if err != nil {
+		return nil, err
+	}
+	return storetypes.CommitInfo{
+		Version: s.Height(),
+		StoreInfos: []storetypes.StoreInfo{{
+			Name: s.key.Name(),
+			CommitId: storetypes.CommitID{
+				Version: s.Height(),
+				Hash:    hash,
+			},
+		}},
+	}.Hash(), nil
+}
+
+// rehashTree recomputes the root hash of the IAVL tree of the beacon store at
+// the committed height. The tree is exported node by node into an in-memory
+// tree, which hashes every node again from its key, value, version and
+// height.
+func (s *state) rehashTree() ([]byte, error) {
+	st, ok := s.cms.GetCommitKVStore(s.key).(*iavlstore.Store)
+	if !ok {
+		return nil, errors.Wrapf(ErrUnsupportedStore, "%s", s.key.Name())
+	}
+	exporter, err := st.Export(s.Height())
+	if err != nil {
+		return nil, err
+	}
+	defer exporter.Close()
+
+	tree := iavl.NewMutableTree(
+		iavldb.NewMemDB(), 0, true, iavl.NewNopLogger(),
+	)
+	importer, err := tree.Import(s.Height())
+	if err != nil {
+		return nil, err
+	}
+	defer importer.Close()
+	for {
+		node, nextErr := exporter.Next()
+		if errors.Is(nextErr, iavl.ErrorExportDone) {
+			break
+		} else if nextErr != nil {
+			return nil, nextErr
+		}
+		if err = importer.Add(node); err != nil {
+			return nil, err
+		}
+	}
+	if err = importer.Commit(); err != nil {
+		return nil, err
+	}
+	return tree.Hash(), nil
+}
+
+// CommitHash returns the app hash stored along with the commit of the
+// application store.
+func (s *state) CommitHash() []byte {
+	return s.cms.LastCommitID().Hash
+}
+
+// Store returns the beacon store of the application.
+func (s *state) Store() storetypes.KVStore {
+	return s.cms.GetKVStore(s.key)
+}
+
+// KVStore returns the beacon store of the application, decoding its
+// collections.
+func (s *state) KVStore(ctx context.Context) *kvStore {
+	kv := components.ProvideKVStore[
+		*types.BeaconBlockHeader, *types.ExecutionPayloadHeader,
+	](components.KVStoreInput{
+		KVStoreService: components.NewKVStoreService(s.key),
+	})
+	return kv.WithContext(
+		sdk.NewContext(
+			s.cms.CacheMultiStore(), false, log.NewNopLogger(),
+		).WithContext(ctx),
+	)
+}
+
+// Close closes the application database.
+func (s *state) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/index"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// depositsName is the name of the database of the deposit store.
+	depositsName = "deposits"
+	// blocksName is the name of the database of the block store, which
+	// always runs on PebbleDB.
+	blocksName = "blocks"
+	// statsFormat is the format of a row of the beacon state stats.
+	statsFormat = "%-44s %10v %12v %12v\n"
+)
+
+// prefixStats is the number and size of the keys under a prefix.
+type prefixStats struct {
+	name       string
+	keys       uint64
+	keyBytes   uint64
+	valueBytes uint64
+}
+
+// NewStatsCmd creates a command reporting the size of the databases.
+func NewStatsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stats",
+		Short: "Reports the number and size of the keys of the databases",
+		Long: `Reports the number and size of the keys of the beacon state at the
+latest height for every prefix, along with the size on disk of the
+availability, deposit and block stores. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			home := homeDir(cmd)
+			cfg, err := config.ReadConfigFromAppOpts(
+				clicontext.GetViperFromCmd(cmd),
+			)
+			if err != nil {
+				return err
+			}
+
+			st, err := openState(home)
+			if err != nil {
+				return err
+			}
+			defer st.Close()
+			stats, err := stateStats(st)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Beacon state at height %d\n", st.Height())
+			cmd.Printf(statsFormat, "PREFIX", "KEYS", "KEY BYTES", "VALUE BYTES")
+			var total prefixStats
+			for _, s := range stats {
+				cmd.Printf(statsFormat,
+					s.name, s.keys, s.keyBytes, s.valueBytes,
+				)
+				total.keys += s.keys
+				total.keyBytes += s.keyBytes
+				total.valueBytes += s.valueBytes
+			}
+			cmd.Printf(statsFormat,
+				"Total", total.keys, total.keyBytes, total.valueBytes,
+			)
+
+			for _, store := range []struct {
+				title, backend, name string
+			}{
+				{"Availability store", cfg.Storage.AvailabilityBackend, blobsName},
+				{"Deposit store", cfg.Storage.DepositBackend, depositsName},
+				{"Block store", string(storagedb.BackendPebbleDB), blocksName},
+			} {
+				var (
+					path        string
+					files, size uint64
+				)
+				path, err = storage.DBPath(
+					storagedb.Backend(store.backend), dataDir(home), store.name,
+				)
+				if err != nil {
+					return err
+				}
+				files, size, err = dirSize(path)
+				if err != nil {
+					return err
+				}
+				cmd.Printf("%s (%s at %s): %d files, %d bytes\n",
+					store.title, store.backend, path, files, size,
+				)
+			}
+			return nil
+		},
+	}
+}
+
+// stateStats returns the stats of the beacon store for every prefix holding
+// keys. The keys of the validator indexes are grouped by index, the other
+// ones by their first byte.
+func stateStats(st *state) ([]*prefixStats, error) {
+	var (
+		byByte  [256]*prefixStats
+		indexes = index.Prefixes()
+		byIndex = make([]*prefixStats, len(indexes))
+	)
+	for i, prefix := range indexes {
+		byIndex[i] = &prefixStats{name: prefix}
+	}
+	for prefix := range byByte {
+		name, ok := keys.HumanReadable(byte(prefix))
+		if !ok {
+			name = "Unknown"
+		}
+		byByte[prefix] = &prefixStats{
+			name: fmt.Sprintf("%s (%d)", name, prefix),
+		}
+	}
+
+	it := st.Store().Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) == 0 {
+			continue
+		}
+		s := byByte[key[0]]
+		for i, prefix := range indexes {
+			if bytes.HasPrefix(key, []byte(prefix)) {
+				s = byIndex[i]
+				break
+			}
+		}
+		s.keys++
+		s.keyBytes += uint64(len(key))
+		s.valueBytes += uint64(len(it.Value()))
+	}
+
+	stats := make([]*prefixStats, 0, len(byByte)+len(byIndex))
+	for _, s := range append(byByte[:], byIndex...) {
+		if s.keys > 0 {
+			stats = append(stats, s)
+		}
+	}
+	return stats, it.Error()
+}
+
+// dirSize returns the number and total size of the files under path, which
+// may not exist.
+func dirSize(path string) (uint64, uint64, error) {
+	var files, size uint64
+	err := filepath.WalkDir(path, func(
+		_ string, entry fs.DirEntry, err error,
+	) error {
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			return filepath.SkipDir
+		case err != nil:
+			return err
+		case entry.IsDir():
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		files++
+		//#nosec:G115 // file sizes are never negative.
+		size += uint64(info.Size())
+		return nil
+	})
+	return files, size, err
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"os"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	"github.com/spf13/cobra"
+)
+
+// NewVerifyCmd creates a command checking the application state against the
+// app hash stored by CometBFT.
+func NewVerifyCmd(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify",
+		Short: "Checks the application state against the CometBFT app hash",
+		Long: `Recomputes the app hash from the application store at the latest
+height, rehashing every node of its tree, and compares it with the app hash
+CometBFT stored for that height and with the one committed along with the
+store. The beacon state root at that height is recomputed from every entry of
+the beacon state and compared with the state root of the beacon block
+CometBFT stored for that height, and the slot of the state with the slot of
+that block. The node must be stopped.
+
+The command exits with a non-zero status on a mismatch, which indicates a
+corrupted data directory; 'rollback' recovers from an application store ahead
+of CometBFT by one height.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			st, err := openState(cfg.RootDir)
+			if err != nil {
+				return err
+			}
+			defer st.Close()
+			if st.Height() == 0 {
+				cmd.Println("Nothing to verify before the first block")
+				return nil
+			}
+
+			cmtState, err := loadCometBFTState(cfg)
+			if err != nil {
+				return err
+			}
+			if st.Height() != cmtState.LastBlockHeight {
+				return errors.Wrapf(
+					ErrHeightMismatch, "application %d, CometBFT %d",
+					st.Height(), cmtState.LastBlockHeight,
+				)
+			}
+
+			kv := st.KVStore(cmd.Context())
+			slot, err := kv.GetSlot()
+			if err != nil {
+				return err
+			}
+			stateRoot, err := kv.StateHashTreeRoot(
+				chainSpec.ActiveForkVersionForSlot(slot),
+				chainSpec.SlotsPerHistoricalRoot(),
+				chainSpec.EpochsPerHistoricalVector(),
+			)
+			if err != nil {
+				return err
+			}
+
+			blk, err := loadBlock(cfg, chainSpec, st.Height())
+			if err != nil {
+				return err
+			}
+			appHash, err := st.AppHash()
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Height:               %d\n", st.Height())
+			cmd.Printf("Slot:                 %d\n", slot)
+			cmd.Printf("Beacon state root:    %s\n", stateRoot)
+			cmd.Printf("Block slot:           %d\n", blk.GetSlot())
+			cmd.Printf("Block state root:     %s\n", blk.GetStateRoot())
+			cmd.Printf("Recomputed app hash:  %X\n", appHash)
+			cmd.Printf("Committed app hash:   %X\n", st.CommitHash())
+			cmd.Printf("CometBFT app hash:    %X\n", cmtState.AppHash)
+
+			switch {
+			case slot != blk.GetSlot():
+				return errors.Wrapf(
+					ErrSlotMismatch, "state %d, block %d",
+					slot, blk.GetSlot(),
+				)
+			case stateRoot != blk.GetStateRoot():
+				return errors.Wrap(ErrStateRootMismatch, "with the block")
+			case !bytes.Equal(appHash, cmtState.AppHash):
+				return errors.Wrap(ErrAppHashMismatch, "with CometBFT")
+			case !bytes.Equal(appHash, st.CommitHash()):
+				return errors.Wrap(ErrAppHashMismatch, "with the commit")
+			}
+			cmd.Println("State verified")
+			return nil
+		},
+	}
+}
+
+// loadCometBFTState loads the state CometBFT stored after the last block.
+func loadCometBFTState(cfg *cmtcfg.Config) (sm.State, error) {
+	if !dbExists(cfg.DBDir(), "state") {
+		return sm.State{}, errors.Wrap(
+			os.ErrNotExist, "CometBFT state",
+		)
+	}
+	stateDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "state", Config: cfg},
+	)
+	if err != nil {
+		return sm.State{}, err
+	}
+	defer stateDB.Close()
+	return sm.NewStore(stateDB, sm.StoreOptions{}).Load()
+}
+
+// loadBlock returns the beacon block CometBFT stored at the given height.
+// Heights and slots diverge once a slot is skipped, so the fork version the
+// block is decoded with follows the slot the block carries.
+func loadBlock(
+	cfg *cmtcfg.Config,
+	chainSpec common.ChainSpec,
+	height int64,
+) (*types.BeaconBlock, error) {
+	if !dbExists(cfg.DBDir(), "blockstore") {
+		return nil, errors.Wrap(os.ErrNotExist, "CometBFT block store")
+	}
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	if err != nil {
+		return nil, err
+	}
+	blockStore := cmtstore.NewBlockStore(blockStoreDB)
+	defer blockStore.Close()
+
+	block, _ := blockStore.LoadBlock(height)
+	if block == nil ||
+		uint(len(block.Txs)) <= middleware.BeaconBlockTxIndex {
+		return nil, errors.Wrapf(ErrBlockNotFound, "height %d", height)
+	}
+	slot, err := encoding.BeaconBlockSlotFromTx(
+		block.Txs[middleware.BeaconBlockTxIndex],
+	)
+	if err != nil {
+		return nil, err
+	}
+	req := &cmtabci.FinalizeBlockRequest{
+		Txs:    block.Txs.ToSliceOfBytes(),
+		Height: height,
+		Time:   block.Time,
+	}
+	return encoding.UnmarshalBeaconBlockFromABCIRequest[*types.BeaconBlock](
+		req,
+		middleware.BeaconBlockTxIndex,
+		chainSpec.ActiveForkVersionForSlot(slot),
+	)
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"cosmossdk.io/store"
+	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	beaconflags "github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/errors"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstore "github.com/cometbft/cometbft/store"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// flagRecording is the path of the recording to replay.
+	flagRecording = "recording"
+
+	// replayPollInterval is the interval at which the replay progress is
+	// checked.
+	replayPollInterval = 100 * time.Millisecond
+)
+
+// NewReplayCmd creates a command that replays the blocks stored by CometBFT
+// through the application, answering the execution client calls from a
+// recording made with the engine 'record-path' option.
+func NewReplayCmd[
+	T interface {
+		Start(context.Context) error
+		CommitMultiStore() store.CommitMultiStore
+	},
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	appCreator types.AppCreator[T, LoggerT],
+) *cobra.Command {
+	//nolint:lll // its okay.
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "replay stored blocks against a recorded execution client session",
+		Long: `
+Replay re-executes the blocks stored by CometBFT that are past the application
+state, answering every execution client call from a recording instead of the
+execution client. Combined with 'rollback', this reproduces an incident such as
+a bad block or an app hash mismatch offline and deterministically.
+
+The node runs without peers, RPC or node API, and with a throwaway CometBFT
+validator key so that it never signs. Replay modifies the application state:
+run it against a copy of the node home. The command fails if the calls made
+during the replay did not match the recording.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			v := clicontext.GetViperFromCmd(cmd)
+
+			recordingPath, err := cmd.Flags().GetString(flagRecording)
+			if err != nil {
+				return err
+			}
+			records, err := ethclientrpc.ReadRecords(recordingPath)
+			if err != nil {
+				return err
+			}
+			target, err := storedHeight(cfg)
+			if err != nil {
+				return err
+			}
+
+			// Serve the execution client calls from the recording.
+			replayer := ethclientrpc.NewReplayer(records)
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				return err
+			}
+			server := &http.Server{
+				Handler:           replayer,
+				ReadHeaderTimeout: time.Second,
+			}
+			//nolint:errcheck // returns once the server is closed.
+			go server.Serve(listener)
+			defer server.Close()
+
+			// Sign with a throwaway CometBFT key.
+			keyDir, err := os.MkdirTemp("", "replay")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(keyDir)
+			isolateNode(cfg, v, keyDir, "http://"+listener.Addr().String())
+
+			database, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			app := appCreator(logger, database, nil, cfg, v)
+			if err = runUntilHeight(cmd.Context(), app, target); err != nil {
+				return err
+			}
+
+			divergences := replayer.Divergences()
+			logger.Info(
+				"Replay completed",
+				"height", target,
+				"calls", replayer.Calls(),
+				"recorded", len(records),
+				"divergences", len(divergences),
+			)
+			if len(divergences) > 0 {
+				return errors.Wrapf(
+					ErrReplayDiverged, "%d calls: %v",
+					len(divergences), divergences,
+				)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(
+		flagRecording, "", "path of the execution client recording to replay",
+	)
+	//#nosec:G104 // the flag is defined above.
+	_ = cmd.MarkFlagRequired(flagRecording)
+	return cmd
+}
+
+// storedHeight returns the height of the last block stored by CometBFT.
+func storedHeight(cfg *cmtcfg.Config) (int64, error) {
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	if err != nil {
+		return 0, err
+	}
+	blockStore := cmtstore.NewBlockStore(blockStoreDB)
+	height := blockStore.Height()
+	return height, blockStore.Close()
+}
+
+// isolateNode configures the node to run offline against the execution
+// client at dialURL, with a CometBFT key generated in keyDir so that it never
+// signs with its own.
+func isolateNode(
+	cfg *cmtcfg.Config, v *viper.Viper, keyDir string, dialURL string,
+) {
+	cfg.PrivValidatorKey = filepath.Join(keyDir, "priv_validator_key.json")
+	cfg.PrivValidatorState = filepath.Join(keyDir, "priv_validator_state.json")
+
+	cfg.P2P.ListenAddress = "tcp://127.0.0.1:0"
+	cfg.P2P.Seeds = ""
+	cfg.P2P.PersistentPeers = ""
+	cfg.P2P.PexReactor = false
+	cfg.RPC.ListenAddress = ""
+	cfg.StateSync.Enable = false
+
+	v.Set(beaconflags.RPCDialURL, dialURL)
+	v.Set(beaconflags.NodeAPIEnabled, false)
+	v.Set(beaconflags.RecordPath, "")
+}
+
+// runUntilHeight runs the node until the application has committed the
+// given height. CometBFT replays the stored blocks while starting.
+func runUntilHeight[
+	T interface {
+		Start(context.Context) error
+		CommitMultiStore() store.CommitMultiStore
+	},
+](ctx context.Context, app T, height int64) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- app.Start(ctx)
+	}()
+
+	ticker := time.NewTicker(replayPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-errCh:
+			return errors.Join(ErrReplayStopped, err)
+		case <-ticker.C:
+			if app.CommitMultiStore().LatestVersion() >= height {
+				cancel()
+				return <-errCh
+			}
+		}
+	}
+}
@@ -81,9 +81,9 @@ application.
 			}
 
 			logger.Info(
-				"Rolled back state to height %d and hash %X\n",
-				height,
-				hash,
+				"Rolled back state",
+				"height", height,
+				"hash", fmt.Sprintf("%X", hash),
 			)
 			return nil
 		},
@@ -48,6 +48,16 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+
+	// state sync-related flags.
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+
+	// FlagCheckpointSyncURL is the node API of a trusted node to verify the
+	// state a fresh node restores through CometBFT state sync against. The
+	// restored app hash is verified against the statesync trust height and
+	// hash, which must be set.
+	FlagCheckpointSyncURL = "checkpoint-sync-url"
 )
 
 // StartCmdOptions defines options that can be customized in
@@ -103,6 +113,13 @@ nothing: all historic states will be saved, nothing will be deleted (i.e. archiv
 everything: 2 latest states will be kept; pruning at 10 block intervals.
 custom: allow pruning options to be manually specified through 'pruning-keep-recent', and 'pruning-interval'
 
+State sync snapshots of the application store are taken every '--state-sync.snapshot-interval' blocks, keeping
+the latest '--state-sync.snapshot-keep-recent' snapshots, and served to peers bootstrapping through state sync.
+
+A fresh node can be bootstrapped through state sync via '--checkpoint-sync-url', pointing at the API of a trusted
+node. The application store is restored from the snapshots of its peers, verified by the light client configured
+in the '[statesync]' section, and the restored beacon state is verified against the blocks of the trusted node.
+
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
@@ -181,6 +198,21 @@ func addStartNodeFlags[
 			"Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().
 		Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().
+		Uint64(
+			FlagStateSyncSnapshotInterval,
+			0,
+			"State sync snapshot interval")
+	cmd.Flags().
+		Uint32(
+			FlagStateSyncSnapshotKeepRecent,
+			2,
+			"State sync snapshot to keep")
+	cmd.Flags().
+		String(
+			FlagCheckpointSyncURL,
+			"",
+			"Node API URL of a trusted node to verify a state synced fresh node against")
 
 	// add support for all CometBFT-specific command line options
 	cmtcmd.AddNodeFlags(cmd)
@@ -21,11 +21,13 @@
 package commands
 
 import (
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/db"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
 	servertypes "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/slashing"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	cmtcli "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
@@ -56,12 +58,18 @@ func DefaultRootCommandSetup[
 		genutilcli.InitCmd(mm),
 		// `genesis`
 		genesis.Commands(chainSpec),
+		// `db`
+		db.Commands(chainSpec),
 		// `deposit`
 		deposit.Commands[ExecutionPayloadT](chainSpec),
 		// `jwt`
 		jwt.Commands(),
+		// `slashing-protection`
+		slashing.Commands(chainSpec),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
+		// `replay`
+		server.NewReplayCmd(appCreator),
 		// `start`
 		server.StartCmdWithOptions(appCreator, server.StartCmdOptions[T]{
 			AddFlags: flags.AddBeaconKitFlags,
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"os"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	slashingdb "github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/slashing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// flagGenesisValidatorsRoot is the flag for the genesis validators root used
+// when the database does not know it.
+const flagGenesisValidatorsRoot = "genesis-validators-root"
+
+// Commands creates the commands managing the slashing protection database.
+func Commands(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "slashing-protection",
+		Short:                      "Slashing protection subcommands",
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewImportCmd(chainSpec),
+		NewExportCmd(chainSpec),
+	)
+
+	return cmd
+}
+
+// NewImportCmd creates a command importing an EIP-3076 interchange file.
+func NewImportCmd(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [interchange.json]",
+		Short: "Imports an EIP-3076 slashing protection interchange file",
+		Long: `Imports the signing history of an EIP-3076 interchange file into
+the slashing protection database, so that none of the blocks it covers may be
+signed again. The node must be stopped while importing.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDB(cmd)
+			if err != nil {
+				return err
+			}
+
+			//#nosec:G304 // the path is given by the operator.
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			interchange := new(slashingdb.Interchange)
+			if err = json.Unmarshal(bz, interchange); err != nil {
+				return errors.Wrap(err, "failed to decode interchange file")
+			}
+			if err = db.Import(
+				interchange, chainSpec.DomainTypeProposer(),
+			); err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"Imported the history of %d validators\n",
+				len(interchange.Data),
+			)
+			return nil
+		},
+	}
+}
+
+// NewExportCmd creates a command exporting an EIP-3076 interchange file.
+func NewExportCmd(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [interchange.json]",
+		Short: "Exports an EIP-3076 slashing protection interchange file",
+		Long: `Exports the slashing protection database as an EIP-3076
+interchange file, to be imported wherever the validator keys are moved to. The
+node must be stopped while exporting, and must stay stopped once the keys are
+moved. The genesis validators root must be given if the database was never
+imported into.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDB(cmd)
+			if err != nil {
+				return err
+			}
+
+			var genesisValidatorsRoot *common.Root
+			rootFlag, err := cmd.Flags().GetString(flagGenesisValidatorsRoot)
+			if err != nil {
+				return err
+			}
+			if rootFlag != "" {
+				root, rErr := parser.ConvertGenesisValidatorRoot(rootFlag)
+				if rErr != nil {
+					return rErr
+				}
+				genesisValidatorsRoot = &root
+			}
+
+			interchange, err := db.Export(
+				chainSpec.DomainTypeProposer(), genesisValidatorsRoot,
+			)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(interchange, "", "  ")
+			if err != nil {
+				return err
+			}
+			//#nosec:G306 // the history holds no secrets.
+			return os.WriteFile(args[0], bz, 0o644)
+		},
+	}
+
+	cmd.Flags().String(
+		flagGenesisValidatorsRoot, "",
+		"genesis validators root of the chain, if unknown to the database",
+	)
+	return cmd
+}
+
+// openDB opens the slashing protection database configured for the node.
+func openDB(cmd *cobra.Command) (*slashingdb.DB, error) {
+	v := clicontext.GetViperFromCmd(cmd)
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	if err != nil {
+		return nil, err
+	}
+	db, err := components.ProvideSlashingProtection(
+		components.SlashingProtectionInput{
+			AppOpts: v,
+			Config:  cfg,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if db == nil {
+		return nil, ErrSlashingProtectionDisabled
+	}
+	return db, nil
+}
@@ -33,12 +33,14 @@ const (
 	// Builder Config.
 	builderRoot              = beaconKitRoot + "payload-builder."
 	SuggestedFeeRecipient    = builderRoot + "suggested-fee-recipient"
+	FeeRecipientsPath        = builderRoot + "fee-recipients-path"
 	LocalBuilderEnabled      = builderRoot + "local-builder-enabled"
 	LocalBuildPayloadTimeout = builderRoot + "local-build-payload-timeout"
 
 	// Validator Config.
-	validatorRoot = beaconKitRoot + "validator."
-	Graffiti      = validatorRoot + "graffiti"
+	validatorRoot          = beaconKitRoot + "validator."
+	Graffiti               = validatorRoot + "graffiti"
+	SlashingProtectionPath = validatorRoot + "slashing-protection-path"
 
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
@@ -49,11 +51,17 @@ const (
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	RecordPath              = engineRoot + "record-path"
+	RecordMaxSize           = engineRoot + "record-max-size"
+	RecordMaxFiles          = engineRoot + "record-max-files"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
 	KZGTrustedSetupPath = kzgRoot + "trusted-setup-path"
 	KZGImplementation   = kzgRoot + "implementation"
+	KZGCacheSize        = kzgRoot + "cache-size"
+	KZGBatchWindow      = kzgRoot + "batch-window"
+	KZGBatchMaxBlobs    = kzgRoot + "batch-max-blobs"
 
 	// Logger Config.
 	loggerRoot = beaconKitRoot + "logger."
@@ -67,6 +75,11 @@ const (
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
 
+	// Storage Config.
+	storageRoot                = beaconKitRoot + "storage."
+	StorageAvailabilityBackend = storageRoot + "availability-backend"
+	StorageDepositBackend      = storageRoot + "deposit-backend"
+
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
@@ -101,11 +114,36 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().String(
+		RecordPath,
+		defaultCfg.Engine.RecordPath,
+		"path to record execution client traffic to",
+	)
+	startCmd.Flags().Uint64(
+		RecordMaxSize,
+		defaultCfg.Engine.RecordMaxSize,
+		"size in bytes after which the recording is rotated",
+	)
+	startCmd.Flags().Uint64(
+		RecordMaxFiles,
+		defaultCfg.Engine.RecordMaxFiles,
+		"number of rotated recordings kept",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
 		"suggested fee recipient",
 	)
+	startCmd.Flags().String(
+		FeeRecipientsPath,
+		defaultCfg.PayloadBuilder.FeeRecipientsPath,
+		"path to the per-validator fee recipients file",
+	)
+	startCmd.Flags().String(
+		SlashingProtectionPath,
+		defaultCfg.Validator.SlashingProtectionPath,
+		"path to the slashing protection database",
+	)
 	startCmd.Flags().String(
 		KZGTrustedSetupPath,
 		defaultCfg.KZG.TrustedSetupPath,
@@ -116,6 +154,21 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.KZG.Implementation,
 		"kzg implementation",
 	)
+	startCmd.Flags().Int(
+		KZGCacheSize,
+		defaultCfg.KZG.CacheSize,
+		"number of verified blobs to remember",
+	)
+	startCmd.Flags().Duration(
+		KZGBatchWindow,
+		defaultCfg.KZG.BatchWindow,
+		"window to batch the kzg verification of finalized blobs over",
+	)
+	startCmd.Flags().Int(
+		KZGBatchMaxBlobs,
+		defaultCfg.KZG.BatchMaxBlobs,
+		"number of blobs after which a batch is verified",
+	)
 	startCmd.Flags().String(
 		TimeFormat,
 		defaultCfg.Logger.TimeFormat,
@@ -141,6 +194,16 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().String(
+		StorageAvailabilityBackend,
+		defaultCfg.Storage.AvailabilityBackend,
+		"backend the blob sidecars are stored in",
+	)
+	startCmd.Flags().String(
+		StorageDepositBackend,
+		defaultCfg.Storage.DepositBackend,
+		"backend the deposits are stored in",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -24,13 +24,17 @@ import (
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/tracing"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -47,9 +51,13 @@ func DefaultConfig() *Config {
 		Logger:            log.DefaultConfig(),
 		KZG:               kzg.DefaultConfig(),
 		PayloadBuilder:    builder.DefaultConfig(),
+		Relay:             relay.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
+		Storage:           storagedb.DefaultConfig(),
+		BlobArchive:       archive.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		Tracing:           tracing.DefaultConfig(),
 	}
 }
 
@@ -63,12 +71,20 @@ type Config struct {
 	KZG kzg.Config `mapstructure:"kzg"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
+	// Relay is the configuration for the external block builder relay.
+	Relay relay.Config `mapstructure:"relay"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
+	// Storage is the configuration for the storage backends.
+	Storage storagedb.Config `mapstructure:"storage"`
+	// BlobArchive is the configuration for the long-term blob archive.
+	BlobArchive archive.Config `mapstructure:"blob-archive"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Tracing is the configuration for the OpenTelemetry tracing.
+	Tracing tracing.Config `mapstructure:"tracing"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -45,6 +45,16 @@ rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Path of the file every execution client request and response is recorded to,
+# for replaying a session offline with 'beacond replay'. Empty disables recording.
+record-path = "{{.BeaconKit.Engine.RecordPath}}"
+
+# Size in bytes after which the recording is rotated.
+record-max-size = {{.BeaconKit.Engine.RecordMaxSize}}
+
+# Number of rotated recordings kept besides the current one.
+record-max-files = {{.BeaconKit.Engine.RecordMaxFiles}}
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -64,6 +74,20 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+# Number of verified blobs to remember so that they are not verified again.
+# A size of 0 disables the cache.
+cache-size = {{.BeaconKit.KZG.CacheSize}}
+
+# How long the verification of the blobs of finalized blocks is deferred, to
+# verify the blobs of several blocks together off the block processing path.
+# Blobs remembered by the cache are not verified again. A window of 0
+# disables the deferred verification.
+batch-window = "{{.BeaconKit.KZG.BatchWindow}}"
+
+# Number of blobs after which a batch is verified without waiting for the
+# window to elapse.
+batch-max-blobs = {{.BeaconKit.KZG.BatchMaxBlobs}}
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
@@ -72,11 +96,40 @@ enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
 # from this node.
 suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 
+# Path to a JSON file mapping validator pubkeys ("0x...") or indices ("12") to
+# fee recipients. Proposers without a mapping use the suggested fee recipient.
+# The file is reloaded when it changes and is updated by the
+# prepare_beacon_proposer API. Relative paths are resolved against the home
+# directory.
+fee-recipients-path = "{{.BeaconKit.PayloadBuilder.FeeRecipientsPath}}"
+
 # The timeout for local build payload. This should match, or be slightly less
 # than the configured timeout on your execution client. It also must be less than
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+[beacon-kit.relay]
+# Enabled determines if payloads are requested from an external block builder
+# relay. The local payload is proposed whenever it is worth more than the bid,
+# or the relay fails to answer in time.
+enabled = {{ .BeaconKit.Relay.Enabled }}
+
+# Builder API endpoint of the relay. The relay's public key may be given as the
+# user of the URL (https://0xpubkey@host) to only accept bids signed by it.
+url = "{{ .BeaconKit.Relay.URL }}"
+
+# Deadline for the relay to answer a header request.
+get-header-timeout = "{{ .BeaconKit.Relay.GetHeaderTimeout }}"
+
+# Deadline for the relay to reveal the payload of a signed blinded block.
+submit-blinded-block-timeout = "{{ .BeaconKit.Relay.SubmitBlindedBlockTimeout }}"
+
+# Gas limit builders are asked to target.
+gas-limit = {{ .BeaconKit.Relay.GasLimit }}
+
+# Interval at which the validator registration is resubmitted to the relay.
+registration-interval = "{{ .BeaconKit.Relay.RegistrationInterval }}"
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
@@ -85,6 +138,17 @@ graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+# Compression used for the beacon block and blob sidecars in proposals from
+# the DenebPlus fork on, earlier proposals are always sent as raw SSZ.
+# Options are "none", "snappy" or "zstd". Incoming proposals are decoded
+# regardless of this setting.
+proposal-compression = "{{.BeaconKit.Validator.ProposalCompression}}"
+
+# Path to the slashing protection database. Blocks and RANDAO reveals are only
+# signed once recorded in it, and conflicting ones are refused. Relative paths
+# are resolved against the home directory. Leave empty to disable.
+slashing-protection-path = "{{.BeaconKit.Validator.SlashingProtectionPath}}"
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -92,6 +156,45 @@ enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+[beacon-kit.storage]
+# Backend the blob sidecars are stored in. Options are "file", one file per
+# blob, "segment", one append-only file per slot, "pebbledb" or "goleveldb".
+# Existing blobs are moved to another backend with "beacond db migrate-blobs".
+availability-backend = "{{ .BeaconKit.Storage.AvailabilityBackend }}"
+
+# Backend the deposits are stored in. Options are "pebbledb" or "goleveldb".
+deposit-backend = "{{ .BeaconKit.Storage.DepositBackend }}"
+
+[beacon-kit.blob-archive]
+# Enabled determines if the blob sidecars of finalized blocks are archived, to
+# be served by the node API once they are pruned from the availability store.
+# The availability store keeps the sidecars until they are archived.
+enabled = {{ .BeaconKit.BlobArchive.Enabled }}
+
+# Backend the sidecars are archived to. Options are "dir", a local directory,
+# or "s3", an S3-compatible object store such as MinIO.
+backend = "{{ .BeaconKit.BlobArchive.Backend }}"
+
+# Directory of the "dir" backend. Relative paths are resolved against the home
+# directory.
+dir = "{{ .BeaconKit.BlobArchive.Dir }}"
+
+# URL of the S3-compatible API, e.g. http://localhost:9000.
+s3-endpoint = "{{ .BeaconKit.BlobArchive.S3Endpoint }}"
+
+# Bucket the sidecars are archived to.
+s3-bucket = "{{ .BeaconKit.BlobArchive.S3Bucket }}"
+
+# Region requests to the object store are signed for.
+s3-region = "{{ .BeaconKit.BlobArchive.S3Region }}"
+
+# Credentials requests to the object store are signed with.
+s3-access-key-id = "{{ .BeaconKit.BlobArchive.S3AccessKeyID }}"
+s3-secret-access-key = "{{ .BeaconKit.BlobArchive.S3SecretAccessKey }}"
+
+# Timeout of a request to the object store.
+s3-timeout = "{{ .BeaconKit.BlobArchive.S3Timeout }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -101,4 +204,17 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+[beacon-kit.tracing]
+# Enabled determines if OpenTelemetry spans of the slot lifecycle are exported.
+enabled = {{ .BeaconKit.Tracing.Enabled }}
+
+# Host and port of the OTLP/HTTP collector the spans are exported to.
+endpoint = "{{ .BeaconKit.Tracing.Endpoint }}"
+
+# Insecure disables TLS when exporting to the collector.
+insecure = {{ .BeaconKit.Tracing.Insecure }}
+
+# Fraction of slots that are traced, between 0 and 1.
+sample-ratio = {{ .BeaconKit.Tracing.SampleRatio }}
 `
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/karalabe/ssz"
+)
+
+// Attestations is a typealias for a list of AttestationData.
+type Attestations []*AttestationData
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the SSZ encoded size in bytes for the Attestations.
+func (as Attestations) SizeSSZ(bool) uint32 {
+	return ssz.SizeSliceOfStaticObjects(([]*AttestationData)(as))
+}
+
+// DefineSSZ defines the SSZ encoding for the Attestations object.
+func (as Attestations) DefineSSZ(c *ssz.Codec) {
+	c.DefineDecoder(func(*ssz.Decoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*AttestationData)(&as), constants.MaxAttestationsPerBlock)
+	})
+	c.DefineEncoder(func(*ssz.Encoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*AttestationData)(&as), constants.MaxAttestationsPerBlock)
+	})
+	c.DefineHasher(func(*ssz.Hasher) {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			c, (*[]*AttestationData)(&as), constants.MaxAttestationsPerBlock)
+	})
+}
+
+// HashTreeRoot returns the hash tree root of the Attestations.
+func (as Attestations) HashTreeRoot() common.Root {
+	return ssz.HashSequential(as)
+}
@@ -0,0 +1,452 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/karalabe/ssz"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                           BlindedBeaconBlockBody                           */
+/* -------------------------------------------------------------------------- */
+
+// BlindedBeaconBlockBody is a BeaconBlockBody that commits to its execution
+// payload through the payload header only. Since a header and its payload
+// share the same hash tree root, so do a body and its blinded counterpart.
+type BlindedBeaconBlockBody struct {
+	// RandaoReveal is the reveal of the RANDAO.
+	RandaoReveal crypto.BLSSignature `json:"randao_reveal"`
+	// Eth1Data is the data from the Eth1 chain.
+	Eth1Data *Eth1Data `json:"eth1_data"`
+	// Graffiti is for a fun message or meme.
+	Graffiti common.Bytes32 `json:"graffiti"`
+	// Deposits is the list of deposits included in the body.
+	Deposits []*Deposit `json:"deposits"`
+	// ExecutionPayloadHeader is the header of the execution payload.
+	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header"`
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// Attestations is the list of validators voting for the parent block in
+	// the last CometBFT commit. Only part of the body from DenebPlus on.
+	Attestations []*AttestationData `json:"attestations,omitempty"`
+	// SlashingInfo is the list of validator misbehaviors reported by
+	// CometBFT. Only part of the body from DenebPlus on.
+	SlashingInfo []*SlashingInfo `json:"slashing_info,omitempty"`
+
+	// isDenebPlus is whether the body is laid out for DenebPlus, which adds
+	// the fields derived from CometBFT. Bodies are Deneb bodies by default.
+	isDenebPlus bool
+}
+
+// hasCometBFTData returns whether the body carries the attestations and
+// slashing info derived from CometBFT, which it does from DenebPlus on.
+func (b *BlindedBeaconBlockBody) hasCometBFTData() bool {
+	return b.isDenebPlus
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlockBody in SSZ.
+func (b *BlindedBeaconBlockBody) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.hasCometBFTData() {
+		size += 4 + 4
+	}
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
+	size += ssz.SizeDynamicObject(b.ExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	if b.hasCometBFTData() {
+		size += ssz.SizeSliceOfStaticObjects(b.Attestations)
+		size += ssz.SizeSliceOfStaticObjects(b.SlashingInfo)
+	}
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BlindedBeaconBlockBody.
+//
+//nolint:mnd // TODO: chainspec.
+func (b *BlindedBeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
+	ssz.DefineStaticObject(codec, &b.Eth1Data)
+	ssz.DefineStaticBytes(codec, &b.Graffiti)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	if b.hasCometBFTData() {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &b.Attestations, constants.MaxAttestationsPerBlock,
+		)
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &b.SlashingInfo, constants.MaxSlashingInfoPerBlock,
+		)
+	}
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	if b.hasCometBFTData() {
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &b.Attestations, constants.MaxAttestationsPerBlock,
+		)
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &b.SlashingInfo, constants.MaxSlashingInfoPerBlock,
+		)
+	}
+}
+
+// MarshalSSZ serializes the BlindedBeaconBlockBody to SSZ-encoded bytes.
+func (b *BlindedBeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ deserializes the BlindedBeaconBlockBody from SSZ-encoded
+// bytes.
+func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+// IsNil checks if the BlindedBeaconBlockBody is nil.
+func (b *BlindedBeaconBlockBody) IsNil() bool {
+	return b == nil
+}
+
+// GetExecutionPayloadHeader returns the execution payload header of the body.
+func (
+	b *BlindedBeaconBlockBody,
+) GetExecutionPayloadHeader() *ExecutionPayloadHeader {
+	return b.ExecutionPayloadHeader
+}
+
+// GetBlobKzgCommitments returns the BlobKzgCommitments of the body.
+func (
+	b *BlindedBeaconBlockBody,
+) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return b.BlobKzgCommitments
+}
+
+// Blind returns the blinded counterpart of the body, committing to the given
+// execution payload header instead of the body's payload.
+func (b *BeaconBlockBody) Blind(
+	header *ExecutionPayloadHeader,
+) *BlindedBeaconBlockBody {
+	return &BlindedBeaconBlockBody{
+		RandaoReveal:           b.RandaoReveal,
+		Eth1Data:               b.Eth1Data,
+		Graffiti:               b.Graffiti,
+		Deposits:               b.Deposits,
+		ExecutionPayloadHeader: header,
+		BlobKzgCommitments:     b.BlobKzgCommitments,
+		Attestations:           b.Attestations,
+		SlashingInfo:           b.SlashingInfo,
+		isDenebPlus:            b.isDenebPlus,
+	}
+}
+
+// BlindedHashTreeRoot returns the hash tree root the body will have once its
+// execution payload is replaced by the one committed to by the given header.
+func (b *BeaconBlockBody) BlindedHashTreeRoot(
+	header *ExecutionPayloadHeader,
+) common.Root {
+	return b.Blind(header).HashTreeRoot()
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             BlindedBeaconBlock                             */
+/* -------------------------------------------------------------------------- */
+
+// BlindedBeaconBlock is a BeaconBlock with a blinded body.
+type BlindedBeaconBlock struct {
+	// Slot represents the position of the block in the chain.
+	Slot math.Slot `json:"slot"`
+	// ProposerIndex is the index of the validator who proposed the block.
+	ProposerIndex math.ValidatorIndex `json:"proposer_index"`
+	// ParentRoot is the hash of the parent block
+	ParentRoot common.Root `json:"parent_root"`
+	// StateRoot is the hash of the state at the block.
+	StateRoot common.Root `json:"state_root"`
+	// Body is the blinded body of the block.
+	Body *BlindedBeaconBlockBody `json:"body"`
+}
+
+// Blind returns the blinded counterpart of the block, committing to the
+// given execution payload header instead of the block's payload.
+func (b *BeaconBlock) Blind(
+	header *ExecutionPayloadHeader,
+) *BlindedBeaconBlock {
+	return &BlindedBeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body:          b.Body.Blind(header),
+	}
+}
+
+// ToBlinded returns the blinded counterpart of the block, committing to the
+// header of the block's execution payload.
+func (b *BeaconBlock) ToBlinded(
+	eth1ChainID uint64,
+) (*BlindedBeaconBlock, error) {
+	header, err := b.Body.ExecutionPayload.ToHeader(0, eth1ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return b.Blind(header), nil
+}
+
+// Unblind returns the block the blinded block commits to, signed by the given
+// signature, with its execution payload rebuilt from the payload header and
+// the given payload body. It fails if the body does not match the header.
+func (b *BlindedBeaconBlock) Unblind(
+	body *engineprimitives.ExecutionPayloadBodyV1,
+	signature crypto.BLSSignature,
+	eth1ChainID uint64,
+) (*BeaconBlock, error) {
+	h := b.Body.ExecutionPayloadHeader
+	payload := &ExecutionPayload{
+		ParentHash:    h.ParentHash,
+		FeeRecipient:  h.FeeRecipient,
+		StateRoot:     h.StateRoot,
+		ReceiptsRoot:  h.ReceiptsRoot,
+		LogsBloom:     h.LogsBloom,
+		Random:        h.Random,
+		Number:        h.Number,
+		GasLimit:      h.GasLimit,
+		GasUsed:       h.GasUsed,
+		Timestamp:     h.Timestamp,
+		ExtraData:     h.ExtraData,
+		BaseFeePerGas: h.BaseFeePerGas,
+		BlockHash:     h.BlockHash,
+		Transactions:  body.GetTransactions(),
+		Withdrawals:   body.Withdrawals,
+		BlobGasUsed:   h.BlobGasUsed,
+		ExcessBlobGas: h.ExcessBlobGas,
+	}
+
+	// The header commits to the transactions and withdrawals by their roots.
+	header, err := payload.ToHeader(0, eth1ChainID)
+	if err != nil {
+		return nil, err
+	}
+	if header.HashTreeRoot() != h.HashTreeRoot() {
+		return nil, errors.Wrapf(
+			ErrPayloadBodyMismatch, "block hash %s", h.BlockHash,
+		)
+	}
+
+	return &BeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &BeaconBlockBody{
+			RandaoReveal:       b.Body.RandaoReveal,
+			Eth1Data:           b.Body.Eth1Data,
+			Graffiti:           b.Body.Graffiti,
+			Deposits:           b.Body.Deposits,
+			ExecutionPayload:   payload,
+			BlobKzgCommitments: b.Body.BlobKzgCommitments,
+			Attestations:       b.Body.Attestations,
+			SlashingInfo:       b.Body.SlashingInfo,
+			isDenebPlus:        b.Body.isDenebPlus,
+		},
+		Signature: signature,
+	}, nil
+}
+
+// NewFromSSZ creates a new blinded block from the given SSZ bytes.
+func (b *BlindedBeaconBlock) NewFromSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*BlindedBeaconBlock, error) {
+	if isSupportedForkVersion(forkVersion) {
+		blk := &BlindedBeaconBlock{
+			Body: &BlindedBeaconBlockBody{
+				isDenebPlus: forkVersion == version.DenebPlus,
+			},
+		}
+		return blk, blk.UnmarshalSSZ(bz)
+	}
+
+	return nil, errors.Wrap(
+		ErrForkVersionNotSupported,
+		fmt.Sprintf("fork %d", forkVersion),
+	)
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlock object in SSZ encoding.
+func (b *BlindedBeaconBlock) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // todo fix.
+	var size = uint32(8 + 8 + 32 + 32 + 4)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(b.Body)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the BlindedBeaconBlock object.
+func (b *BlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &b.Slot)
+	ssz.DefineUint64(codec, &b.ProposerIndex)
+	ssz.DefineStaticBytes(codec, &b.ParentRoot)
+	ssz.DefineStaticBytes(codec, &b.StateRoot)
+	ssz.DefineDynamicObjectOffset(codec, &b.Body)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Body)
+}
+
+// MarshalSSZ marshals the BlindedBeaconBlock object to SSZ format.
+func (b *BlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ unmarshals the BlindedBeaconBlock object from SSZ format.
+func (b *BlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot computes the Merkleization of the BlindedBeaconBlock object.
+func (b *BlindedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+// IsNil checks if the BlindedBeaconBlock is nil.
+func (b *BlindedBeaconBlock) IsNil() bool {
+	return b == nil
+}
+
+// GetSlot retrieves the slot of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetSlot() math.Slot {
+	return b.Slot
+}
+
+// GetProposerIndex retrieves the proposer index.
+func (b *BlindedBeaconBlock) GetProposerIndex() math.ValidatorIndex {
+	return b.ProposerIndex
+}
+
+// GetParentBlockRoot retrieves the parent block root of the
+// BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetParentBlockRoot() common.Root {
+	return b.ParentRoot
+}
+
+// GetStateRoot retrieves the state root of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetStateRoot() common.Root {
+	return b.StateRoot
+}
+
+// GetTimestamp retrieves the timestamp of the execution payload of the
+// BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetTimestamp() math.U64 {
+	return b.Body.ExecutionPayloadHeader.Timestamp
+}
+
+// GetBody retrieves the blinded body of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetBody() *BlindedBeaconBlockBody {
+	return b.Body
+}
+
+// GetExecutionBlockHash returns the hash of the execution block the
+// BlindedBeaconBlock commits to.
+func (b *BlindedBeaconBlock) GetExecutionBlockHash() common.ExecutionHash {
+	return b.Body.ExecutionPayloadHeader.BlockHash
+}
+
+// Version identifies the version of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) Version() uint32 {
+	if b.Body != nil && b.Body.isDenebPlus {
+		return version.DenebPlus
+	}
+	return version.Deneb
+}
+
+/* -------------------------------------------------------------------------- */
+/*                          SignedBlindedBeaconBlock                          */
+/* -------------------------------------------------------------------------- */
+
+// SignedBlindedBeaconBlock is a BlindedBeaconBlock signed by its proposer.
+type SignedBlindedBeaconBlock struct {
+	// Message is the blinded block.
+	Message *BlindedBeaconBlock `json:"message"`
+	// Signature is the proposer's signature over the blinded block.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// New signs the given blinded block under the given domain, guarded by the
+// slashing protection of the signer if any.
+func (*SignedBlindedBeaconBlock) New(
+	blk *BlindedBeaconBlock,
+	forkData *ForkData,
+	domainType common.DomainType,
+	signer crypto.BLSSigner,
+) (*SignedBlindedBeaconBlock, error) {
+	signingRoot := ComputeSigningRoot(blk, forkData.ComputeDomain(domainType))
+	signature, err := crypto.SignAt(
+		signer, domainType, blk.Slot, signingRoot[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBlindedBeaconBlock{
+		Message:   blk,
+		Signature: signature,
+	}, nil
+}
+
+// IsNil checks if the SignedBlindedBeaconBlock is nil.
+func (b *SignedBlindedBeaconBlock) IsNil() bool {
+	return b == nil
+}
+
+// GetMessage returns the blinded block.
+func (b *SignedBlindedBeaconBlock) GetMessage() *BlindedBeaconBlock {
+	return b.Message
+}
+
+// GetSignature returns the proposer's signature over the blinded block.
+func (b *SignedBlindedBeaconBlock) GetSignature() crypto.BLSSignature {
+	return b.Signature
+}
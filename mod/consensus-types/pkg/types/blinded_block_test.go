@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlindedBeaconBlock_HashTreeRootMatchesBlock(t *testing.T) {
+	blk := generateValidBeaconBlock()
+	header, err := blk.GetBody().GetExecutionPayload().ToHeader(16, 1)
+	require.NoError(t, err)
+
+	blinded := blk.Blind(header)
+	require.Equal(t, blk.GetSlot(), blinded.GetSlot())
+	require.Equal(t, blk.GetStateRoot(), blinded.GetStateRoot())
+	require.Equal(t, blk.HashTreeRoot(), blinded.HashTreeRoot())
+	require.Equal(
+		t,
+		blk.GetBody().HashTreeRoot(),
+		blk.GetBody().BlindedHashTreeRoot(header),
+	)
+
+	// A different header commits to a different block.
+	header.GasUsed++
+	require.NotEqual(t, blk.HashTreeRoot(), blk.Blind(header).HashTreeRoot())
+}
+
+func TestBlindedBeaconBlock_MarshalUnmarshalSSZ(t *testing.T) {
+	blk := generateValidBeaconBlock()
+	header, err := blk.GetBody().GetExecutionPayload().ToHeader(16, 1)
+	require.NoError(t, err)
+	blinded := blk.Blind(header)
+
+	bz, err := blinded.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded := new(types.BlindedBeaconBlock)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, blinded.HashTreeRoot(), decoded.HashTreeRoot())
+
+	decoded, err = new(types.BlindedBeaconBlock).NewFromSSZ(
+		bz, blinded.Version(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, blinded.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t, blk.GetTimestamp(), decoded.GetTimestamp())
+}
+
+func TestSignedBlindedBeaconBlock_New(t *testing.T) {
+	blk := generateValidBeaconBlock()
+	header, err := blk.GetBody().GetExecutionPayload().ToHeader(16, 1)
+	require.NoError(t, err)
+	blinded := blk.Blind(header)
+
+	forkData := &types.ForkData{
+		CurrentVersion:        common.Version{0x04, 0x00, 0x00, 0x00},
+		GenesisValidatorsRoot: common.Root{0x01},
+	}
+	domainType := common.DomainType{0x00, 0x00, 0x00, 0x00}
+	signingRoot := types.ComputeSigningRoot(
+		blinded, forkData.ComputeDomain(domainType),
+	)
+
+	signer := &mocks.BLSSigner{}
+	signer.On("Sign", signingRoot[:]).Return(crypto.BLSSignature{0x02}, nil)
+
+	signed, err := new(types.SignedBlindedBeaconBlock).New(
+		blinded, forkData, domainType, signer,
+	)
+	require.NoError(t, err)
+	require.Equal(t, blinded, signed.GetMessage())
+	require.Equal(t, crypto.BLSSignature{0x02}, signed.GetSignature())
+	signer.AssertExpectations(t)
+}
+
+func TestBlindedBeaconBlock_Unblind(t *testing.T) {
+	blk := generateValidBeaconBlock()
+	blinded, err := blk.ToBlinded(1)
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), blinded.HashTreeRoot())
+	require.Equal(
+		t,
+		blk.GetBody().GetExecutionPayload().GetBlockHash(),
+		blinded.GetExecutionBlockHash(),
+	)
+
+	payload := blk.GetBody().GetExecutionPayload()
+	body := &engineprimitives.ExecutionPayloadBodyV1{
+		Withdrawals: payload.Withdrawals,
+	}
+	for _, tx := range payload.Transactions {
+		body.Transactions = append(body.Transactions, bytes.Bytes(tx))
+	}
+
+	unblinded, err := blinded.Unblind(body, crypto.BLSSignature{0x03}, 1)
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), unblinded.HashTreeRoot())
+	require.Equal(t, crypto.BLSSignature{0x03}, unblinded.GetSignature())
+
+	// A body the header does not commit to is rejected.
+	body.Transactions = body.Transactions[1:]
+	_, err = blinded.Unblind(body, crypto.BLSSignature{0x03}, 1)
+	require.ErrorIs(t, err, types.ErrPayloadBodyMismatch)
+}
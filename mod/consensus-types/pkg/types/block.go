@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
@@ -45,6 +46,10 @@ type BeaconBlock struct {
 	// Body is the body of the BeaconBlock, containing the block's
 	// operations.
 	Body *BeaconBlockBody `json:"body"`
+	// Signature is the proposer's signature over the block. It is not part
+	// of the block itself, and is only carried along by the
+	// SignedBeaconBlock encoding.
+	Signature crypto.BLSSignature `json:"-"`
 }
 
 // Empty creates an empty beacon block.
@@ -59,13 +64,13 @@ func (b *BeaconBlock) NewWithVersion(
 	parentBlockRoot common.Root,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
+	if isSupportedForkVersion(forkVersion) {
 		return &BeaconBlock{
 			Slot:          slot,
 			ProposerIndex: proposerIndex,
 			ParentRoot:    parentBlockRoot,
 			StateRoot:     common.Root{},
-			Body:          &BeaconBlockBody{},
+			Body:          newBodyForVersion(forkVersion),
 		}, nil
 	}
 
@@ -80,8 +85,8 @@ func (b *BeaconBlock) NewFromSSZ(
 	bz []byte,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
-		block := &BeaconBlock{}
+	if isSupportedForkVersion(forkVersion) {
+		block := newForDecoding(forkVersion)
 		return block, block.UnmarshalSSZ(bz)
 	}
 
@@ -91,6 +96,40 @@ func (b *BeaconBlock) NewFromSSZ(
 	)
 }
 
+// NewFromSignedSSZ creates a new beacon block from the given SSZ bytes of a
+// SignedBeaconBlock, carrying over its signature.
+func (b *BeaconBlock) NewFromSignedSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*BeaconBlock, error) {
+	if isSupportedForkVersion(forkVersion) {
+		signed := &SignedBeaconBlock{Message: newForDecoding(forkVersion)}
+		if err := signed.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		signed.Message.Signature = signed.Signature
+		return signed.Message, nil
+	}
+
+	return nil, errors.Wrap(
+		ErrForkVersionNotSupported,
+		fmt.Sprintf("fork %d", forkVersion),
+	)
+}
+
+// isSupportedForkVersion returns whether blocks of the given fork version
+// are supported.
+func isSupportedForkVersion(forkVersion uint32) bool {
+	return forkVersion == version.Deneb || forkVersion == version.DenebPlus
+}
+
+// newForDecoding returns an empty block to decode a block of the given fork
+// version into. The fork version of the body determines its SSZ layout, so
+// it must be set before decoding.
+func newForDecoding(forkVersion uint32) *BeaconBlock {
+	return &BeaconBlock{Body: newBodyForVersion(forkVersion)}
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -125,6 +164,15 @@ func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
 	return buf, ssz.EncodeToBytes(buf, b)
 }
 
+// MarshalSignedSSZ marshals the BeaconBlock object along with its signature
+// to the SSZ format of a SignedBeaconBlock.
+func (b *BeaconBlock) MarshalSignedSSZ() ([]byte, error) {
+	return (&SignedBeaconBlock{
+		Message:   b,
+		Signature: b.Signature,
+	}).MarshalSSZ()
+}
+
 // UnmarshalSSZ unmarshals the BeaconBlock object from SSZ format.
 func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
 	return ssz.DecodeFromBytes(buf, b)
@@ -207,7 +255,10 @@ func (b *BeaconBlock) GetStateRoot() common.Root {
 
 // Version identifies the version of the BeaconBlock.
 func (b *BeaconBlock) Version() uint32 {
-	return version.Deneb
+	if b.Body == nil {
+		return version.Deneb
+	}
+	return b.Body.Version()
 }
 
 // SetStateRoot sets the state root of the BeaconBlock.
@@ -215,6 +266,16 @@ func (b *BeaconBlock) SetStateRoot(root common.Root) {
 	b.StateRoot = root
 }
 
+// GetSignature retrieves the proposer's signature over the BeaconBlock.
+func (b *BeaconBlock) GetSignature() crypto.BLSSignature {
+	return b.Signature
+}
+
+// SetSignature sets the proposer's signature over the BeaconBlock.
+func (b *BeaconBlock) SetSignature(signature crypto.BLSSignature) {
+	b.Signature = signature
+}
+
 // GetBody retrieves the body of the BeaconBlock.
 func (b *BeaconBlock) GetBody() *BeaconBlockBody {
 	return b.Body
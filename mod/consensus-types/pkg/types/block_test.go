@@ -91,6 +91,27 @@ func TestBeaconBlockFromSSZ(t *testing.T) {
 	require.Equal(t, originalBlock, wrappedBlock)
 }
 
+func TestBeaconBlockFromSSZDenebPlus(t *testing.T) {
+	originalBlock, err := (&types.BeaconBlock{}).NewWithVersion(
+		10, 5, common.Root{1, 2, 3}, version.DenebPlus,
+	)
+	require.NoError(t, err)
+	originalBlock.Body = generateDenebPlusBeaconBlockBody()
+	require.Equal(t, version.DenebPlus, originalBlock.Version())
+
+	sszBlock, err := originalBlock.MarshalSSZ()
+	require.NoError(t, err)
+
+	wrappedBlock, err := originalBlock.NewFromSSZ(sszBlock, version.DenebPlus)
+	require.NoError(t, err)
+	require.Equal(t, originalBlock, wrappedBlock)
+	require.Equal(t, originalBlock.HashTreeRoot(), wrappedBlock.HashTreeRoot())
+
+	// A DenebPlus block is not a valid Deneb block.
+	_, err = originalBlock.NewFromSSZ(sszBlock, version.Deneb)
+	require.Error(t, err)
+}
+
 func TestBeaconBlockFromSSZForkVersionNotSupported(t *testing.T) {
 	wrappedBlock := &types.BeaconBlock{}
 	_, err := wrappedBlock.NewFromSSZ([]byte{}, 1)
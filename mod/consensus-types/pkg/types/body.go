@@ -27,6 +27,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -40,7 +41,12 @@ const (
 	// struct.
 	BodyLengthDeneb uint64 = 6
 
+	// BodyLengthDenebPlus is the number of fields in the BeaconBlockBody
+	// struct from the DenebPlus fork on.
+	BodyLengthDenebPlus uint64 = 8
+
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
+	// The fields added by DenebPlus come after it, so it is unchanged.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
 	// KZGMerkleIndexDeneb is the merkle index of BlobKzgCommitments' root
@@ -55,12 +61,13 @@ const (
 // for the given fork version.
 func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 	switch forkVersion {
-	case version.Deneb:
+	case version.Deneb, version.DenebPlus:
 		return &BeaconBlockBody{
 			Eth1Data: new(Eth1Data),
 			ExecutionPayload: &ExecutionPayload{
 				ExtraData: make([]byte, ExtraDataSize),
 			},
+			isDenebPlus: forkVersion == version.DenebPlus,
 		}
 	default:
 		panic(ErrForkVersionNotSupported)
@@ -75,7 +82,7 @@ func BlockBodyKZGOffset(
 	cs common.ChainSpec,
 ) uint64 {
 	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
+	case version.Deneb, version.DenebPlus:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
 	default:
 		panic(ErrForkVersionNotSupported)
@@ -86,17 +93,47 @@ func BlockBodyKZGOffset(
 // chain.
 type BeaconBlockBody struct {
 	// RandaoReveal is the reveal of the RANDAO.
-	RandaoReveal crypto.BLSSignature
+	RandaoReveal crypto.BLSSignature `json:"randao_reveal"`
 	// Eth1Data is the data from the Eth1 chain.
-	Eth1Data *Eth1Data
+	Eth1Data *Eth1Data `json:"eth1_data"`
 	// Graffiti is for a fun message or meme.
-	Graffiti [32]byte
+	Graffiti [32]byte `json:"graffiti"`
 	// Deposits is the list of deposits included in the body.
-	Deposits []*Deposit
+	Deposits []*Deposit `json:"deposits"`
 	// ExecutionPayload is the execution payload of the body.
-	ExecutionPayload *ExecutionPayload
+	ExecutionPayload *ExecutionPayload `json:"execution_payload"`
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
-	BlobKzgCommitments []eip4844.KZGCommitment
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// Attestations is the list of validators voting for the parent block in
+	// the last CometBFT commit. Only part of the body from DenebPlus on.
+	Attestations []*AttestationData `json:"attestations,omitempty"`
+	// SlashingInfo is the list of validator misbehaviors reported by
+	// CometBFT. Only part of the body from DenebPlus on.
+	SlashingInfo []*SlashingInfo `json:"slashing_info,omitempty"`
+
+	// isDenebPlus is whether the body is laid out for DenebPlus, which adds
+	// the fields derived from CometBFT. Bodies are Deneb bodies by default.
+	isDenebPlus bool
+}
+
+// Version returns the fork version of the BeaconBlockBody.
+func (b *BeaconBlockBody) Version() uint32 {
+	if b.isDenebPlus {
+		return version.DenebPlus
+	}
+	return version.Deneb
+}
+
+// newBodyForVersion returns an empty body laid out for the given fork
+// version.
+func newBodyForVersion(forkVersion uint32) *BeaconBlockBody {
+	return &BeaconBlockBody{isDenebPlus: forkVersion == version.DenebPlus}
+}
+
+// hasCometBFTData returns whether the body carries the attestations and
+// slashing info derived from CometBFT, which it does from DenebPlus on.
+func (b *BeaconBlockBody) hasCometBFTData() bool {
+	return b.isDenebPlus
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,6 +143,9 @@ type BeaconBlockBody struct {
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.hasCometBFTData() {
+		size += 4 + 4
+	}
 	if fixed {
 		return size
 	}
@@ -113,6 +153,10 @@ func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
 	size += ssz.SizeDynamicObject(b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	if b.hasCometBFTData() {
+		size += ssz.SizeSliceOfStaticObjects(b.Attestations)
+		size += ssz.SizeSliceOfStaticObjects(b.SlashingInfo)
+	}
 	return size
 }
 
@@ -127,11 +171,27 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	if b.hasCometBFTData() {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &b.Attestations, constants.MaxAttestationsPerBlock,
+		)
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &b.SlashingInfo, constants.MaxSlashingInfoPerBlock,
+		)
+	}
 
 	// Define the dynamic data (fields)
 	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	if b.hasCometBFTData() {
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &b.Attestations, constants.MaxAttestationsPerBlock,
+		)
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &b.SlashingInfo, constants.MaxSlashingInfoPerBlock,
+		)
+	}
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
@@ -221,6 +281,34 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	if b.hasCometBFTData() {
+		// Field (6) 'Attestations'
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > constants.MaxAttestationsPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range b.Attestations {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxAttestationsPerBlock)
+
+		// Field (7) 'SlashingInfo'
+		subIndx = hh.Index()
+		num = uint64(len(b.SlashingInfo))
+		if num > constants.MaxSlashingInfoPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range b.SlashingInfo {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxSlashingInfoPerBlock)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -269,29 +357,31 @@ func (b *BeaconBlockBody) SetEth1Data(eth1Data *Eth1Data) {
 	b.Eth1Data = eth1Data
 }
 
-// SetDeposits is not implemented for BeaconBlockDeneb.
+// GetAttestations returns the Attestations of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetAttestations() []*AttestationData {
-	panic("not implemented")
+	return b.Attestations
 }
 
-// SetDeposits is not implemented for BeaconBlockDeneb.
-func (b *BeaconBlockBody) SetAttestations(_ []*AttestationData) {
-	panic("not implemented")
+// SetAttestations sets the Attestations of the BeaconBlockBody. They are
+// only part of the body from DenebPlus on.
+func (b *BeaconBlockBody) SetAttestations(attestations []*AttestationData) {
+	b.Attestations = attestations
 }
 
-// GetSlashingInfo is not implemented for BeaconBlockDeneb.
+// GetSlashingInfo returns the SlashingInfo of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetSlashingInfo() []*SlashingInfo {
-	panic("not implemented")
+	return b.SlashingInfo
 }
 
-// SetSlashingInfo is not implemented for BeaconBlockDeneb.
-func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
-	panic("not implemented")
+// SetSlashingInfo sets the SlashingInfo of the BeaconBlockBody. It is only
+// part of the body from DenebPlus on.
+func (b *BeaconBlockBody) SetSlashingInfo(slashingInfo []*SlashingInfo) {
+	b.SlashingInfo = slashingInfo
 }
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -300,10 +390,20 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		// I think this is a bug.
 		common.Root{},
 	}
+	if b.hasCometBFTData() {
+		roots = append(roots,
+			Attestations(b.GetAttestations()).HashTreeRoot(),
+			SlashingInfos(b.GetSlashingInfo()).HashTreeRoot(),
+		)
+	}
+	return roots
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.hasCometBFTData() {
+		return BodyLengthDenebPlus
+	}
 	return BodyLengthDeneb
 }
 
@@ -160,3 +160,48 @@ func TestBeaconBlockBody_Empty(t *testing.T) {
 	body := blockBody.Empty(version.Deneb)
 	require.NotNil(t, body)
 }
+
+func generateDenebPlusBeaconBlockBody() *types.BeaconBlockBody {
+	body := (&types.BeaconBlockBody{}).Empty(version.DenebPlus)
+	body.RandaoReveal = [96]byte{1, 2, 3}
+	body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	body.SetAttestations([]*types.AttestationData{
+		(&types.AttestationData{}).New(1, 0, common.Root{1}),
+		(&types.AttestationData{}).New(1, 2, common.Root{1}),
+	})
+	body.SetSlashingInfo([]*types.SlashingInfo{
+		(&types.SlashingInfo{}).New(1, 3),
+	})
+	return body
+}
+
+func TestBeaconBlockBody_DenebPlus(t *testing.T) {
+	body := generateDenebPlusBeaconBlockBody()
+	require.Equal(t, version.DenebPlus, body.Version())
+	require.Equal(t, types.BodyLengthDenebPlus, body.Length())
+	require.Len(t, body.GetTopLevelRoots(), int(types.BodyLengthDenebPlus))
+
+	// The attestations and slashing info are committed to by the body.
+	root := body.HashTreeRoot()
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, root[:], tree.Hash())
+
+	body.SetSlashingInfo(nil)
+	require.NotEqual(t, root, body.HashTreeRoot())
+
+	// They are not part of a Deneb body.
+	deneb := (&types.BeaconBlockBody{}).Empty(version.Deneb)
+	deneb.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	denebRoot := deneb.HashTreeRoot()
+	deneb.SetAttestations(body.GetAttestations())
+	require.Equal(t, version.Deneb, deneb.Version())
+	require.Equal(t, denebRoot, deneb.HashTreeRoot())
+}
+
+func TestBeaconBlockBody_DenebPlusBlindedRoot(t *testing.T) {
+	body := generateDenebPlusBeaconBlockBody()
+	header, err := body.GetExecutionPayload().ToHeader(0, 0)
+	require.NoError(t, err)
+	require.Equal(t, body.HashTreeRoot(), body.BlindedHashTreeRoot(header))
+}
@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// maxBuilderBidCommitments is the list limit of BlobKzgCommitments in a
+// BuilderBid, as defined in the builder-specs.
+const maxBuilderBidCommitments = 4096
+
+/* -------------------------------------------------------------------------- */
+/*                            ValidatorRegistration                           */
+/* -------------------------------------------------------------------------- */
+
+// ValidatorRegistration asks a relay to build blocks paying to the given fee
+// recipient whenever the validator with the given pubkey proposes.
+type ValidatorRegistration struct {
+	// FeeRecipient is the address builders should pay.
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	// GasLimit is the gas limit builders should target.
+	GasLimit math.U64 `json:"gas_limit"`
+	// Timestamp is the unix time at which the registration was created.
+	Timestamp math.U64 `json:"timestamp"`
+	// Pubkey is the public key of the registering validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// SizeSSZ returns the size of the ValidatorRegistration object in SSZ
+// encoding.
+func (*ValidatorRegistration) SizeSSZ() uint32 {
+	//nolint:mnd // 20 + 8 + 8 + 48 = 84.
+	return 84
+}
+
+// DefineSSZ defines the SSZ encoding for the ValidatorRegistration object.
+func (r *ValidatorRegistration) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &r.FeeRecipient)
+	ssz.DefineUint64(codec, &r.GasLimit)
+	ssz.DefineUint64(codec, &r.Timestamp)
+	ssz.DefineStaticBytes(codec, &r.Pubkey)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ValidatorRegistration
+// object.
+func (r *ValidatorRegistration) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// MarshalSSZ marshals the ValidatorRegistration object to SSZ format.
+func (r *ValidatorRegistration) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, r.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, r)
+}
+
+// UnmarshalSSZ unmarshals the ValidatorRegistration object from SSZ format.
+func (r *ValidatorRegistration) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, r)
+}
+
+// SignedValidatorRegistration is a ValidatorRegistration signed by the
+// registering validator under the application builder domain.
+type SignedValidatorRegistration struct {
+	// Message is the registration.
+	Message *ValidatorRegistration `json:"message"`
+	// Signature is the validator's signature over the registration.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// New creates and signs a registration for the signer's public key.
+func (*SignedValidatorRegistration) New(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signer crypto.BLSSigner,
+	feeRecipient common.ExecutionAddress,
+	gasLimit math.U64,
+	timestamp math.U64,
+) (*SignedValidatorRegistration, error) {
+	registration := &ValidatorRegistration{
+		FeeRecipient: feeRecipient,
+		GasLimit:     gasLimit,
+		Timestamp:    timestamp,
+		Pubkey:       signer.PublicKey(),
+	}
+	signingRoot := ComputeSigningRoot(
+		registration, forkData.ComputeDomain(domainType),
+	)
+	signature, err := signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	return &SignedValidatorRegistration{
+		Message:   registration,
+		Signature: signature,
+	}, nil
+}
+
+// GetMessage returns the registration.
+func (r *SignedValidatorRegistration) GetMessage() *ValidatorRegistration {
+	return r.Message
+}
+
+// GetSignature returns the signature of the registration.
+func (r *SignedValidatorRegistration) GetSignature() crypto.BLSSignature {
+	return r.Signature
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 BuilderBid                                 */
+/* -------------------------------------------------------------------------- */
+
+// BuilderBid is the offer of an external builder to provide the execution
+// payload committed to by Header in exchange for Value.
+type BuilderBid struct {
+	// Header is the header of the offered execution payload.
+	Header *ExecutionPayloadHeader `json:"header"`
+	// BlobKzgCommitments are the commitments to the blobs of the payload.
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// Value is the amount, in wei, paid to the proposer's fee recipient.
+	Value *math.U256 `json:"value"`
+	// Pubkey is the public key of the builder.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// SizeSSZ returns the size of the BuilderBid object in SSZ encoding.
+func (b *BuilderBid) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // 4 + 4 + 32 + 48 = 88.
+	var size uint32 = 88
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(b.Header)
+	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the BuilderBid object.
+func (b *BuilderBid) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineDynamicObjectOffset(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKzgCommitments, maxBuilderBidCommitments,
+	)
+	ssz.DefineUint256(codec, &b.Value)
+	ssz.DefineStaticBytes(codec, &b.Pubkey)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKzgCommitments, maxBuilderBidCommitments,
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the BuilderBid object.
+func (b *BuilderBid) HashTreeRoot() common.Root {
+	return ssz.HashSequential(b)
+}
+
+// MarshalSSZ marshals the BuilderBid object to SSZ format.
+func (b *BuilderBid) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ unmarshals the BuilderBid object from SSZ format.
+func (b *BuilderBid) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// GetHeader returns the header of the offered execution payload.
+func (b *BuilderBid) GetHeader() *ExecutionPayloadHeader {
+	return b.Header
+}
+
+// GetBlobKzgCommitments returns the commitments to the blobs of the offered
+// execution payload.
+func (
+	b *BuilderBid,
+) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return b.BlobKzgCommitments
+}
+
+// GetValue returns the value of the bid in wei.
+func (b *BuilderBid) GetValue() *math.U256 {
+	return b.Value
+}
+
+// GetPubkey returns the public key of the builder.
+func (b *BuilderBid) GetPubkey() crypto.BLSPubkey {
+	return b.Pubkey
+}
+
+// SignedBuilderBid is a BuilderBid signed by the builder under the
+// application builder domain.
+type SignedBuilderBid struct {
+	// Message is the bid.
+	Message *BuilderBid `json:"message"`
+	// Signature is the builder's signature over the bid.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// IsNil checks if the SignedBuilderBid is nil.
+func (b *SignedBuilderBid) IsNil() bool {
+	return b == nil
+}
+
+// GetMessage returns the bid.
+func (b *SignedBuilderBid) GetMessage() *BuilderBid {
+	return b.Message
+}
+
+// GetSignature returns the signature of the bid.
+func (b *SignedBuilderBid) GetSignature() crypto.BLSSignature {
+	return b.Signature
+}
+
+// Verify verifies that the bid is complete and was signed by the builder it
+// names.
+func (b *SignedBuilderBid) Verify(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	if b.Message == nil || b.Message.Header == nil || b.Message.Value == nil {
+		return ErrIncompleteBuilderBid
+	}
+	signingRoot := ComputeSigningRoot(
+		b.Message, forkData.ComputeDomain(domainType),
+	)
+	if err := signatureVerificationFn(
+		b.Message.Pubkey, signingRoot[:], b.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidBuilderBidSignature)
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                       ExecutionPayloadAndBlobsBundle                       */
+/* -------------------------------------------------------------------------- */
+
+// ExecutionPayloadAndBlobsBundle is the payload revealed by an external
+// builder for a signed blinded block, along with the blobs it carries.
+type ExecutionPayloadAndBlobsBundle struct {
+	// ExecutionPayload is the revealed execution payload.
+	ExecutionPayload *ExecutionPayload `json:"execution_payload"`
+	// BlobsBundle holds the blobs, commitments and proofs of the payload.
+	BlobsBundle *engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	] `json:"blobs_bundle"`
+}
+
+// IsNil checks if the ExecutionPayloadAndBlobsBundle is nil.
+func (p *ExecutionPayloadAndBlobsBundle) IsNil() bool {
+	return p == nil
+}
+
+// GetExecutionPayload returns the revealed execution payload.
+func (
+	p *ExecutionPayloadAndBlobsBundle,
+) GetExecutionPayload() *ExecutionPayload {
+	return p.ExecutionPayload
+}
+
+// GetBlobsBundle returns the blobs bundle of the revealed execution payload.
+func (
+	p *ExecutionPayloadAndBlobsBundle,
+) GetBlobsBundle() engineprimitives.BlobsBundle {
+	if p.BlobsBundle == nil {
+		return nil
+	}
+	return p.BlobsBundle
+}
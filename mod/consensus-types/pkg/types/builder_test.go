@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedValidatorRegistration_New(t *testing.T) {
+	forkData := &types.ForkData{}
+	domainType := common.DomainType{0x00, 0x00, 0x00, 0x01}
+
+	signer := &mocks.BLSSigner{}
+	signer.On("PublicKey").Return(crypto.BLSPubkey{0x01})
+	signer.On("Sign", mock.Anything).Return(crypto.BLSSignature{0x02}, nil)
+
+	signed, err := new(types.SignedValidatorRegistration).New(
+		forkData, domainType, signer,
+		common.ExecutionAddress{0x03}, 30_000_000, 1_700_000_000,
+	)
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSPubkey{0x01}, signed.GetMessage().Pubkey)
+	require.Equal(t, math.U64(30_000_000), signed.GetMessage().GasLimit)
+	require.Equal(t, crypto.BLSSignature{0x02}, signed.GetSignature())
+
+	signingRoot := types.ComputeSigningRoot(
+		signed.GetMessage(), forkData.ComputeDomain(domainType),
+	)
+	signer.AssertCalled(t, "Sign", signingRoot[:])
+
+	bz, err := signed.GetMessage().MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, 84)
+}
+
+func TestBuilderBid_MarshalUnmarshalSSZ(t *testing.T) {
+	bid := &types.BuilderBid{
+		Header: &types.ExecutionPayloadHeader{
+			BaseFeePerGas: math.NewU256(7),
+			ExtraData:     []byte("builder"),
+		},
+		BlobKzgCommitments: []eip4844.KZGCommitment{{0x01}, {0x02}},
+		Value:              math.NewU256(1_000_000),
+		Pubkey:             crypto.BLSPubkey{0x04},
+	}
+	bz, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded := new(types.BuilderBid)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, bid.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t, bid.GetValue(), decoded.GetValue())
+	require.Len(t, decoded.GetBlobKzgCommitments(), 2)
+}
+
+func TestSignedBuilderBid_Verify(t *testing.T) {
+	forkData := &types.ForkData{}
+	domainType := common.DomainType{0x00, 0x00, 0x00, 0x01}
+	bid := &types.SignedBuilderBid{
+		Message: &types.BuilderBid{
+			Header: &types.ExecutionPayloadHeader{
+				BaseFeePerGas: math.NewU256(7),
+			},
+			Value:  math.NewU256(1),
+			Pubkey: crypto.BLSPubkey{0x04},
+		},
+		Signature: crypto.BLSSignature{0x05},
+	}
+	signingRoot := types.ComputeSigningRoot(
+		bid.GetMessage(), forkData.ComputeDomain(domainType),
+	)
+
+	var verified bool
+	require.NoError(t, bid.Verify(
+		forkData, domainType,
+		func(
+			pubkey crypto.BLSPubkey, msg []byte, sig crypto.BLSSignature,
+		) error {
+			require.Equal(t, bid.GetMessage().GetPubkey(), pubkey)
+			require.Equal(t, signingRoot[:], msg)
+			require.Equal(t, bid.GetSignature(), sig)
+			verified = true
+			return nil
+		},
+	))
+	require.True(t, verified)
+
+	errBadSig := errors.New("bad signature")
+	err := bid.Verify(
+		forkData, domainType,
+		func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error {
+			return errBadSig
+		},
+	)
+	require.ErrorIs(t, err, types.ErrInvalidBuilderBidSignature)
+	require.ErrorIs(t, err, errBadSig)
+
+	bid.Message.Value = nil
+	require.ErrorIs(
+		t,
+		bid.Verify(forkData, domainType, nil),
+		types.ErrIncompleteBuilderBid,
+	)
+}
@@ -38,4 +38,20 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrIncompleteBuilderBid is an error for when a builder bid is missing
+	// its header or value.
+	ErrIncompleteBuilderBid = errors.New("incomplete builder bid")
+
+	// ErrInvalidBuilderBidSignature is an error for when the signature of a
+	// builder bid does not verify against the builder's pubkey.
+	ErrInvalidBuilderBidSignature = errors.New(
+		"invalid builder bid signature",
+	)
+
+	// ErrPayloadBodyMismatch is an error for when the body of an execution
+	// payload does not match the payload header it is unblinded with.
+	ErrPayloadBodyMismatch = errors.New(
+		"payload body does not match the payload header",
+	)
 )
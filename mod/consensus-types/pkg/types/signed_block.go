@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/karalabe/ssz"
+)
+
+// SignedBeaconBlock is a BeaconBlock signed by its proposer.
+type SignedBeaconBlock struct {
+	// Message is the block.
+	Message *BeaconBlock `json:"message"`
+	// Signature is the proposer's signature over the block.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the SignedBeaconBlock object in SSZ encoding.
+func (b *SignedBeaconBlock) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // offset and signature.
+	var size = uint32(4 + 96)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(b.Message)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedBeaconBlock object.
+func (b *SignedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineDynamicObjectOffset(codec, &b.Message)
+	ssz.DefineStaticBytes(codec, &b.Signature)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Message)
+}
+
+// MarshalSSZ marshals the SignedBeaconBlock object to SSZ format.
+func (b *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ unmarshals the SignedBeaconBlock object from SSZ format.
+func (b *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot computes the Merkleization of the SignedBeaconBlock object.
+func (b *SignedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Getters                                  */
+/* -------------------------------------------------------------------------- */
+
+// IsNil checks if the SignedBeaconBlock is nil.
+func (b *SignedBeaconBlock) IsNil() bool {
+	return b == nil
+}
+
+// GetMessage returns the block.
+func (b *SignedBeaconBlock) GetMessage() *BeaconBlock {
+	return b.Message
+}
+
+// GetSignature returns the proposer's signature over the block.
+func (b *SignedBeaconBlock) GetSignature() crypto.BLSSignature {
+	return b.Signature
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockFromSignedSSZ(t *testing.T) {
+	originalBlock := generateValidBeaconBlock()
+	originalBlock.SetSignature(crypto.BLSSignature{1, 2, 3})
+
+	bz, err := originalBlock.MarshalSignedSSZ()
+	require.NoError(t, err)
+
+	signed := &types.SignedBeaconBlock{}
+	require.NoError(t, signed.UnmarshalSSZ(bz))
+	require.Equal(t, originalBlock.GetSignature(), signed.GetSignature())
+	require.Equal(t,
+		originalBlock.HashTreeRoot(), signed.GetMessage().HashTreeRoot(),
+	)
+
+	blk, err := (&types.BeaconBlock{}).NewFromSignedSSZ(bz, version.Deneb)
+	require.NoError(t, err)
+	require.Equal(t, originalBlock, blk)
+
+	// The signature is not part of the block.
+	blk.SetSignature(crypto.BLSSignature{})
+	require.Equal(t, originalBlock.HashTreeRoot(), blk.HashTreeRoot())
+}
+
+func TestBeaconBlockFromSignedSSZForkVersionNotSupported(t *testing.T) {
+	_, err := (&types.BeaconBlock{}).NewFromSignedSSZ([]byte{}, 1)
+	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
+}
+
+func TestForkData_ComputeBlockSigningRoot(t *testing.T) {
+	var (
+		fd = &types.ForkData{
+			CurrentVersion:        common.Version{1},
+			GenesisValidatorsRoot: common.Root{2},
+		}
+		domainType = common.DomainType{0, 0, 0, 0}
+		blk        = generateValidBeaconBlock()
+	)
+	require.Equal(t,
+		types.ComputeSigningRoot(blk, fd.ComputeDomain(domainType)),
+		fd.ComputeBlockSigningRoot(domainType, blk.HashTreeRoot()),
+	)
+}
@@ -21,14 +21,23 @@
 package types
 
 import (
+	"io"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
 
+// HistoricalSummariesLimit is the maximum number of historical summaries in
+// the beacon state, HISTORICAL_ROOTS_LIMIT in the Ethereum 2.0 specification.
+const HistoricalSummariesLimit = 16777216
+
 // BeaconState represents the entire state of the beacon chain.
+//
+//nolint:lll // struct tags.
 type BeaconState[
 	BeaconBlockHeaderT constraints.
 		StaticSSZField[BeaconBlockHeaderT, B],
@@ -43,34 +52,41 @@ type BeaconState[
 	B, E, P, F, V any,
 ] struct {
 	// Versioning
-	GenesisValidatorsRoot common.Root
-	Slot                  math.Slot
-	Fork                  ForkT
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+	Slot                  math.Slot   `json:"slot"`
+	Fork                  ForkT       `json:"fork"`
 
 	// History
-	LatestBlockHeader BeaconBlockHeaderT
-	BlockRoots        []common.Root
-	StateRoots        []common.Root
+	LatestBlockHeader BeaconBlockHeaderT `json:"latest_block_header"`
+	BlockRoots        []common.Root      `json:"block_roots"`
+	StateRoots        []common.Root      `json:"state_roots"`
 
 	// Eth1
-	Eth1Data                     Eth1DataT
-	Eth1DepositIndex             uint64
-	LatestExecutionPayloadHeader ExecutionPayloadHeaderT
+	Eth1Data                     Eth1DataT               `json:"eth1_data"`
+	Eth1DepositIndex             uint64                  `json:"eth1_deposit_index"`
+	LatestExecutionPayloadHeader ExecutionPayloadHeaderT `json:"latest_execution_payload_header"`
 
 	// Registry
-	Validators []ValidatorT
-	Balances   []uint64
+	Validators []ValidatorT `json:"validators"`
+	Balances   []uint64     `json:"balances"`
 
 	// Randomness
-	RandaoMixes []common.Bytes32
+	RandaoMixes []common.Bytes32 `json:"randao_mixes"`
 
 	// Withdrawals
-	NextWithdrawalIndex          uint64
-	NextWithdrawalValidatorIndex math.ValidatorIndex
+	NextWithdrawalIndex          uint64              `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex math.ValidatorIndex `json:"next_withdrawal_validator_index"`
 
 	// Slashing
-	Slashings     []math.Gwei
-	TotalSlashing math.Gwei
+	Slashings     []math.Gwei `json:"slashings"`
+	TotalSlashing math.Gwei   `json:"total_slashing"`
+
+	// Historical summaries, only part of the state from DenebPlus on.
+	HistoricalSummaries []*common.HistoricalSummary `json:"historical_summaries,omitempty"`
+
+	// isDenebPlus is whether the state is laid out for DenebPlus, which adds
+	// the historical summaries. States are Deneb states by default.
+	isDenebPlus bool
 }
 
 // New creates a new BeaconState.
@@ -82,7 +98,7 @@ func (st *BeaconState[
 	ValidatorT,
 	B, E, P, F, V,
 ]) New(
-	_ uint32,
+	forkVersion uint32,
 	genesisValidatorsRoot common.Root,
 	slot math.Slot,
 	fork ForkT,
@@ -99,6 +115,7 @@ func (st *BeaconState[
 	nextWithdrawalValidatorIndex math.ValidatorIndex,
 	slashings []math.Gwei,
 	totalSlashing math.Gwei,
+	historicalSummaries []*common.HistoricalSummary,
 ) (*BeaconState[
 	BeaconBlockHeaderT,
 	Eth1DataT,
@@ -131,9 +148,59 @@ func (st *BeaconState[
 		NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
 		Slashings:                    slashings,
 		TotalSlashing:                totalSlashing,
+		HistoricalSummaries:          historicalSummaries,
+		isDenebPlus:                  forkVersion >= version.DenebPlus,
 	}, nil
 }
 
+// NewFromSSZ creates a new BeaconState from the given SSZ bytes.
+func (st *BeaconState[
+	BeaconBlockHeaderT,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ForkT,
+	ValidatorT,
+	B, E, P, F, V,
+]) NewFromSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*BeaconState[
+	BeaconBlockHeaderT,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ForkT,
+	ValidatorT,
+	B, E, P, F, V,
+], error) {
+	st = &BeaconState[
+		BeaconBlockHeaderT,
+		Eth1DataT,
+		ExecutionPayloadHeaderT,
+		ForkT,
+		ValidatorT,
+		B, E, P, F, V,
+	]{isDenebPlus: forkVersion >= version.DenebPlus}
+	return st, st.UnmarshalSSZ(bz)
+}
+
+// Version returns the fork version the BeaconState is laid out for.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) Version() uint32 {
+	if st.isDenebPlus {
+		return version.DenebPlus
+	}
+	return version.Deneb
+}
+
+// hasHistoricalSummaries returns whether the state holds the historical
+// summaries, which it does from DenebPlus on.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) hasHistoricalSummaries() bool {
+	return st.isDenebPlus
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -143,6 +210,9 @@ func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
 ]) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 300
+	if st.hasHistoricalSummaries() {
+		size += 4
+	}
 
 	if fixed {
 		return size
@@ -156,6 +226,9 @@ func (st *BeaconState[
 	size += ssz.SizeSliceOfUint64s(st.Balances)
 	size += ssz.SizeSliceOfStaticBytes(st.RandaoMixes)
 	size += ssz.SizeSliceOfUint64s(st.Slashings)
+	if st.hasHistoricalSummaries() {
+		size += ssz.SizeSliceOfStaticObjects(st.HistoricalSummaries)
+	}
 
 	return size
 }
@@ -196,6 +269,13 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
 	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
 
+	// Historical summaries
+	if st.hasHistoricalSummaries() {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &st.HistoricalSummaries, HistoricalSummariesLimit,
+		)
+	}
+
 	// Dynamic content
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.BlockRoots, 8192)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.StateRoots, 8192)
@@ -204,6 +284,11 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sContent(codec, &st.Balances, 1099511627776)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.RandaoMixes, 65536)
 	ssz.DefineSliceOfUint64sContent(codec, &st.Slashings, 1099511627776)
+	if st.hasHistoricalSummaries() {
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &st.HistoricalSummaries, HistoricalSummariesLimit,
+		)
+	}
 }
 
 // MarshalSSZ marshals the BeaconState into SSZ format.
@@ -214,6 +299,14 @@ func (st *BeaconState[
 	return buf, ssz.EncodeToBytes(buf, st)
 }
 
+// MarshalSSZToWriter streams the SSZ encoding of the BeaconState into the
+// given writer without materialising the full encoding in memory.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) MarshalSSZToWriter(w io.Writer) error {
+	return ssz.EncodeToStream(w, st)
+}
+
 // UnmarshalSSZ unmarshals the BeaconState from SSZ format.
 func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
@@ -228,6 +321,129 @@ func (st *BeaconState[
 	return ssz.HashConcurrent(st)
 }
 
+/* -------------------------------------------------------------------------- */
+/*                                   Getters                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetGenesisValidatorsRoot returns the genesis validators root.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetGenesisValidatorsRoot() common.Root {
+	return st.GenesisValidatorsRoot
+}
+
+// GetSlot returns the slot.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetSlot() math.Slot {
+	return st.Slot
+}
+
+// GetFork returns the fork.
+func (st *BeaconState[
+	_, _, _, ForkT, _, _, _, _, _, _,
+]) GetFork() ForkT {
+	return st.Fork
+}
+
+// GetLatestBlockHeader returns the latest block header.
+func (st *BeaconState[
+	BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _,
+]) GetLatestBlockHeader() BeaconBlockHeaderT {
+	return st.LatestBlockHeader
+}
+
+// GetBlockRoots returns the historical block roots.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetBlockRoots() []common.Root {
+	return st.BlockRoots
+}
+
+// GetStateRoots returns the historical state roots.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetStateRoots() []common.Root {
+	return st.StateRoots
+}
+
+// GetEth1Data returns the eth1 data.
+func (st *BeaconState[
+	_, Eth1DataT, _, _, _, _, _, _, _, _,
+]) GetEth1Data() Eth1DataT {
+	return st.Eth1Data
+}
+
+// GetEth1DepositIndex returns the eth1 deposit index.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetEth1DepositIndex() uint64 {
+	return st.Eth1DepositIndex
+}
+
+// GetLatestExecutionPayloadHeader returns the latest execution payload header.
+func (st *BeaconState[
+	_, _, ExecutionPayloadHeaderT, _, _, _, _, _, _, _,
+]) GetLatestExecutionPayloadHeader() ExecutionPayloadHeaderT {
+	return st.LatestExecutionPayloadHeader
+}
+
+// GetValidators returns the validators.
+func (st *BeaconState[
+	_, _, _, _, ValidatorT, _, _, _, _, _,
+]) GetValidators() []ValidatorT {
+	return st.Validators
+}
+
+// GetBalances returns the validator balances.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetBalances() []uint64 {
+	return st.Balances
+}
+
+// GetRandaoMixes returns the randao mixes.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetRandaoMixes() []common.Bytes32 {
+	return st.RandaoMixes
+}
+
+// GetNextWithdrawalIndex returns the next withdrawal index.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetNextWithdrawalIndex() uint64 {
+	return st.NextWithdrawalIndex
+}
+
+// GetNextWithdrawalValidatorIndex returns the next withdrawal validator index.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetNextWithdrawalValidatorIndex() math.ValidatorIndex {
+	return st.NextWithdrawalValidatorIndex
+}
+
+// GetSlashings returns the slashings.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetSlashings() []math.Gwei {
+	return st.Slashings
+}
+
+// GetTotalSlashing returns the total slashing.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetTotalSlashing() math.Gwei {
+	return st.TotalSlashing
+}
+
+// GetHistoricalSummaries returns the historical summaries.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetHistoricalSummaries() []*common.HistoricalSummary {
+	return st.HistoricalSummaries
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   FastSSZ                                  */
 /* -------------------------------------------------------------------------- */
@@ -391,6 +607,21 @@ func (st *BeaconState[
 	// Field (15) 'TotalSlashing'
 	hh.PutUint64(uint64(st.TotalSlashing))
 
+	// Field (16) 'HistoricalSummaries'
+	if st.hasHistoricalSummaries() {
+		subIndx = hh.Index()
+		num = uint64(len(st.HistoricalSummaries))
+		if num > HistoricalSummariesLimit {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range st.HistoricalSummaries {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, HistoricalSummariesLimit)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -27,12 +27,14 @@
 package types_test
 
 import (
+	"bytes"
 	"io"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	karalabessz "github.com/karalabe/ssz"
 	"github.com/stretchr/testify/require"
 )
@@ -222,6 +224,79 @@ func TestBeaconState_MarshalSSZTo(t *testing.T) {
 	require.Equal(t, data, buf)
 }
 
+func TestBeaconState_NewFromSSZ(t *testing.T) {
+	state := generateValidBeaconState()
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded, err := state.NewFromSSZ(data, 0)
+	require.NoError(t, err)
+	require.Equal(t, state.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t, state.GetSlot(), decoded.GetSlot())
+	require.Equal(t, state.GetValidators(), decoded.GetValidators())
+}
+
+func TestBeaconState_HistoricalSummariesDenebPlus(t *testing.T) {
+	deneb := generateValidBeaconState()
+	denebRoot := deneb.HashTreeRoot()
+	denebPlus, err := deneb.New(
+		version.DenebPlus,
+		deneb.GenesisValidatorsRoot,
+		deneb.Slot,
+		deneb.Fork,
+		deneb.LatestBlockHeader,
+		deneb.BlockRoots,
+		deneb.StateRoots,
+		deneb.Eth1Data,
+		deneb.Eth1DepositIndex,
+		deneb.LatestExecutionPayloadHeader,
+		deneb.Validators,
+		deneb.Balances,
+		deneb.RandaoMixes,
+		deneb.NextWithdrawalIndex,
+		deneb.NextWithdrawalValidatorIndex,
+		deneb.Slashings,
+		deneb.TotalSlashing,
+		[]*common.HistoricalSummary{
+			{BlockSummaryRoot: common.Root{0x44}},
+			{StateSummaryRoot: common.Root{0x45}},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, version.Deneb, deneb.Version())
+	require.Equal(t, version.DenebPlus, denebPlus.Version())
+	require.Equal(t, uint32(300), deneb.SizeSSZ(true))
+	require.Equal(t, uint32(304), denebPlus.SizeSSZ(true))
+
+	// The summaries are not part of the Deneb layout.
+	deneb.HistoricalSummaries = denebPlus.HistoricalSummaries
+	require.Equal(t, denebRoot, deneb.HashTreeRoot())
+	require.NotEqual(t, denebRoot, denebPlus.HashTreeRoot())
+
+	data, err := denebPlus.MarshalSSZ()
+	require.NoError(t, err)
+	decoded, err := deneb.NewFromSSZ(data, version.DenebPlus)
+	require.NoError(t, err)
+	require.Equal(t, denebPlus.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t,
+		denebPlus.HistoricalSummaries, decoded.GetHistoricalSummaries(),
+	)
+	_, err = deneb.NewFromSSZ(data, version.Deneb)
+	require.Error(t, err)
+}
+
+func TestBeaconState_MarshalSSZToWriter(t *testing.T) {
+	state := generateValidBeaconState()
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, state.MarshalSSZToWriter(&buf))
+
+	// Streaming must produce the same encoding as MarshalSSZ.
+	require.Equal(t, data, buf.Bytes())
+}
+
 func TestBeaconState_HashTreeRoot(t *testing.T) {
 	state := generateValidBeaconState()
 
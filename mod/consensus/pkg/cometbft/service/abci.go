@@ -29,17 +29,16 @@ import (
 	"sort"
 
 	"cosmossdk.io/store/rootmulti"
-	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
-	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
 	errorsmod "github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/spans"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
-	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
 	"github.com/sourcegraph/conc/iter"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -84,7 +83,7 @@ func (s *Service[LoggerT]) InitChain(
 		}
 	}
 
-	s.finalizeBlockState = s.resetState()
+	s.finalizeBlockState = s.resetState(s.initialHeight)
 
 	resValidators, err := s.initChainer(
 		s.finalizeBlockState.Context(),
@@ -182,7 +181,7 @@ func (s *Service[LoggerT]) Info(
 // PrepareProposal implements the PrepareProposal ABCI method and returns a
 // ResponsePrepareProposal object to the client.
 func (s *Service[LoggerT]) PrepareProposal(
-	_ context.Context,
+	ctx context.Context,
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
 	// CometBFT must never call PrepareProposal with a height of 0.
@@ -194,9 +193,12 @@ func (s *Service[LoggerT]) PrepareProposal(
 		)
 	}
 
+	span := startSpan(ctx, "PrepareProposal", req.Height)
+	defer span.End()
+
 	// Always reset state given that PrepareProposal can timeout
 	// and be called again in a subsequent round.
-	s.prepareProposalState = s.resetState()
+	s.prepareProposalState = s.resetState(req.Height)
 	s.prepareProposalState.SetContext(
 		s.getContextForProposal(
 			s.prepareProposalState.Context(),
@@ -204,15 +206,17 @@ func (s *Service[LoggerT]) PrepareProposal(
 		),
 	)
 
-	blkBz, sidecarsBz, err := s.Middleware.PrepareProposal(
-		s.prepareProposalState.Context(), &types.SlotData[
-			*ctypes.AttestationData,
-			*ctypes.SlashingInfo,
-		]{
-			Slot: math.Slot(req.Height),
-		},
+	slotData, err := s.slotDataFromPrepareProposal(
+		s.prepareProposalState.Context(), req,
 	)
+	var blkBz, sidecarsBz []byte
+	if err == nil {
+		blkBz, sidecarsBz, err = s.Middleware.PrepareProposal(
+			withSpan(s.prepareProposalState.Context(), span), slotData,
+		)
+	}
 	if err != nil {
+		spans.RecordError(span, err)
 		s.logger.Error(
 			"failed to prepare proposal",
 			"height",
@@ -233,7 +237,7 @@ func (s *Service[LoggerT]) PrepareProposal(
 // ProcessProposal implements the ProcessProposal ABCI method and returns a
 // ResponseProcessProposal object to the client.
 func (s *Service[LoggerT]) ProcessProposal(
-	_ context.Context,
+	ctx context.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
 	// CometBFT must never call ProcessProposal with a height of 0.
@@ -245,6 +249,9 @@ func (s *Service[LoggerT]) ProcessProposal(
 		)
 	}
 
+	span := startSpan(ctx, "ProcessProposal", req.Height)
+	defer span.End()
+
 	// Since the application can get access to FinalizeBlock state and write to
 	// it, we must be sure to reset it in case ProcessProposal timeouts and is
 	// called
@@ -252,9 +259,9 @@ func (s *Service[LoggerT]) ProcessProposal(
 	// processed the first block, as we want to avoid overwriting the
 	// finalizeState
 	// after state changes during InitChain.
-	s.processProposalState = s.resetState()
+	s.processProposalState = s.resetState(req.Height)
 	if req.Height > s.initialHeight {
-		s.finalizeBlockState = s.resetState()
+		s.finalizeBlockState = s.resetState(req.Height)
 	}
 
 	s.processProposalState.SetContext(
@@ -264,11 +271,18 @@ func (s *Service[LoggerT]) ProcessProposal(
 		),
 	)
 
-	resp, err := s.Middleware.ProcessProposal(
-		s.processProposalState.Context(),
-		req,
+	err := s.validateProposalCometBFTData(
+		s.processProposalState.Context(), req,
 	)
+	var resp *cmtabci.ProcessProposalResponse
+	if err == nil {
+		resp, err = s.Middleware.ProcessProposal(
+			withSpan(s.processProposalState.Context(), span),
+			req,
+		)
+	}
 	if err != nil {
+		spans.RecordError(span, err)
 		s.logger.Error(
 			"failed to process proposal",
 			"height",
@@ -289,6 +303,7 @@ func (s *Service[LoggerT]) ProcessProposal(
 }
 
 func (s *Service[LoggerT]) internalFinalizeBlock(
+	span trace.Span,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
 	if err := s.validateFinalizeBlockHeight(req); err != nil {
@@ -300,7 +315,7 @@ func (s *Service[LoggerT]) internalFinalizeBlock(
 	// here given that during block replay ProcessProposal is not executed by
 	// CometBFT.
 	if s.finalizeBlockState == nil {
-		s.finalizeBlockState = s.resetState()
+		s.finalizeBlockState = s.resetState(req.Height)
 	}
 
 	// Iterate over all raw transactions in the proposal and attempt to execute
@@ -321,7 +336,7 @@ func (s *Service[LoggerT]) internalFinalizeBlock(
 	}
 
 	finalizeBlock, err := s.Middleware.FinalizeBlock(
-		s.finalizeBlockState.Context(),
+		withSpan(s.finalizeBlockState.Context(), span),
 		req,
 	)
 	if err != nil {
@@ -384,10 +399,16 @@ func (s *Service[_]) validateFinalizeBlockHeight(
 }
 
 func (s *Service[_]) FinalizeBlock(
-	_ context.Context,
+	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
-	res, err := s.internalFinalizeBlock(req)
+	span := startSpan(ctx, "FinalizeBlock", req.Height)
+	defer span.End()
+
+	res, err := s.internalFinalizeBlock(span, req)
+	if err != nil {
+		spans.RecordError(span, err)
+	}
 	if res != nil {
 		res.AppHash = s.workingHash()
 	}
@@ -403,7 +424,7 @@ func (s *Service[_]) FinalizeBlock(
 // against that height and gracefully halt if it matches the latest committed
 // height.
 func (s *Service[LoggerT]) Commit(
-	context.Context, *cmtabci.CommitRequest,
+	ctx context.Context, _ *cmtabci.CommitRequest,
 ) (*cmtabci.CommitResponse, error) {
 	if s.finalizeBlockState == nil {
 		// This is unexpected since CometBFT should call Commit only
@@ -411,6 +432,9 @@ func (s *Service[LoggerT]) Commit(
 		panic(fmt.Errorf("commit: %w", errNilFinalizeBlockState))
 	}
 	header := s.finalizeBlockState.Context().BlockHeader()
+	span := startSpan(ctx, "Commit", header.Height)
+	defer span.End()
+
 	retainHeight := s.GetBlockRetentionHeight(header.Height)
 
 	rms, ok := s.sm.CommitMultiStore().(*rootmulti.Store)
@@ -421,6 +445,10 @@ func (s *Service[LoggerT]) Commit(
 
 	s.finalizeBlockState = nil
 
+	// The snapshot, if any, is taken in the background from the version just
+	// committed.
+	s.snapshotManager.SnapshotIfApplicable(header.Height)
+
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
 	}, nil
@@ -599,6 +627,16 @@ func (s *Service[_]) GetBlockRetentionHeight(commitHeight int64) int64 {
 		retentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
 	}
 
+	if s.snapshotManager != nil {
+		snapshotRetentionHeights := s.snapshotManager.
+			GetSnapshotBlockRetentionHeights()
+		if snapshotRetentionHeights > 0 {
+			retentionHeight = minNonZero(
+				retentionHeight, commitHeight-snapshotRetentionHeights,
+			)
+		}
+	}
+
 	//#nosec:G701 // bet.
 	v := commitHeight - int64(s.minRetainBlocks)
 	retentionHeight = minNonZero(retentionHeight, v)
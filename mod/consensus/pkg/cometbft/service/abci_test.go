@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+var latestBlockRoot = common.Root{0xaa}
+
+// validatorResolver resolves CometBFT addresses from a fixed table.
+type validatorResolver map[string]math.ValidatorIndex
+
+func (r validatorResolver) ValidatorIndexByCometBFTAddress(
+	_ context.Context,
+	address []byte,
+) (math.ValidatorIndex, error) {
+	index, ok := r[string(address)]
+	if !ok {
+		return 0, errors.New("unknown validator")
+	}
+	return index, nil
+}
+
+func (validatorResolver) LatestBlockRoot(
+	context.Context,
+) (common.Root, error) {
+	return latestBlockRoot, nil
+}
+
+// middleware records the slot data it is asked to build a block for and
+// accepts every proposal.
+type middleware struct {
+	slotData *types.SlotData[*ctypes.AttestationData, *ctypes.SlashingInfo]
+}
+
+func (*middleware) Name() string { return "middleware" }
+
+func (*middleware) InitGenesis(
+	context.Context, []byte,
+) (transition.ValidatorUpdates, error) {
+	return nil, nil
+}
+
+func (m *middleware) PrepareProposal(
+	_ context.Context,
+	slotData *types.SlotData[*ctypes.AttestationData, *ctypes.SlashingInfo],
+) ([]byte, []byte, error) {
+	m.slotData = slotData
+	return []byte{0x01}, []byte{0x02}, nil
+}
+
+func (*middleware) ProcessProposal(
+	context.Context, *cmtabci.ProcessProposalRequest,
+) (*cmtabci.ProcessProposalResponse, error) {
+	return &cmtabci.ProcessProposalResponse{
+		Status: cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+	}, nil
+}
+
+func (*middleware) FinalizeBlock(
+	context.Context, *cmtabci.FinalizeBlockRequest,
+) (transition.ValidatorUpdates, error) {
+	return nil, nil
+}
+
+func newDenebPlusService(t *testing.T, m *middleware) *cometbft.Service[
+	*phuslu.Logger,
+] {
+	t.Helper()
+	cfg := phuslu.DefaultConfig()
+	return cometbft.NewService(
+		storetypes.NewKVStoreKey("beacon"),
+		phuslu.NewLogger(io.Discard, &cfg),
+		dbm.NewMemDB(),
+		m,
+		nil,
+		chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:    32,
+			ElectraForkEpoch: 1 << 32,
+		}),
+		cometbft.SetValidatorResolver[*phuslu.Logger](validatorResolver{
+			"alice": 2,
+			"bob":   1,
+			"carol": 3,
+		}),
+	)
+}
+
+func TestPrepareProposalSlotData(t *testing.T) {
+	m := &middleware{}
+	s := newDenebPlusService(t, m)
+
+	resp, err := s.PrepareProposal(
+		context.Background(),
+		&cmtabci.PrepareProposalRequest{
+			Height: 5,
+			LocalLastCommit: v1.ExtendedCommitInfo{
+				Votes: []v1.ExtendedVoteInfo{
+					{
+						Validator:   v1.Validator{Address: []byte("alice")},
+						BlockIdFlag: cmttypes.BlockIDFlagCommit,
+					},
+					{
+						Validator:   v1.Validator{Address: []byte("carol")},
+						BlockIdFlag: cmttypes.BlockIDFlagAbsent,
+					},
+					{
+						Validator:   v1.Validator{Address: []byte("bob")},
+						BlockIdFlag: cmttypes.BlockIDFlagCommit,
+					},
+				},
+			},
+			Misbehavior: []v1.Misbehavior{
+				{Validator: v1.Validator{Address: []byte("carol")}, Height: 3},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Txs, 2)
+
+	require.Equal(t, math.Slot(5), m.slotData.GetSlot())
+	require.Equal(t, []*ctypes.AttestationData{
+		{Slot: 4, Index: 1, BeaconBlockRoot: latestBlockRoot},
+		{Slot: 4, Index: 2, BeaconBlockRoot: latestBlockRoot},
+	}, m.slotData.AttestationData)
+	require.Equal(t, []*ctypes.SlashingInfo{
+		{Slot: 3, Index: 3},
+	}, m.slotData.SlashingInfo)
+}
+
+func TestPrepareProposalUnknownValidator(t *testing.T) {
+	m := &middleware{}
+	s := newDenebPlusService(t, m)
+
+	req := &cmtabci.PrepareProposalRequest{
+		Height: 5,
+		Txs:    [][]byte{{0x03}},
+		LocalLastCommit: v1.ExtendedCommitInfo{
+			Votes: []v1.ExtendedVoteInfo{{
+				Validator:   v1.Validator{Address: []byte("mallory")},
+				BlockIdFlag: cmttypes.BlockIDFlagCommit,
+			}},
+		},
+	}
+	resp, err := s.PrepareProposal(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, req.Txs, resp.Txs)
+	require.Nil(t, m.slotData)
+}
+
+func TestProcessProposalCometBFTData(t *testing.T) {
+	votes := []v1.VoteInfo{
+		{
+			Validator:   v1.Validator{Address: []byte("alice")},
+			BlockIdFlag: cmttypes.BlockIDFlagCommit,
+		},
+		{
+			Validator:   v1.Validator{Address: []byte("bob")},
+			BlockIdFlag: cmttypes.BlockIDFlagNil,
+		},
+	}
+	misbehaviors := []v1.Misbehavior{
+		{Validator: v1.Validator{Address: []byte("carol")}, Height: 3},
+	}
+	attestations := []*ctypes.AttestationData{
+		{Slot: 4, Index: 2, BeaconBlockRoot: latestBlockRoot},
+	}
+	slashingInfo := []*ctypes.SlashingInfo{{Slot: 3, Index: 3}}
+
+	for name, tc := range map[string]struct {
+		attestations []*ctypes.AttestationData
+		slashingInfo []*ctypes.SlashingInfo
+		status       cmtabci.ProcessProposalStatus
+	}{
+		"matching": {
+			attestations: attestations,
+			slashingInfo: slashingInfo,
+			status:       cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"missing attestations": {
+			slashingInfo: slashingInfo,
+			status:       cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"wrong attestation root": {
+			attestations: []*ctypes.AttestationData{
+				{Slot: 4, Index: 2, BeaconBlockRoot: common.Root{0xbb}},
+			},
+			slashingInfo: slashingInfo,
+			status:       cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"missing slashing info": {
+			attestations: attestations,
+			status:       cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := newDenebPlusService(t, &middleware{})
+
+			blk, err := (&ctypes.BeaconBlock{}).NewWithVersion(
+				5, 0, common.Root{}, version.DenebPlus,
+			)
+			require.NoError(t, err)
+			blk.Body.Eth1Data = &ctypes.Eth1Data{}
+			blk.Body.ExecutionPayload = &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			}
+			blk.Body.SetAttestations(tc.attestations)
+			blk.Body.SetSlashingInfo(tc.slashingInfo)
+			bz, err := blk.MarshalSignedSSZ()
+			require.NoError(t, err)
+			tx, err := encoding.EncodeTx(
+				bz, encoding.ContentTypeSignedBeaconBlock, version.DenebPlus,
+				encoding.CompressionNone,
+			)
+			require.NoError(t, err)
+
+			resp, err := s.ProcessProposal(
+				context.Background(),
+				&cmtabci.ProcessProposalRequest{
+					Height:             5,
+					Txs:                [][]byte{tx},
+					ProposedLastCommit: v1.CommitInfo{Votes: votes},
+					Misbehavior:        misbehaviors,
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, resp.Status)
+		})
+	}
+}
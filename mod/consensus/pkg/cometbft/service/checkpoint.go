@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package cometbft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	errNoSnapshotManager = errors.New(
+		"checkpoint sync requires a snapshot manager to restore snapshots",
+	)
+	errNotEnoughStateSyncRPCServers = errors.New(
+		"checkpoint sync requires at least two statesync rpc servers",
+	)
+	errNoTrustOptions = errors.New(
+		"checkpoint sync requires a statesync trust height and hash",
+	)
+	errCheckpointHeightMismatch = errors.New(
+		"checkpoint slot does not match the restored height",
+	)
+)
+
+// syncFromCheckpoint bootstraps a fresh node from a trusted checkpoint
+// through CometBFT state sync. The application store is restored from a
+// snapshot served by the peers of the node, which keeps the versions the
+// IAVL nodes were written at, so that it hashes to the app hash agreed upon
+// by the network. CometBFT verifies that app hash through the light client,
+// from the operator's trusted header, while the restored beacon state is
+// verified against the trusted checkpoint once the snapshot is applied.
+func (s *Service[_]) syncFromCheckpoint() error {
+	if s.checkpointSyncer == nil || s.LastBlockHeight() != 0 {
+		return nil
+	}
+	if s.snapshotManager == nil {
+		return errNoSnapshotManager
+	}
+	if err := s.validateTrustOptions(); err != nil {
+		return err
+	}
+	s.cmtCfg.StateSync.Enable = true
+	return nil
+}
+
+// verifyCheckpoint verifies the beacon state restored from a snapshot
+// against the trusted checkpoint, if checkpoint sync is enabled.
+func (s *Service[_]) verifyCheckpoint(ctx context.Context) error {
+	if s.checkpointSyncer == nil {
+		return nil
+	}
+
+	cms := s.sm.CommitMultiStore()
+	lastCommitID := cms.LastCommitID()
+	slot, err := s.checkpointSyncer.Verify(
+		sdk.NewContext(
+			cms.CacheMultiStore(), false,
+			servercmtlog.WrapSDKLogger(s.logger),
+		).WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	//#nosec:G115 // slot will never overflow an int64.
+	if int64(slot) != lastCommitID.Version {
+		return fmt.Errorf(
+			"%w: slot %d, height %d",
+			errCheckpointHeightMismatch, slot, lastCommitID.Version,
+		)
+	}
+
+	s.logger.Info(
+		"Restored application store from checkpoint",
+		"height", lastCommitID.Version,
+		"app_hash", fmt.Sprintf("%X", lastCommitID.Hash),
+	)
+	return nil
+}
+
+// validateTrustOptions ensures the operator configured the light client
+// trust options that the restored snapshot is verified against.
+func (s *Service[_]) validateTrustOptions() error {
+	cfg := s.cmtCfg.StateSync
+	switch {
+	// The light client cross-checks the primary RPC server against at least
+	// one witness.
+	case len(cfg.RPCServers) < 2:
+		return errNotEnoughStateSyncRPCServers
+	case cfg.TrustHeight <= 0 || cfg.TrustHash == "":
+		return errNoTrustOptions
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// maxDecodedPayloadSize is the upper bound on the size of a decompressed
+// proposal transaction, protecting against decompression bombs.
+const maxDecodedPayloadSize = 32 << 20
+
+// Compression identifies the algorithm used to compress an envelope payload.
+type Compression uint8
+
+const (
+	// CompressionNone indicates that the payload is stored uncompressed.
+	CompressionNone Compression = iota
+	// CompressionSnappy indicates that the payload is snappy block encoded.
+	CompressionSnappy
+	// CompressionZstd indicates that the payload is zstd encoded.
+	CompressionZstd
+)
+
+// compressionNames maps each supported compression to its config name.
+//
+//nolint:gochecknoglobals // static lookup table.
+var compressionNames = map[Compression]string{
+	CompressionNone:   "none",
+	CompressionSnappy: "snappy",
+	CompressionZstd:   "zstd",
+}
+
+// CompressionFromString parses the config name of a compression algorithm.
+func CompressionFromString(s string) (Compression, error) {
+	if s == "" {
+		return CompressionNone, nil
+	}
+	for c, name := range compressionNames {
+		if name == s {
+			return c, nil
+		}
+	}
+	return CompressionNone, errors.Wrapf(ErrUnknownCompression, "%q", s)
+}
+
+// String returns the config name of the compression algorithm.
+func (c Compression) String() string {
+	if name, ok := compressionNames[c]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// compress compresses the given bytes with the compression algorithm.
+func (c Compression) compress(bz []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return bz, nil
+	case CompressionSnappy:
+		return snappy.Encode(nil, bz), nil
+	case CompressionZstd:
+		enc, err := zstdEncoder()
+		if err != nil {
+			return nil, err
+		}
+		return enc.EncodeAll(bz, nil), nil
+	default:
+		return nil, ErrUnknownCompression
+	}
+}
+
+// decompress decompresses the given bytes with the compression algorithm.
+func (c Compression) decompress(bz []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return bz, nil
+	case CompressionSnappy:
+		n, err := snappy.DecodedLen(bz)
+		if err != nil {
+			return nil, err
+		}
+		if n > maxDecodedPayloadSize {
+			return nil, ErrPayloadTooLarge
+		}
+		return snappy.Decode(nil, bz)
+	case CompressionZstd:
+		dec, err := zstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		return dec.DecodeAll(bz, nil)
+	default:
+		return nil, ErrUnknownCompression
+	}
+}
+
+//nolint:gochecknoglobals // zstd coders are safe for concurrent use.
+var (
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+		return zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	})
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+		return zstd.NewReader(
+			nil,
+			zstd.WithDecoderConcurrency(0),
+			zstd.WithDecoderMaxMemory(maxDecodedPayloadSize),
+		)
+	})
+)
@@ -21,7 +21,17 @@
 package encoding
 
 import (
+	"encoding/binary"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// offsetSize is the size of an SSZ offset.
+	offsetSize = 4
+	// slotSize is the size of an SSZ encoded slot.
+	slotSize = 8
 )
 
 // ExtractBlobsAndBlockFromRequest extracts the blobs and block from an ABCI
@@ -59,6 +69,7 @@ func ExtractBlobsAndBlockFromRequest[
 	blobs, err = UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
 		req,
 		blobSidecarsIndex,
+		forkVersion,
 	)
 	if err != nil {
 		return blk, blobs, err
@@ -99,7 +110,54 @@ func UnmarshalBeaconBlockFromABCIRequest[
 		return blk, ErrNilBeaconBlockInRequest
 	}
 
-	return blk.NewFromSSZ(blkBz, forkVersion)
+	env, err := DecodeTx(blkBz)
+	if err != nil {
+		return blk, err
+	}
+
+	// Blocks are signed by their proposer, unsigned blocks remain decodable
+	// and are rejected by the state transition.
+	if env.ContentType == ContentTypeSignedBeaconBlock {
+		if err = env.validate(
+			ContentTypeSignedBeaconBlock, forkVersion,
+		); err != nil {
+			return blk, err
+		}
+		return blk.NewFromSignedSSZ(env.Payload, forkVersion)
+	}
+	if err = env.validate(ContentTypeBeaconBlock, forkVersion); err != nil {
+		return blk, err
+	}
+	return blk.NewFromSSZ(env.Payload, forkVersion)
+}
+
+// BeaconBlockSlotFromTx returns the slot of the beacon block carried by the
+// proposal tx without decoding the block. The slot leads the SSZ layout of
+// the block of every fork version, so the fork version to decode the block
+// with can be derived from it.
+func BeaconBlockSlotFromTx(tx []byte) (math.Slot, error) {
+	env, err := DecodeTx(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	bz := env.Payload
+	// A signed block starts with the offset of the block, which follows the
+	// signature.
+	if env.ContentType == ContentTypeSignedBeaconBlock {
+		if len(bz) < offsetSize {
+			return 0, ErrMalformedBeaconBlock
+		}
+		offset := binary.LittleEndian.Uint32(bz)
+		if uint64(offset) > uint64(len(bz)) {
+			return 0, ErrMalformedBeaconBlock
+		}
+		bz = bz[offset:]
+	}
+	if len(bz) < slotSize {
+		return 0, ErrMalformedBeaconBlock
+	}
+	return math.Slot(binary.LittleEndian.Uint64(bz)), nil
 }
 
 // UnmarshalBlobSidecarsFromABCIRequest extracts blob sidecars from an ABCI
@@ -112,6 +170,7 @@ func UnmarshalBlobSidecarsFromABCIRequest[
 ](
 	req ABCIRequest,
 	bzIndex uint,
+	forkVersion uint32,
 ) (BlobSidecarsT, error) {
 	var sidecars BlobSidecarsT
 	if req == nil {
@@ -128,8 +187,16 @@ func UnmarshalBlobSidecarsFromABCIRequest[
 		return sidecars, ErrNilBeaconBlockInRequest
 	}
 
+	env, err := DecodeTx(sidecarBz)
+	if err != nil {
+		return sidecars, err
+	}
+	if err = env.validate(ContentTypeBlobSidecars, forkVersion); err != nil {
+		return sidecars, err
+	}
+
 	// TODO: Do some research to figure out how to make this more
 	// elegant.
 	sidecars = sidecars.Empty()
-	return sidecars, sidecars.UnmarshalSSZ(sidecarBz)
+	return sidecars, sidecars.UnmarshalSSZ(env.Payload)
 }
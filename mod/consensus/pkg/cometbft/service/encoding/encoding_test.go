@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalBeaconBlockFromABCIRequest(t *testing.T) {
+	body := (&types.BeaconBlockBody{}).Empty(version.DenebPlus)
+	body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	blk := &types.BeaconBlock{
+		Slot:      3,
+		Body:      body,
+		Signature: crypto.BLSSignature{0x01},
+	}
+	signedBz, err := blk.MarshalSignedSSZ()
+	require.NoError(t, err)
+	unsignedBz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		bz          []byte
+		contentType encoding.ContentType
+		signature   crypto.BLSSignature
+	}{
+		"signed": {
+			bz:          signedBz,
+			contentType: encoding.ContentTypeSignedBeaconBlock,
+			signature:   blk.Signature,
+		},
+		"unsigned": {
+			bz:          unsignedBz,
+			contentType: encoding.ContentTypeBeaconBlock,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tx, err := encoding.EncodeTx(
+				tc.bz, tc.contentType, version.DenebPlus,
+				encoding.CompressionNone,
+			)
+			require.NoError(t, err)
+
+			got, err := encoding.
+				UnmarshalBeaconBlockFromABCIRequest[*types.BeaconBlock](
+				&cmtabci.ProcessProposalRequest{Txs: [][]byte{tx}},
+				0, version.DenebPlus,
+			)
+			require.NoError(t, err)
+			require.Equal(t, blk.HashTreeRoot(), got.HashTreeRoot())
+			require.Equal(t, tc.signature, got.GetSignature())
+
+			slot, err := encoding.BeaconBlockSlotFromTx(tx)
+			require.NoError(t, err)
+			require.Equal(t, blk.Slot, slot)
+		})
+	}
+}
+
+func TestUnmarshalBeaconBlockEnvelopeBeforeFork(t *testing.T) {
+	tx, err := encoding.EncodeTx(
+		[]byte("beacon"),
+		encoding.ContentTypeBeaconBlock,
+		version.DenebPlus,
+		encoding.CompressionNone,
+	)
+	require.NoError(t, err)
+
+	_, err = encoding.UnmarshalBeaconBlockFromABCIRequest[*types.BeaconBlock](
+		&cmtabci.ProcessProposalRequest{Txs: [][]byte{tx}},
+		0, version.Deneb,
+	)
+	require.ErrorIs(t, err, encoding.ErrEnvelopeNotActive)
+}
+
+func TestBeaconBlockSlotFromTx(t *testing.T) {
+	// Raw SSZ txs of older nodes carry an unsigned block.
+	body := (&types.BeaconBlockBody{}).Empty(version.Deneb)
+	body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	bz, err := (&types.BeaconBlock{Slot: 7, Body: body}).MarshalSSZ()
+	require.NoError(t, err)
+	slot, err := encoding.BeaconBlockSlotFromTx(bz)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(7), slot)
+
+	_, err = encoding.BeaconBlockSlotFromTx(bz[:4])
+	require.ErrorIs(t, err, encoding.ErrMalformedBeaconBlock)
+
+	tx, err := encoding.EncodeTx(
+		[]byte{0xff, 0xff, 0xff, 0xff},
+		encoding.ContentTypeSignedBeaconBlock,
+		version.DenebPlus,
+		encoding.CompressionNone,
+	)
+	require.NoError(t, err)
+	_, err = encoding.BeaconBlockSlotFromTx(tx)
+	require.ErrorIs(t, err, encoding.ErrMalformedBeaconBlock)
+}
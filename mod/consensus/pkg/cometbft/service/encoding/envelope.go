@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+const (
+	// EnvelopeVersion is the current version of the proposal tx envelope.
+	EnvelopeVersion uint8 = 1
+	// EnvelopeForkVersion is the fork version from which proposal txs are
+	// wrapped in an envelope. Earlier proposals are exchanged as raw SSZ.
+	EnvelopeForkVersion = version.DenebPlus
+)
+
+const (
+	// envelopeHeaderSize is the size of the fixed envelope header:
+	// magic (4) | version (1) | content type (1) | compression (1) |
+	// fork version (4) | checksum (4).
+	envelopeHeaderSize = 15
+	// forkVersionOffset is the offset of the fork version in the header.
+	forkVersionOffset = 7
+	// checksumOffset is the offset of the checksum in the header.
+	checksumOffset = 11
+)
+
+//nolint:gochecknoglobals // constants.
+var (
+	// envelopeMagic prefixes every enveloped proposal tx. Raw SSZ txs start
+	// with a little-endian slot or sidecar index, which can never realistically
+	// take this value, so anything without it is decoded as raw SSZ.
+	envelopeMagic = [4]byte{0xbe, 0xac, 0x0e, 0x7e}
+
+	// castagnoli is the CRC32 table used for envelope checksums.
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// ContentType identifies what is carried in an envelope payload.
+type ContentType uint8
+
+const (
+	// ContentTypeBeaconBlock is an SSZ encoded beacon block.
+	ContentTypeBeaconBlock ContentType = iota + 1
+	// ContentTypeBlobSidecars is an SSZ encoded list of blob sidecars.
+	ContentTypeBlobSidecars
+	// ContentTypeSignedBeaconBlock is an SSZ encoded beacon block signed by
+	// its proposer.
+	ContentTypeSignedBeaconBlock
+)
+
+// Envelope is a versioned wrapper around an SSZ proposal transaction.
+type Envelope struct {
+	// Version is the envelope format version.
+	Version uint8
+	// ContentType is the type of the SSZ payload.
+	ContentType ContentType
+	// Compression is the algorithm the payload was compressed with.
+	Compression Compression
+	// ForkVersion is the fork version the payload was encoded under.
+	ForkVersion uint32
+	// Checksum is the CRC32-C checksum of the uncompressed payload.
+	Checksum uint32
+	// Payload is the uncompressed SSZ payload.
+	Payload []byte
+}
+
+// EncodeTx wraps the given SSZ payload in a versioned envelope, compressing
+// it with the given compression algorithm. Before the envelope fork the
+// payload is returned as raw SSZ, so that it stays decodable by older nodes.
+func EncodeTx(
+	payload []byte,
+	contentType ContentType,
+	forkVersion uint32,
+	compression Compression,
+) ([]byte, error) {
+	if !IsEnvelopeActive(forkVersion) {
+		return payload, nil
+	}
+
+	compressed, err := compression.compress(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := make([]byte, envelopeHeaderSize, envelopeHeaderSize+len(compressed))
+	copy(bz, envelopeMagic[:])
+	bz[4] = EnvelopeVersion
+	bz[5] = byte(contentType)
+	bz[6] = byte(compression)
+	binary.LittleEndian.PutUint32(bz[forkVersionOffset:], forkVersion)
+	binary.LittleEndian.PutUint32(
+		bz[checksumOffset:], crc32.Checksum(payload, castagnoli),
+	)
+	return append(bz, compressed...), nil
+}
+
+// DecodeTx unwraps a proposal transaction. Transactions that do not carry the
+// envelope magic are returned as-is with a zero version, so that raw SSZ
+// transactions produced by older nodes remain decodable.
+func DecodeTx(bz []byte) (*Envelope, error) {
+	if !IsEnvelope(bz) {
+		return &Envelope{Payload: bz}, nil
+	}
+	if len(bz) < envelopeHeaderSize {
+		return nil, ErrMalformedEnvelope
+	}
+
+	env := &Envelope{
+		Version:     bz[4],
+		ContentType: ContentType(bz[5]),
+		Compression: Compression(bz[6]),
+		ForkVersion: binary.LittleEndian.Uint32(bz[forkVersionOffset:]),
+		Checksum:    binary.LittleEndian.Uint32(bz[checksumOffset:]),
+	}
+	if env.Version != EnvelopeVersion {
+		return nil, errors.Wrapf(
+			ErrUnsupportedEnvelopeVersion, "version %d", env.Version,
+		)
+	}
+
+	var err error
+	if env.Payload, err = env.Compression.decompress(
+		bz[envelopeHeaderSize:],
+	); err != nil {
+		return nil, err
+	}
+	if crc32.Checksum(env.Payload, castagnoli) != env.Checksum {
+		return nil, ErrChecksumMismatch
+	}
+	return env, nil
+}
+
+// IsEnvelope returns true if the given transaction starts with the envelope
+// magic.
+func IsEnvelope(bz []byte) bool {
+	return bytes.HasPrefix(bz, envelopeMagic[:])
+}
+
+// IsEnvelopeActive returns true if proposal txs encoded under the given fork
+// version are wrapped in an envelope.
+func IsEnvelopeActive(forkVersion uint32) bool {
+	return forkVersion >= EnvelopeForkVersion
+}
+
+// IsLegacy returns true if the envelope was decoded from a raw SSZ
+// transaction.
+func (e *Envelope) IsLegacy() bool {
+	return e.Version == 0
+}
+
+// validate checks that the envelope carries the expected content type and
+// fork version. Legacy transactions carry neither and are always accepted,
+// while enveloped transactions are rejected before the envelope fork.
+func (e *Envelope) validate(
+	contentType ContentType,
+	forkVersion uint32,
+) error {
+	if e.IsLegacy() {
+		return nil
+	}
+	if !IsEnvelopeActive(forkVersion) {
+		return errors.Wrapf(
+			ErrEnvelopeNotActive, "fork version %d", forkVersion,
+		)
+	}
+	if e.ContentType != contentType {
+		return errors.Wrapf(
+			ErrContentTypeMismatch,
+			"expected %d, got %d", contentType, e.ContentType,
+		)
+	}
+	if e.ForkVersion != forkVersion {
+		return errors.Wrapf(
+			ErrForkVersionMismatch,
+			"expected %d, got %d", forkVersion, e.ForkVersion,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	payload := bytes.Repeat([]byte{0x01, 0x02, 0x03, 0x04}, 4096)
+	for _, c := range []encoding.Compression{
+		encoding.CompressionNone,
+		encoding.CompressionSnappy,
+		encoding.CompressionZstd,
+	} {
+		t.Run(c.String(), func(t *testing.T) {
+			bz, err := encoding.EncodeTx(
+				payload, encoding.ContentTypeBeaconBlock, version.DenebPlus, c,
+			)
+			require.NoError(t, err)
+			require.True(t, encoding.IsEnvelope(bz))
+			if c != encoding.CompressionNone {
+				require.Less(t, len(bz), len(payload))
+			}
+
+			env, err := encoding.DecodeTx(bz)
+			require.NoError(t, err)
+			require.False(t, env.IsLegacy())
+			require.Equal(t, encoding.EnvelopeVersion, env.Version)
+			require.Equal(t, encoding.ContentTypeBeaconBlock, env.ContentType)
+			require.Equal(t, c, env.Compression)
+			require.Equal(t, version.DenebPlus, env.ForkVersion)
+			require.Equal(t, payload, env.Payload)
+		})
+	}
+}
+
+func TestEncodeTxBeforeEnvelopeFork(t *testing.T) {
+	payload := []byte("beacon")
+	bz, err := encoding.EncodeTx(
+		payload,
+		encoding.ContentTypeBeaconBlock,
+		version.Deneb,
+		encoding.CompressionSnappy,
+	)
+	require.NoError(t, err)
+	require.False(t, encoding.IsEnvelope(bz))
+	require.Equal(t, payload, bz)
+}
+
+func TestDecodeLegacyTx(t *testing.T) {
+	raw := []byte{0x0a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff}
+	env, err := encoding.DecodeTx(raw)
+	require.NoError(t, err)
+	require.True(t, env.IsLegacy())
+	require.Equal(t, raw, env.Payload)
+}
+
+func TestDecodeTxChecksumMismatch(t *testing.T) {
+	bz, err := encoding.EncodeTx(
+		[]byte("beacon"),
+		encoding.ContentTypeBlobSidecars,
+		version.DenebPlus,
+		encoding.CompressionNone,
+	)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	_, err = encoding.DecodeTx(bz)
+	require.ErrorIs(t, err, encoding.ErrChecksumMismatch)
+}
+
+func TestCompressionFromString(t *testing.T) {
+	c, err := encoding.CompressionFromString("zstd")
+	require.NoError(t, err)
+	require.Equal(t, encoding.CompressionZstd, c)
+
+	_, err = encoding.CompressionFromString("lz4")
+	require.ErrorIs(t, err, encoding.ErrUnknownCompression)
+}
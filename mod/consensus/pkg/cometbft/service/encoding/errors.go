@@ -39,6 +39,42 @@ var (
 	// is nil.
 	ErrNilABCIRequest = errors.New("nil abci request")
 
+	// ErrMalformedBeaconBlock is an error for when a beacon block is too
+	// short to hold its slot.
+	ErrMalformedBeaconBlock = errors.New("malformed beacon block")
+
 	// ErrInvalidType is an error for when the type is invalid.
 	ErrInvalidType = errors.New("invalid type")
+
+	// ErrMalformedEnvelope is an error for when a tx carries the envelope
+	// magic but is too short to hold the envelope header.
+	ErrMalformedEnvelope = errors.New("malformed tx envelope")
+
+	// ErrUnsupportedEnvelopeVersion is an error for when the envelope version
+	// is not supported by this node.
+	ErrUnsupportedEnvelopeVersion = errors.New("unsupported envelope version")
+
+	// ErrUnknownCompression is an error for when the envelope compression
+	// algorithm is unknown.
+	ErrUnknownCompression = errors.New("unknown compression")
+
+	// ErrPayloadTooLarge is an error for when the decompressed payload would
+	// exceed the maximum allowed size.
+	ErrPayloadTooLarge = errors.New("decompressed payload too large")
+
+	// ErrChecksumMismatch is an error for when the envelope checksum does not
+	// match its payload.
+	ErrChecksumMismatch = errors.New("envelope checksum mismatch")
+
+	// ErrContentTypeMismatch is an error for when the envelope carries an
+	// unexpected content type.
+	ErrContentTypeMismatch = errors.New("envelope content type mismatch")
+
+	// ErrForkVersionMismatch is an error for when the envelope was encoded
+	// under a different fork version than the one active for the slot.
+	ErrForkVersionMismatch = errors.New("envelope fork version mismatch")
+
+	// ErrEnvelopeNotActive is an error for when an enveloped tx is received
+	// for a slot before the envelope fork.
+	ErrEnvelopeNotActive = errors.New("tx envelope not active for fork")
 )
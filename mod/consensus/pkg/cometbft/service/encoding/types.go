@@ -39,4 +39,7 @@ type ABCIRequest interface {
 type BeaconBlock[T any] interface {
 	constraints.SSZMarshallable
 	NewFromSSZ([]byte, uint32) (T, error)
+	// NewFromSignedSSZ creates a beacon block carrying its proposer's
+	// signature from the SSZ encoding of a signed beacon block.
+	NewFromSignedSSZ([]byte, uint32) (T, error)
 }
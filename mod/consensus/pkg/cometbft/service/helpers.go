@@ -17,119 +17,188 @@
 // EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
+
 package cometbft
 
-// package cometbft
+import (
+	"context"
+	"errors"
+	"sort"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+var (
+	// errAttestationsMismatch is returned when the attestations of a
+	// proposed block do not match the votes of the last commit.
+	errAttestationsMismatch = errors.New(
+		"attestations do not match the votes of the last commit",
+	)
+	// errSlashingInfoMismatch is returned when the slashing info of a
+	// proposed block does not match the misbehaviors of the request.
+	errSlashingInfoMismatch = errors.New(
+		"slashing info does not match the misbehaviors of the request",
+	)
+)
+
+// carriesCometBFTData returns true if blocks at the given slot carry the
+// attestations and slashing info derived from CometBFT.
+func (s *Service[_]) carriesCometBFTData(slot math.Slot) bool {
+	return s.validatorResolver != nil &&
+		s.chainSpec.ActiveForkVersionForSlot(slot) >= version.DenebPlus
+}
 
-// import (
-// 	"sort"
+// slotDataFromPrepareProposal builds the slot data of a prepare proposal
+// request.
+func (s *Service[_]) slotDataFromPrepareProposal(
+	ctx context.Context,
+	req *cmtabci.PrepareProposalRequest,
+) (*types.SlotData[*ctypes.AttestationData, *ctypes.SlashingInfo], error) {
+	slotData := &types.SlotData[
+		*ctypes.AttestationData,
+		*ctypes.SlashingInfo,
+	]{
+		Slot: math.Slot(req.Height),
+	}
+	if !s.carriesCometBFTData(slotData.Slot) {
+		return slotData, nil
+	}
 
-// 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-// 	cmtabci "github.com/cometbft/cometbft/abci/types"
-// 	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
-// 	sdk "github.com/cosmos/cosmos-sdk/types"
-// )
+	var err error
+	votes := make([]v1.VoteInfo, len(req.LocalLastCommit.Votes))
+	for i, vote := range req.LocalLastCommit.Votes {
+		votes[i] = v1.VoteInfo{
+			Validator:   vote.Validator,
+			BlockIdFlag: vote.BlockIdFlag,
+		}
+	}
+	if slotData.AttestationData, err = s.attestationsFromVotes(
+		ctx, votes, slotData.Slot,
+	); err != nil {
+		return nil, err
+	}
+	if slotData.SlashingInfo, err = s.slashingInfoFromMisbehaviors(
+		ctx, req.Misbehavior,
+	); err != nil {
+		return nil, err
+	}
+	return slotData, nil
+}
 
-// // convertPrepareProposalToSlotData converts a prepare proposal request to
-// // a slot data.
-// func (c *ConsensusEngine[
-// 	_, _, _, SlotDataT, _, _,
-// ]) convertPrepareProposalToSlotData(
-// 	ctx sdk.Context,
-// 	req *cmtabci.PrepareProposalRequest,
-// ) (SlotDataT, error) {
-// 	var t SlotDataT
+// validateProposalCometBFTData verifies that the attestations and slashing
+// info of the proposed block match the last commit and misbehaviors of the
+// process proposal request.
+func (s *Service[_]) validateProposalCometBFTData(
+	ctx context.Context,
+	req *cmtabci.ProcessProposalRequest,
+) error {
+	slot := math.Slot(req.Height)
+	if !s.carriesCometBFTData(slot) {
+		return nil
+	}
 
-// 	// Get the attestation data from the votes.
-// 	attestationData, err := c.attestationsFromVotes(
-// 		ctx,
-// 		req.LocalLastCommit.Votes,
-// 		//#nosec:G701 // safe.
-// 		math.Slot(req.Height),
-// 	)
-// 	if err != nil {
-// 		return t, err
-// 	}
+	// Malformed blocks are rejected by the middleware, which reports the
+	// decoding error, so they are not checked here.
+	blk, err := encoding.UnmarshalBeaconBlockFromABCIRequest[*ctypes.BeaconBlock](
+		req,
+		middleware.BeaconBlockTxIndex,
+		s.chainSpec.ActiveForkVersionForSlot(slot),
+	)
+	if err != nil || blk == nil || blk.GetBody() == nil {
+		return nil
+	}
 
-// 	// Get the slashing info from the misbehaviors.
-// 	slashingInfo, err := c.slashingInfoFromMisbehaviors(
-// 		ctx,
-// 		req.Misbehavior,
-// 	)
-// 	if err != nil {
-// 		return t, err
-// 	}
+	attestations, err := s.attestationsFromVotes(
+		ctx, req.ProposedLastCommit.Votes, slot,
+	)
+	if err != nil {
+		return err
+	}
+	if ctypes.Attestations(attestations).HashTreeRoot() !=
+		ctypes.Attestations(blk.GetBody().GetAttestations()).HashTreeRoot() {
+		return errAttestationsMismatch
+	}
 
-// 	// Create the slot data.
-// 	t = t.New(
-// 		math.U64(req.Height),
-// 		attestationData,
-// 		slashingInfo,
-// 	)
-// 	return t, nil
-// }
+	slashingInfo, err := s.slashingInfoFromMisbehaviors(ctx, req.Misbehavior)
+	if err != nil {
+		return err
+	}
+	if ctypes.SlashingInfos(slashingInfo).HashTreeRoot() !=
+		ctypes.SlashingInfos(blk.GetBody().GetSlashingInfo()).HashTreeRoot() {
+		return errSlashingInfoMismatch
+	}
+	return nil
+}
 
-// // attestationsFromVotes returns a list of attestation data from the votes.
-// func (c *ConsensusEngine[
-// 	AttestationDataT, _, _, _, _, _,
-// ]) attestationsFromVotes(
-// 	ctx sdk.Context,
-// 	votes []v1.ExtendedVoteInfo,
-// 	slot math.Slot,
-// ) ([]AttestationDataT, error) {
-// 	var err error
-// 	var index math.U64
-// 	attestations := make([]AttestationDataT, len(votes))
-// 	st := c.sb.StateFromContext(ctx)
-// 	root := st.HashTreeRoot()
-// 	for i, vote := range votes {
-// 		index, err = st.ValidatorIndexByCometBFTAddress(vote.Validator.Address)
-// 		if err != nil {
-// 			return nil, err
-// 		}
+// attestationsFromVotes returns the attestations of the validators which
+// committed to the previous block, sorted by validator index.
+func (s *Service[_]) attestationsFromVotes(
+	ctx context.Context,
+	votes []v1.VoteInfo,
+	slot math.Slot,
+) ([]*ctypes.AttestationData, error) {
+	// Votes of the last commit are cast for the block of the previous slot.
+	root, err := s.validatorResolver.LatestBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-// 		var t AttestationDataT
-// 		t = t.New(
-// 			slot,
-// 			index,
-// 			root,
-// 		)
-// 		attestations[i] = t
-// 	}
+	var index math.ValidatorIndex
+	attestations := make([]*ctypes.AttestationData, 0, len(votes))
+	for _, vote := range votes {
+		if vote.BlockIdFlag != cmttypes.BlockIDFlagCommit {
+			continue
+		}
+		index, err = s.validatorResolver.ValidatorIndexByCometBFTAddress(
+			ctx, vote.Validator.Address,
+		)
+		if err != nil {
+			return nil, err
+		}
+		attestations = append(
+			attestations,
+			(&ctypes.AttestationData{}).New(slot-1, index, root),
+		)
+	}
 
-// 	// Attestations are sorted by index.
-// 	sort.Slice(attestations, func(i, j int) bool {
-// 		return attestations[i].GetIndex() < attestations[j].GetIndex()
-// 	})
-// 	return attestations, nil
-// }
+	// Attestations are sorted by index.
+	sort.Slice(attestations, func(i, j int) bool {
+		return attestations[i].GetIndex() < attestations[j].GetIndex()
+	})
+	return attestations, nil
+}
 
-// // slashingInfoFromMisbehaviors returns a list of slashing info from the
-// // comet misbehaviors.
-// func (c *ConsensusEngine[
-// 	_, _, SlashingInfoT, _, _, _,
-// ]) slashingInfoFromMisbehaviors(
-// 	ctx sdk.Context,
-// 	misbehaviors []v1.Misbehavior,
-// ) ([]SlashingInfoT, error) {
-// 	var err error
-// 	var index math.U64
-// 	st := c.sb.StateFromContext(ctx)
-// 	slashingInfo := make([]SlashingInfoT, len(misbehaviors))
-// 	for i, misbehavior := range misbehaviors {
-// 		index, err = st.ValidatorIndexByCometBFTAddress(
-// 			misbehavior.Validator.Address,
-// 		)
-// 		if err != nil {
-// 			return nil, err
-// 		}
-// 		var t SlashingInfoT
-// 		t = t.New(
-// 			//#nosec:G701 // safe.
-// 			math.Slot(misbehavior.GetHeight()),
-// 			index,
-// 		)
-// 		slashingInfo[i] = t
-// 	}
-// 	return slashingInfo, nil
-// }
+// slashingInfoFromMisbehaviors returns the slashing info of the comet
+// misbehaviors, in the order they were reported.
+func (s *Service[_]) slashingInfoFromMisbehaviors(
+	ctx context.Context,
+	misbehaviors []v1.Misbehavior,
+) ([]*ctypes.SlashingInfo, error) {
+	var (
+		err   error
+		index math.ValidatorIndex
+	)
+	slashingInfo := make([]*ctypes.SlashingInfo, len(misbehaviors))
+	for i, misbehavior := range misbehaviors {
+		index, err = s.validatorResolver.ValidatorIndexByCometBFTAddress(
+			ctx, misbehavior.Validator.Address,
+		)
+		if err != nil {
+			return nil, err
+		}
+		slashingInfo[i] = (&ctypes.SlashingInfo{}).New(
+			//#nosec:G701 // heights are never negative.
+			math.Slot(misbehavior.GetHeight()),
+			index,
+		)
+	}
+	return slashingInfo, nil
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	errorsmod "github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// LightBlock returns the CometBFT header at the given height with the commit
+// that signed it and the validator set the commit was signed by. The commit
+// of the latest height is the one this node has seen, later heights carry
+// the canonical one.
+func (s *Service[_]) LightBlock(height int64) (*transition.LightBlock, error) {
+	if s.stateStore == nil || s.blockStore == nil {
+		return nil, errNodeNotRunning
+	}
+
+	meta := s.blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrNotFound, "no block at height %d", height,
+		)
+	}
+	var commit *cmttypes.Commit
+	if height == s.blockStore.Height() {
+		commit = s.blockStore.LoadSeenCommit(height)
+	} else {
+		commit = s.blockStore.LoadBlockCommit(height)
+	}
+	if commit == nil {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrNotFound, "no commit at height %d", height,
+		)
+	}
+	vals, err := s.stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, err
+	}
+
+	signedHeader, err := cmtjson.Marshal(&cmttypes.SignedHeader{
+		Header: &meta.Header,
+		Commit: commit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	validatorSet, err := cmtjson.Marshal(vals)
+	if err != nil {
+		return nil, err
+	}
+	return &transition.LightBlock{
+		SignedHeader: signedHeader,
+		ValidatorSet: validatorSet,
+	}, nil
+}
+
+// AppHash returns the app hash committed to by executing the block at the
+// given height. It is carried by the CometBFT header of the next height, or
+// is the last commit of the store if there is no such header yet.
+func (s *Service[_]) AppHash(height int64) ([]byte, error) {
+	if s.blockStore == nil {
+		return nil, errNodeNotRunning
+	}
+
+	if meta := s.blockStore.LoadBlockMeta(height + 1); meta != nil {
+		return meta.Header.AppHash, nil
+	}
+	lastCommit := s.sm.CommitMultiStore().LastCommitID()
+	if lastCommit.Version == height {
+		return lastCommit.Hash, nil
+	}
+	return nil, errorsmod.Wrapf(
+		sdkerrors.ErrNotFound, "no app hash at height %d", height,
+	)
+}
+
+// ProveBlockRoot returns the block root at the given index of the block roots
+// of the beacon state at the given height, with its proof against the app
+// hash of that height.
+func (s *Service[_]) ProveBlockRoot(
+	height int64,
+	index uint64,
+) (*transition.StoreProof, error) {
+	return s.proveBeaconStore(height, keys.BlockRootKey(index))
+}
+
+// proveBeaconStore returns the value of the given key of the beacon store at
+// the given height, with its proof against the app hash of that height.
+func (s *Service[_]) proveBeaconStore(
+	height int64,
+	key []byte,
+) (*transition.StoreProof, error) {
+	res := s.queryStore(&abci.QueryRequest{Height: height, Prove: true}, key)
+	if res.Code != 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "store query failed: %s", res.Log,
+		)
+	}
+	if res.Value == nil {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrNotFound, "key %x at height %d", key, height,
+		)
+	}
+
+	proof := &transition.StoreProof{
+		Height: res.Height,
+		Key:    res.Key,
+		Value:  res.Value,
+	}
+	if res.ProofOps != nil {
+		proof.ProofOps = make([]*transition.ProofOp, len(res.ProofOps.Ops))
+		for i, op := range res.ProofOps.Ops {
+			proof.ProofOps[i] = &transition.ProofOp{
+				Type: op.Type,
+				Key:  op.Key,
+				Data: op.Data,
+			}
+		}
+	}
+	return proof, nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
@@ -74,7 +75,10 @@ func (h *ABCIMiddleware[
 	select {
 	case <-ctx.Done():
 		return nil, ErrInitGenesisTimeout(ctx.Err())
-	case gdpEvent := <-h.subGenDataProcessed:
+	case gdpEvent, ok := <-h.subGenDataProcessed:
+		if !ok {
+			return nil, ErrSubscriptionClosed
+		}
 		return gdpEvent.Data(), gdpEvent.Error()
 	}
 }
@@ -126,6 +130,7 @@ func (h *ABCIMiddleware[
 	if err != nil {
 		return nil, nil, err
 	}
+	setBlockRoot(ctx, builtBeaconBlock)
 
 	// wait for built sidecars
 	builtSidecars, err = h.waitForBuiltSidecars(awaitCtx)
@@ -145,7 +150,10 @@ func (h *ABCIMiddleware[
 	select {
 	case <-ctx.Done():
 		return *new(BeaconBlockT), ErrBuildBeaconBlockTimeout(ctx.Err())
-	case bbEvent := <-h.subBuiltBeaconBlock:
+	case bbEvent, ok := <-h.subBuiltBeaconBlock:
+		if !ok {
+			return *new(BeaconBlockT), ErrSubscriptionClosed
+		}
 		return bbEvent.Data(), bbEvent.Error()
 	}
 }
@@ -159,7 +167,10 @@ func (h *ABCIMiddleware[
 	select {
 	case <-ctx.Done():
 		return *new(BlobSidecarsT), ErrBuildSidecarsTimeout(ctx.Err())
-	case scEvent := <-h.subBuiltSidecars:
+	case scEvent, ok := <-h.subBuiltSidecars:
+		if !ok {
+			return *new(BlobSidecarsT), ErrSubscriptionClosed
+		}
 		return scEvent.Data(), scEvent.Error()
 	}
 }
@@ -172,14 +183,37 @@ func (h *ABCIMiddleware[
 	bb BeaconBlockT,
 	sc BlobSidecarsT,
 ) ([]byte, []byte, error) {
-	bbBz, bbErr := bb.MarshalSSZ()
+	var (
+		bbBz        []byte
+		bbErr       error
+		contentType = encoding.ContentTypeBeaconBlock
+	)
+	forkVersion := h.chainSpec.ActiveForkVersionForSlot(bb.GetSlot())
+	// Blocks are only signed by their proposer from DenebPlus on, earlier
+	// blocks are exchanged as raw SSZ without a signature.
+	if forkVersion >= version.DenebPlus {
+		contentType = encoding.ContentTypeSignedBeaconBlock
+		bbBz, bbErr = bb.MarshalSignedSSZ()
+	} else {
+		bbBz, bbErr = bb.MarshalSSZ()
+	}
 	if bbErr != nil {
 		return nil, nil, bbErr
 	}
+	if bbBz, bbErr = encoding.EncodeTx(
+		bbBz, contentType, forkVersion, h.compression,
+	); bbErr != nil {
+		return nil, nil, bbErr
+	}
 	scBz, scErr := sc.MarshalSSZ()
 	if scErr != nil {
 		return nil, nil, scErr
 	}
+	if scBz, scErr = encoding.EncodeTx(
+		scBz, encoding.ContentTypeBlobSidecars, forkVersion, h.compression,
+	); scErr != nil {
+		return nil, nil, scErr
+	}
 	return bbBz, scBz, nil
 }
 
@@ -225,6 +259,7 @@ func (h *ABCIMiddleware[
 	); err != nil {
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
+	setBlockRoot(ctx, blk)
 
 	// notify that the beacon block has been received.
 	if err = h.dispatcher.Publish(
@@ -236,7 +271,7 @@ func (h *ABCIMiddleware[
 	// Request the blob sidecars.
 	if sidecars, err = encoding.
 		UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
-		req, 1,
+		req, 1, h.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height)),
 	); err != nil {
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
@@ -270,7 +305,10 @@ func (h *ABCIMiddleware[
 	select {
 	case <-ctx.Done():
 		return *new(BeaconBlockT), ErrVerifyBeaconBlockTimeout(ctx.Err())
-	case vEvent := <-h.subBBVerified:
+	case vEvent, ok := <-h.subBBVerified:
+		if !ok {
+			return *new(BeaconBlockT), ErrSubscriptionClosed
+		}
 		return vEvent.Data(), vEvent.Error()
 	}
 }
@@ -284,7 +322,10 @@ func (h *ABCIMiddleware[
 	select {
 	case <-ctx.Done():
 		return *new(BlobSidecarsT), ErrVerifySidecarsTimeout(ctx.Err())
-	case vEvent := <-h.subSCVerified:
+	case vEvent, ok := <-h.subSCVerified:
+		if !ok {
+			return *new(BlobSidecarsT), ErrSubscriptionClosed
+		}
 		return vEvent.Data(), vEvent.Error()
 	}
 }
@@ -340,6 +381,7 @@ func (h *ABCIMiddleware[
 		// If we don't have a block, we can't do anything.
 		return nil, nil
 	}
+	setBlockRoot(ctx, blk)
 
 	// notify that the final beacon block has been received.
 	if err = h.dispatcher.Publish(
@@ -369,7 +411,10 @@ func (h *ABCIMiddleware[
 	select {
 	case <-ctx.Done():
 		return nil, ErrFinalValidatorUpdatesTimeout(ctx.Err())
-	case event := <-h.subFinalValidatorUpdates:
+	case event, ok := <-h.subFinalValidatorUpdates:
+		if !ok {
+			return nil, ErrSubscriptionClosed
+		}
 		return event.Data(), event.Error()
 	}
 }
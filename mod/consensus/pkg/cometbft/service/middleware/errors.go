@@ -27,6 +27,10 @@ var (
 	// ErrUnexpectedEvent is returned when an unexpected event is encountered.
 	ErrUnexpectedEvent = errors.New("unexpected event")
 
+	// ErrSubscriptionClosed is returned when a subscription channel is closed
+	// while waiting on it, which happens when the node is shutting down.
+	ErrSubscriptionClosed = errors.New("subscription closed")
+
 	ErrInitGenesisTimeout = func(errTimeout error) error {
 		return errors.Wrapf(errTimeout,
 			"A timeout occurred while waiting for genesis data processing",
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -39,6 +40,8 @@ type ABCIMiddleware[
 ] struct {
 	// chainSpec is the chain specification.
 	chainSpec common.ChainSpec
+	// compression is the compression used for outgoing proposal txs.
+	compression encoding.Compression
 	// dispatcher is the central dispatcher to
 	dispatcher types.EventDispatcher
 	// metrics is the metrics emitter.
@@ -68,6 +71,7 @@ func NewABCIMiddleware[
 	SlotDataT any,
 ](
 	chainSpec common.ChainSpec,
+	compression encoding.Compression,
 	dispatcher types.EventDispatcher,
 	logger log.Logger,
 	telemetrySink TelemetrySink,
@@ -78,6 +82,7 @@ func NewABCIMiddleware[
 		BeaconBlockT, BlobSidecarsT, GenesisT, SlotDataT,
 	]{
 		chainSpec:                chainSpec,
+		compression:              compression,
 		dispatcher:               dispatcher,
 		logger:                   logger,
 		metrics:                  newABCIMiddlewareMetrics(telemetrySink),
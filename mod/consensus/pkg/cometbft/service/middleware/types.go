@@ -23,7 +23,9 @@ package middleware
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -33,6 +35,16 @@ type BeaconBlock[SelfT any] interface {
 	constraints.Nillable
 	constraints.Empty[SelfT]
 	NewFromSSZ([]byte, uint32) (SelfT, error)
+	// NewFromSignedSSZ creates a beacon block carrying its proposer's
+	// signature from the SSZ encoding of a signed beacon block.
+	NewFromSignedSSZ([]byte, uint32) (SelfT, error)
+	// MarshalSignedSSZ marshals the beacon block along with its proposer's
+	// signature to the SSZ encoding of a signed beacon block.
+	MarshalSignedSSZ() ([]byte, error)
+	// GetSlot returns the slot of the beacon block.
+	GetSlot() math.Slot
+	// HashTreeRoot returns the hash tree root of the beacon block.
+	HashTreeRoot() common.Root
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
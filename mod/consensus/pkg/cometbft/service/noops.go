@@ -26,41 +26,6 @@ import (
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 )
 
-func (Service[_]) Query(
-	context.Context,
-	*abci.QueryRequest,
-) (*abci.QueryResponse, error) {
-	return &abci.QueryResponse{}, nil
-}
-
-func (Service[_]) ListSnapshots(
-	context.Context,
-	*abci.ListSnapshotsRequest,
-) (*abci.ListSnapshotsResponse, error) {
-	return &abci.ListSnapshotsResponse{}, nil
-}
-
-func (Service[_]) LoadSnapshotChunk(
-	context.Context,
-	*abci.LoadSnapshotChunkRequest,
-) (*abci.LoadSnapshotChunkResponse, error) {
-	return &abci.LoadSnapshotChunkResponse{}, nil
-}
-
-func (Service[_]) OfferSnapshot(
-	context.Context,
-	*abci.OfferSnapshotRequest,
-) (*abci.OfferSnapshotResponse, error) {
-	return &abci.OfferSnapshotResponse{}, nil
-}
-
-func (Service[_]) ApplySnapshotChunk(
-	context.Context,
-	*abci.ApplySnapshotChunkRequest,
-) (*abci.ApplySnapshotChunkResponse, error) {
-	return &abci.ApplySnapshotChunkResponse{}, nil
-}
-
 func (Service[_]) ExtendVote(
 	context.Context,
 	*abci.ExtendVoteRequest,
@@ -22,7 +22,10 @@ package cometbft
 
 import (
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
+	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
@@ -75,9 +78,48 @@ func SetInterBlockCache[
 	}
 }
 
+// SetSnapshot sets the snapshot store and options of the Service, which
+// takes state sync snapshots of the application store at the configured
+// interval and restores a fresh node from the snapshots of its peers.
+func SetSnapshot[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		cms := s.sm.CommitMultiStore()
+		cms.SetSnapshotInterval(opts.Interval)
+		s.snapshotManager = snapshots.NewManager(
+			snapshotStore, opts, cms, nil,
+			servercmtlog.WrapSDKLogger(s.logger),
+		)
+	}
+}
+
 // SetChainID sets the chain ID in cometbft.
 func SetChainID[
 	LoggerT log.AdvancedLogger[LoggerT],
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetCheckpointSyncer sets the syncer verifying the state a fresh node
+// restores from a snapshot against a trusted checkpoint.
+func SetCheckpointSyncer[
+	LoggerT log.AdvancedLogger[LoggerT],
+](syncer CheckpointSyncer) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		s.checkpointSyncer = syncer
+	}
+}
+
+// SetValidatorResolver sets the resolver used to derive the attestations and
+// slashing info of DenebPlus blocks from CometBFT votes and misbehaviors.
+func SetValidatorResolver[
+	LoggerT log.AdvancedLogger[LoggerT],
+](resolver ValidatorResolver) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		s.validatorResolver = resolver
+	}
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"slices"
+
+	errorsmod "github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var (
+	errUnexpectedPubkeyLength = errorsmod.New(
+		"unexpected validator pubkey length",
+	)
+	errEmptyValidatorSet = errorsmod.New("empty validator set")
+)
+
+// ProposerCandidates returns the CometBFT validator set at the given height
+// together with the proposer priorities of its validators.
+func (s *Service[_]) ProposerCandidates(
+	height int64,
+) ([]*transition.ProposerCandidate, error) {
+	if s.stateStore == nil {
+		return nil, errNodeNotRunning
+	}
+
+	vals, err := s.stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*transition.ProposerCandidate, len(vals.Validators))
+	for i, val := range vals.Validators {
+		var pubkey crypto.BLSPubkey
+		if pubkey, err = blsPubkey(val); err != nil {
+			return nil, err
+		}
+		candidates[i] = &transition.ProposerCandidate{
+			Pubkey:           pubkey,
+			VotingPower:      val.VotingPower,
+			ProposerPriority: val.ProposerPriority,
+		}
+	}
+	return candidates, nil
+}
+
+// ProposerSchedule returns a schedule predicting the proposers of the heights
+// after the given one, replaying CometBFT's proposer selection on a copy of
+// the validator set at that height.
+func (s *Service[_]) ProposerSchedule(
+	height int64,
+) (transition.ProposerSchedule, error) {
+	if s.stateStore == nil {
+		return nil, errNodeNotRunning
+	}
+
+	vals, err := s.stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, err
+	}
+	return &proposerSchedule{vals: vals.Copy()}, nil
+}
+
+// proposerSchedule is a transition.ProposerSchedule over a CometBFT
+// validator set.
+type proposerSchedule struct {
+	vals *cmttypes.ValidatorSet
+}
+
+// Next advances the schedule by one height and returns the proposer of that
+// height.
+func (p *proposerSchedule) Next() (crypto.BLSPubkey, error) {
+	proposer, err := p.next()
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	return blsPubkey(proposer)
+}
+
+// next advances the schedule by one height and returns the proposer of that
+// height.
+func (p *proposerSchedule) next() (*cmttypes.Validator, error) {
+	if p.vals.IsNilOrEmpty() {
+		return nil, errEmptyValidatorSet
+	}
+	p.vals.IncrementProposerPriority(1)
+	return p.vals.GetProposer(), nil
+}
+
+// Update applies the given validator updates to the validator set the way
+// CometBFT applies the updates returned by the application.
+func (p *proposerSchedule) Update(updates transition.ValidatorUpdates) error {
+	abciUpdates := make([]abci.ValidatorUpdate, len(updates))
+	for i := range updates {
+		var err error
+		if abciUpdates[i], err = convertValidatorUpdate[abci.ValidatorUpdate](
+			&updates[i],
+		); err != nil {
+			return err
+		}
+	}
+	changes, err := cmttypes.PB2TM.ValidatorUpdates(abciUpdates)
+	if err != nil {
+		return err
+	}
+	return p.apply(changes)
+}
+
+// apply applies the changes that move the voting power of a validator to the
+// validator set. Changes that leave a voting power as it is are dropped, as
+// they would only rescale and shift the priorities of an unchanged set.
+func (p *proposerSchedule) apply(changes []*cmttypes.Validator) error {
+	changes = slices.DeleteFunc(changes, func(val *cmttypes.Validator) bool {
+		_, current := p.vals.GetByAddress(val.Address)
+		if current == nil {
+			// CometBFT rejects the removal of validators not in the set.
+			return val.VotingPower == 0
+		}
+		return current.VotingPower == val.VotingPower
+	})
+	return p.vals.UpdateWithChangeSet(changes)
+}
+
+// blsPubkey returns the BLS public key of the given CometBFT validator.
+func blsPubkey(val *cmttypes.Validator) (crypto.BLSPubkey, error) {
+	var pubkey crypto.BLSPubkey
+	bz := val.PubKey.Bytes()
+	if len(bz) != len(pubkey) {
+		return pubkey, errorsmod.Wrapf(
+			errUnexpectedPubkeyLength, "got %d bytes", len(bz),
+		)
+	}
+	copy(pubkey[:], bz)
+	return pubkey, nil
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"testing"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProposerSchedule checks the predicted proposers against the proposers
+// of a CometBFT validator set driven the way CometBFT drives it, with the
+// full validator set returned as updates at every epoch boundary.
+func TestProposerSchedule(t *testing.T) {
+	const (
+		slotsPerEpoch = 4
+		start         = 6
+		end           = 60
+	)
+	keys := make([]cmtcrypto.PubKey, 5)
+	for i := range keys {
+		keys[i] = ed25519.GenPrivKey().PubKey()
+	}
+	// powers returns the voting powers the application returns at the
+	// epoch boundary of the given height, -1 for validators not in the
+	// beacon state.
+	powers := func(height int64) []int64 {
+		switch epoch := height / slotsPerEpoch; {
+		case epoch < 3:
+			return []int64{32, 32, 64, 96, -1}
+		case epoch < 6:
+			// One validator gains power, one loses some, one joins.
+			return []int64{40, 32, 48, 96, 32}
+		case epoch < 7:
+			// One validator leaves.
+			return []int64{40, 0, 48, 96, 32}
+		default:
+			return []int64{40, -1, 48, 96, 32}
+		}
+	}
+	changes := func(height int64) []*cmttypes.Validator {
+		vals := make([]*cmttypes.Validator, 0, len(keys))
+		for i, power := range powers(height) {
+			if power >= 0 {
+				vals = append(vals, cmttypes.NewValidator(keys[i], power))
+			}
+		}
+		return vals
+	}
+
+	// The validator sets at the start height and the one after it.
+	current := cmttypes.NewValidatorSet(changes(0))
+	for range start - 1 {
+		current.IncrementProposerPriority(1)
+	}
+	next := current.CopyIncrementProposerPriority(1)
+
+	schedule := &proposerSchedule{vals: current.Copy()}
+	for height := int64(start + 1); height <= end; height++ {
+		// The schedule applies the updates of the block two heights back.
+		if height > 2 && (height-2)%slotsPerEpoch == 0 {
+			require.NoError(t, schedule.apply(changes(height-2)))
+		}
+		predicted, err := schedule.next()
+		require.NoError(t, err)
+
+		// CometBFT's state update after the block at the previous height.
+		nextNext := next.Copy()
+		if (height-1)%slotsPerEpoch == 0 {
+			require.NoError(t,
+				nextNext.UpdateWithChangeSet(changes(height-1)),
+			)
+		}
+		nextNext.IncrementProposerPriority(1)
+		current, next = next, nextNext
+
+		require.Equal(t,
+			current.GetProposer().Address, predicted.Address,
+			"height %d", height,
+		)
+	}
+}
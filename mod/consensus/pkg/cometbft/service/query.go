@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	sdkerrorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	errorsmod "github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/index"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// queryPathBeacon is the prefix of all beacon state queries.
+	queryPathBeacon    = "beacon"
+	queryPathValidator = "validator"
+	queryPathBalance   = "balance"
+	queryPathSlot      = "slot"
+)
+
+// Query serves reads of the beacon state over ABCI. The supported paths are:
+//
+//	/beacon/validator/{pubkey}
+//	/beacon/balance/{index}
+//	/beacon/slot
+//
+// The response value is the raw value in the beacon store, i.e. the SSZ
+// encoded validator or the big-endian encoded balance or slot, and the
+// response key is the store key it was read from. If a proof is requested,
+// the response carries ICS-23 proof ops of the key/value pair against the
+// app hash of the queried height.
+func (s *Service[_]) Query(
+	_ context.Context,
+	req *abci.QueryRequest,
+) (resp *abci.QueryResponse, err error) {
+	// add panic recovery for all queries
+	defer func() {
+		if r := recover(); r != nil {
+			resp = queryResult(errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r))
+		}
+	}()
+
+	// when a client did not provide a query height, manually inject the latest
+	if req.Height == 0 {
+		req.Height = s.LastBlockHeight()
+	}
+
+	path := strings.Split(strings.Trim(req.Path, "/"), "/")
+	if path[0] != queryPathBeacon {
+		return queryResult(
+			errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "unknown query path"),
+		), nil
+	}
+
+	key, err := s.beaconQueryKey(req.Height, path[1:])
+	if err != nil {
+		return queryResult(err), nil
+	}
+	return s.queryStore(req, key), nil
+}
+
+// beaconQueryKey resolves the arguments of a beacon query into the key of
+// the beacon store holding the requested value.
+func (s *Service[_]) beaconQueryKey(
+	height int64,
+	args []string,
+) ([]byte, error) {
+	switch {
+	case len(args) == 1 && args[0] == queryPathSlot:
+		return keys.SlotKey(), nil
+	case len(args) == 2 && args[0] == queryPathBalance:
+		idx, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest, "invalid validator index: %s", err,
+			)
+		}
+		return keys.BalanceKey(idx), nil
+	case len(args) == 2 && args[0] == queryPathValidator:
+		var pubkey crypto.BLSPubkey
+		if err := pubkey.UnmarshalText([]byte(args[1])); err != nil {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest, "invalid validator pubkey: %s", err,
+			)
+		}
+		idx, err := s.validatorIndexByPubkey(height, pubkey)
+		if err != nil {
+			return nil, err
+		}
+		return keys.ValidatorByIndexKey(idx), nil
+	default:
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrUnknownRequest, "unknown beacon query path",
+		)
+	}
+}
+
+// validatorIndexByPubkey looks up the index of the validator with the given
+// pubkey in the beacon store at the given height.
+func (s *Service[_]) validatorIndexByPubkey(
+	height int64,
+	pubkey crypto.BLSPubkey,
+) (uint64, error) {
+	ctx, err := s.CreateQueryContext(height, false)
+	if err != nil {
+		return 0, err
+	}
+
+	bz := ctx.KVStore(s.storeKey).Get(index.ValidatorPubkeyKey(pubkey[:]))
+	if bz == nil {
+		return 0, errorsmod.Wrapf(
+			sdkerrors.ErrNotFound, "validator %s", pubkey,
+		)
+	}
+	if len(bz) != 8 { //nolint:mnd // uint64.
+		return 0, errorsmod.Wrapf(
+			sdkerrors.ErrLogic, "malformed validator index for %s", pubkey,
+		)
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// queryStore reads the given key of the beacon store at the height of the
+// request, optionally with a proof against the app hash.
+func (s *Service[_]) queryStore(
+	req *abci.QueryRequest,
+	key []byte,
+) *abci.QueryResponse {
+	if req.Height <= 1 && req.Prove {
+		return queryResult(
+			errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"cannot query with proof when height <= 1; please provide a valid height",
+			),
+		)
+	}
+
+	queryable, ok := s.sm.CommitMultiStore().(storetypes.Queryable)
+	if !ok {
+		return queryResult(
+			errorsmod.Wrap(
+				sdkerrors.ErrUnknownRequest,
+				"multi-store does not support queries",
+			),
+		)
+	}
+
+	res, err := queryable.Query(&storetypes.RequestQuery{
+		Data:   key,
+		Path:   "/" + s.storeKey.Name() + "/key",
+		Height: req.Height,
+		Prove:  req.Prove,
+	})
+	if err != nil {
+		return queryResult(err)
+	}
+	res.Height = req.Height
+
+	resp := abci.QueryResponse(*res)
+	return &resp
+}
+
+// queryResult returns a QueryResponse from an error.
+func queryResult(err error) *abci.QueryResponse {
+	space, code, log := sdkerrorsmod.ABCIInfo(err, false)
+	return &abci.QueryResponse{
+		Codespace: space,
+		Code:      code,
+		Log:       log,
+	}
+}
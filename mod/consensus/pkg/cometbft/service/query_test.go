@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/index"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery(t *testing.T) {
+	cfg := phuslu.DefaultConfig()
+	storeKey := storetypes.NewKVStoreKey("beacon")
+	s := cometbft.NewService(
+		storeKey,
+		phuslu.NewLogger(io.Discard, &cfg),
+		dbm.NewMemDB(),
+		nil,
+		nil,
+		nil,
+	)
+
+	var (
+		pubkey  = crypto.BLSPubkey{0x01, 0x02}
+		valIdx  = uint64(7)
+		valBz   = []byte("validator")
+		balance = binary.BigEndian.AppendUint64(nil, 32e9)
+		slot    = binary.BigEndian.AppendUint64(nil, 2)
+	)
+
+	// Commit two versions so that proofs can be served.
+	cms := s.CommitMultiStore()
+	kvs := cms.GetKVStore(storeKey)
+	kvs.Set(keys.SlotKey(), binary.BigEndian.AppendUint64(nil, 1))
+	cms.Commit()
+
+	kvs.Set(keys.SlotKey(), slot)
+	kvs.Set(keys.BalanceKey(valIdx), balance)
+	kvs.Set(keys.ValidatorByIndexKey(valIdx), valBz)
+	kvs.Set(
+		index.ValidatorPubkeyKey(pubkey[:]),
+		binary.BigEndian.AppendUint64(nil, valIdx),
+	)
+	appHash := cms.Commit().Hash
+
+	for _, tc := range []struct {
+		name  string
+		path  string
+		key   []byte
+		value []byte
+	}{
+		{"slot", "/beacon/slot", keys.SlotKey(), slot},
+		{"balance", "/beacon/balance/7", keys.BalanceKey(valIdx), balance},
+		{
+			"validator",
+			"/beacon/validator/" + pubkey.String(),
+			keys.ValidatorByIndexKey(valIdx),
+			valBz,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := s.Query(context.Background(), &abci.QueryRequest{
+				Path:  tc.path,
+				Prove: true,
+			})
+			require.NoError(t, err)
+			require.Zero(t, resp.Code, resp.Log)
+			require.Equal(t, int64(2), resp.Height)
+			require.Equal(t, tc.key, resp.Key)
+			require.Equal(t, tc.value, resp.Value)
+
+			keyPath := merkle.KeyPath{}.
+				AppendKey([]byte(storeKey.Name()), merkle.KeyEncodingURL).
+				AppendKey(resp.Key, merkle.KeyEncodingURL)
+			require.NoError(t, rootmulti.DefaultProofRuntime().VerifyValue(
+				resp.ProofOps, appHash, keyPath.String(), resp.Value,
+			))
+		})
+	}
+
+	for _, path := range []string{
+		"/store/beacon/key",
+		"/beacon/balance/abc",
+		"/beacon/validator/0x01",
+		"/beacon/validator/" + crypto.BLSPubkey{0x03}.String(),
+		"/beacon/unknown",
+	} {
+		resp, err := s.Query(
+			context.Background(), &abci.QueryRequest{Path: path},
+		)
+		require.NoError(t, err)
+		require.NotZero(t, resp.Code, path)
+	}
+}
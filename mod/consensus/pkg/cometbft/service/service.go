@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 
+	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/params"
@@ -32,12 +33,14 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtdbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -45,16 +48,23 @@ import (
 const (
 	initialAppVersion uint64 = 0
 	appName           string = "beacond"
+	// cometStateDB is the name of the CometBFT state database.
+	cometStateDB = "state"
 )
 
+var errNodeNotRunning = errors.New("cometbft node is not running")
+
 type Service[
 	LoggerT log.AdvancedLogger[LoggerT],
 ] struct {
-	node   *node.Node
-	cmtCfg *cmtcfg.Config
+	node       *node.Node
+	cmtCfg     *cmtcfg.Config
+	stateStore sm.Store
+	blockStore sm.BlockStore
 
 	logger     LoggerT
 	sm         *statem.Manager
+	storeKey   storetypes.StoreKey
 	Middleware MiddlewareI
 
 	// prepareProposalState is used for PrepareProposal, which is set based on
@@ -77,12 +87,22 @@ type Service[
 
 	interBlockCache storetypes.MultiStorePersistentCache
 	paramStore      *params.ConsensusParamsStore
+	snapshotManager *snapshots.Manager
 
 	// initialHeight is the initial height at which we start the node
 	initialHeight   int64
 	minRetainBlocks uint64
 
 	chainID string
+
+	// checkpointSyncer, if set, verifies the state a fresh node restores
+	// from a snapshot against a trusted checkpoint.
+	checkpointSyncer CheckpointSyncer
+
+	// validatorResolver, if set, resolves CometBFT votes and misbehaviors
+	// to beacon chain validators.
+	validatorResolver ValidatorResolver
+	chainSpec         common.ChainSpec
 }
 
 func NewService[
@@ -102,9 +122,11 @@ func NewService[
 			db,
 			servercmtlog.WrapSDKLogger(logger),
 		),
+		storeKey:   storeKey,
 		Middleware: middleware,
 		cmtCfg:     cmtCfg,
 		paramStore: params.NewConsensusParamsStore(cs),
+		chainSpec:  cs,
 	}
 
 	s.MountStore(storeKey, storetypes.StoreTypeIAVL)
@@ -129,6 +151,10 @@ func NewService[
 func (s *Service[_]) Start(
 	ctx context.Context,
 ) error {
+	if err := s.syncFromCheckpoint(); err != nil {
+		return err
+	}
+
 	cfg := s.cmtCfg
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
@@ -145,7 +171,7 @@ func (s *Service[_]) Start(
 		nodeKey,
 		proxy.NewLocalClientCreator(s),
 		GetGenDocProvider(cfg),
-		cmtcfg.DefaultDBProvider,
+		s.dbProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		servercmtlog.WrapCometLogger(s.logger),
 	)
@@ -153,9 +179,26 @@ func (s *Service[_]) Start(
 		return err
 	}
 
+	// Keep a handle on the CometBFT block store to serve commit queries,
+	// e.g. for serving light clients.
+	s.blockStore = s.node.BlockStore()
+
 	return s.node.Start()
 }
 
+// dbProvider opens the CometBFT databases, keeping a handle on the state
+// store to serve validator set queries, e.g. for predicting proposers.
+func (s *Service[_]) dbProvider(ctx *cmtcfg.DBContext) (cmtdbm.DB, error) {
+	db, err := cmtcfg.DefaultDBProvider(ctx)
+	if err != nil || ctx.ID != cometStateDB {
+		return db, err
+	}
+	s.stateStore = sm.NewStore(db, sm.StoreOptions{
+		DBKeyLayout: ctx.Config.Storage.ExperimentalKeyLayout,
+	})
+	return db, nil
+}
+
 // Close is called in start cmd to gracefully cleanup resources.
 func (s *Service[_]) Close() error {
 	var errs []error
@@ -170,9 +213,35 @@ func (s *Service[_]) Close() error {
 	if err := s.sm.Close(); err != nil {
 		errs = append(errs, err)
 	}
+
+	if s.snapshotManager != nil {
+		s.logger.Info("Closing snapshots/metadata.db")
+		if err := s.snapshotManager.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	return errors.Join(errs...)
 }
 
+// Stop stops the CometBFT node and closes the application database.
+func (s *Service[_]) Stop(context.Context) error {
+	return s.Close()
+}
+
+// Status returns an error if the CometBFT node is not running.
+func (s *Service[_]) Status() error {
+	if s.node == nil || !s.node.IsRunning() {
+		return errNodeNotRunning
+	}
+	return nil
+}
+
+// Dependencies returns the services that must be running before CometBFT
+// starts calling into the application.
+func (s *Service[_]) Dependencies() []string {
+	return []string{s.Middleware.Name()}
+}
+
 // Name returns the name of the cometbft.
 func (s *Service[_]) Name() string {
 	return appName
@@ -218,14 +287,18 @@ func (s *Service[_]) setInterBlockCache(
 }
 
 // resetState provides a fresh state which can be used to reset
-// prepareProposal/processProposal/finalizeBlock State.
+// prepareProposal/processProposal/finalizeBlock State for the block at the
+// given height. The height is carried by the context, so that the beacon
+// state can tell the states of different blocks apart.
 // A state is explicitly returned to avoid false positives from
 // nilaway tool.
-func (s *Service[LoggerT]) resetState() *state {
+func (s *Service[LoggerT]) resetState(height int64) *state {
 	ms := s.sm.CommitMultiStore().CacheMultiStore()
 	return &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, false, servercmtlog.WrapSDKLogger(s.logger)),
+		ms: ms,
+		ctx: sdk.NewContext(
+			ms, false, servercmtlog.WrapSDKLogger(s.logger),
+		).WithBlockHeight(height),
 	}
 }
 
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package cometbft
+
+import (
+	"context"
+	"errors"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+// ListSnapshots lists the state sync snapshots of the application store
+// available to peers.
+func (s *Service[_]) ListSnapshots(
+	context.Context,
+	*cmtabci.ListSnapshotsRequest,
+) (*cmtabci.ListSnapshotsResponse, error) {
+	resp := &cmtabci.ListSnapshotsResponse{
+		Snapshots: []*cmtabci.Snapshot{},
+	}
+	if s.snapshotManager == nil {
+		return resp, nil
+	}
+
+	snapshots, err := s.snapshotManager.List()
+	if err != nil {
+		s.logger.Error("Failed to list snapshots", "err", err)
+		return nil, err
+	}
+	for _, snapshot := range snapshots {
+		abciSnapshot, err := snapshot.ToABCI()
+		if err != nil {
+			s.logger.Error("Failed to convert snapshot", "err", err)
+			return nil, err
+		}
+		resp.Snapshots = append(resp.Snapshots, &abciSnapshot)
+	}
+	return resp, nil
+}
+
+// LoadSnapshotChunk loads a chunk of a state sync snapshot to serve it to a
+// peer.
+func (s *Service[_]) LoadSnapshotChunk(
+	_ context.Context,
+	req *cmtabci.LoadSnapshotChunkRequest,
+) (*cmtabci.LoadSnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		return &cmtabci.LoadSnapshotChunkResponse{}, nil
+	}
+
+	chunk, err := s.snapshotManager.LoadChunk(
+		req.Height, req.Format, req.Chunk,
+	)
+	if err != nil {
+		s.logger.Error(
+			"Failed to load snapshot chunk",
+			"height", req.Height,
+			"format", req.Format,
+			"chunk", req.Chunk,
+			"err", err,
+		)
+		return nil, err
+	}
+	return &cmtabci.LoadSnapshotChunkResponse{Chunk: chunk}, nil
+}
+
+// OfferSnapshot starts restoring the application store from a state sync
+// snapshot offered by a peer.
+func (s *Service[_]) OfferSnapshot(
+	_ context.Context,
+	req *cmtabci.OfferSnapshotRequest,
+) (*cmtabci.OfferSnapshotResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+	if req.Snapshot == nil {
+		s.logger.Error("Received nil snapshot")
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	snapshot, err := snapshottypes.SnapshotFromABCI(req.Snapshot)
+	if err != nil {
+		s.logger.Error("Failed to decode snapshot metadata", "err", err)
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	err = s.snapshotManager.Restore(snapshot)
+	switch {
+	case err == nil:
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_ACCEPT,
+		}, nil
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT,
+		}, nil
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		s.logger.Error(
+			"Rejecting invalid snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"err", err,
+		)
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	default:
+		// The application store cannot be reset to retry another snapshot,
+		// so every restoration is aborted.
+		s.logger.Error(
+			"Failed to restore snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"err", err,
+		)
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+}
+
+// ApplySnapshotChunk restores a chunk of the snapshot being restored. Once
+// the last chunk is restored, the beacon state is verified against the
+// trusted checkpoint, if any.
+func (s *Service[_]) ApplySnapshotChunk(
+	ctx context.Context,
+	req *cmtabci.ApplySnapshotChunkRequest,
+) (*cmtabci.ApplySnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &cmtabci.ApplySnapshotChunkResponse{
+			Result: cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+
+	done, err := s.snapshotManager.RestoreChunk(req.Chunk)
+	if err == nil && done {
+		err = s.verifyCheckpoint(ctx)
+	}
+	switch {
+	case err == nil:
+		return &cmtabci.ApplySnapshotChunkResponse{
+			Result: cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT,
+		}, nil
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		s.logger.Error(
+			"Chunk checksum mismatch, rejecting sender and refetching",
+			"chunk", req.Index,
+			"sender", req.Sender,
+			"err", err,
+		)
+		return &cmtabci.ApplySnapshotChunkResponse{
+			Result:        cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}, nil
+	default:
+		s.logger.Error("Failed to restore snapshot", "err", err)
+		return &cmtabci.ApplySnapshotChunkResponse{
+			Result: cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+}
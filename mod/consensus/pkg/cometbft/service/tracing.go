@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer records the spans of the ABCI methods. It resolves to a no-op
+// tracer unless a tracer provider is installed.
+//
+//nolint:gochecknoglobals // tracers are looked up once per package.
+var tracer = otel.Tracer(
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service",
+)
+
+// startSpan starts the span of an ABCI method for the given height, which is
+// also the slot of the block.
+func startSpan(
+	ctx context.Context,
+	name string,
+	height int64,
+) trace.Span {
+	_, span := tracer.Start(
+		ctx, name, trace.WithAttributes(attribute.Int64("slot", height)),
+	)
+	return span
+}
+
+// withSpan returns a copy of the sdk context carrying the span, so that the
+// spans started by the services handling the request are its children.
+func withSpan(ctx sdk.Context, span trace.Span) sdk.Context {
+	return ctx.WithContext(trace.ContextWithSpan(ctx.Context(), span))
+}
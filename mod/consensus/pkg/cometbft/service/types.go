@@ -39,17 +39,31 @@ type AttestationData[AttestationDataT any] interface {
 	New(math.U64, math.U64, common.Root) AttestationDataT
 }
 
+// BeaconBlockHeader is an interface for accessing the beacon block header.
+type BeaconBlockHeader interface {
+	// GetStateRoot returns the state root of the beacon block header.
+	GetStateRoot() common.Root
+	// SetStateRoot sets the state root of the beacon block header.
+	SetStateRoot(common.Root)
+	// HashTreeRoot returns the hash tree root of the beacon block header.
+	HashTreeRoot() common.Root
+}
+
 // BeaconState is an interface for accessing the beacon state.
-type BeaconState interface {
+type BeaconState[BeaconBlockHeaderT BeaconBlockHeader] interface {
 	// GetValidatorIndexByCometBFTAddress returns the validator index by the
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
+	// GetLatestBlockHeader returns the header of the latest beacon block.
+	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
 	HashTreeRoot() common.Root
 }
 
 type MiddlewareI interface {
+	// Name returns the name of the middleware service.
+	Name() string
 	InitGenesis(
 		ctx context.Context, bz []byte,
 	) (transition.ValidatorUpdates, error)
@@ -65,6 +79,14 @@ type MiddlewareI interface {
 	) (transition.ValidatorUpdates, error)
 }
 
+// CheckpointSyncer verifies the beacon state a fresh node restored from a
+// snapshot against a trusted checkpoint.
+type CheckpointSyncer interface {
+	// Verify verifies the beacon state behind ctx against the checkpoint and
+	// returns its slot.
+	Verify(ctx context.Context) (math.Slot, error)
+}
+
 // SlashingInfo is an interface for accessing the slashing info.
 type SlashingInfo[SlashingInfoT any] interface {
 	// New creates a new slashing info instance.
@@ -79,7 +101,20 @@ type SlotData[AttestationDataT, SlashingInfoT, SlotDataT any] interface {
 
 // StorageBackend defines an interface for accessing various storage components
 // required by the beacon node.
-type StorageBackend[BeaconStateT BeaconState] interface {
+type StorageBackend[BeaconStateT any] interface {
 	// StateFromContext retrieves the beacon state from the given context.
 	StateFromContext(context.Context) BeaconStateT
 }
+
+// ValidatorResolver resolves the CometBFT validators voting and misbehaving
+// in a block to the beacon chain validators they belong to.
+type ValidatorResolver interface {
+	// ValidatorIndexByCometBFTAddress returns the index of the validator
+	// with the given CometBFT address in the beacon state behind ctx.
+	ValidatorIndexByCometBFTAddress(
+		ctx context.Context, cometBFTAddress []byte,
+	) (math.ValidatorIndex, error)
+	// LatestBlockRoot returns the root of the latest beacon block processed
+	// into the beacon state behind ctx.
+	LatestBlockRoot(ctx context.Context) (common.Root, error)
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// stateValidatorResolver is a ValidatorResolver reading from the beacon
+// state of a storage backend.
+type stateValidatorResolver[
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconBlockHeaderT],
+] struct {
+	sb StorageBackend[BeaconStateT]
+}
+
+// NewValidatorResolver returns a ValidatorResolver reading from the beacon
+// state of the given storage backend.
+func NewValidatorResolver[
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconBlockHeaderT],
+](
+	sb StorageBackend[BeaconStateT],
+) ValidatorResolver {
+	return &stateValidatorResolver[BeaconBlockHeaderT, BeaconStateT]{sb: sb}
+}
+
+// ValidatorIndexByCometBFTAddress returns the index of the validator with the
+// given CometBFT address in the beacon state behind ctx.
+func (r *stateValidatorResolver[_, _]) ValidatorIndexByCometBFTAddress(
+	ctx context.Context,
+	cometBFTAddress []byte,
+) (math.ValidatorIndex, error) {
+	return r.sb.StateFromContext(ctx).ValidatorIndexByCometBFTAddress(
+		cometBFTAddress,
+	)
+}
+
+// LatestBlockRoot returns the root of the latest beacon block processed into
+// the beacon state behind ctx.
+func (r *stateValidatorResolver[_, _]) LatestBlockRoot(
+	ctx context.Context,
+) (common.Root, error) {
+	st := r.sb.StateFromContext(ctx)
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	// The state root of the latest block header is only filled in when the
+	// next slot is processed, as it is the root of the state we read from.
+	if header.GetStateRoot() == (common.Root{}) {
+		header.SetStateRoot(st.HashTreeRoot())
+	}
+	return header.HashTreeRoot(), nil
+}
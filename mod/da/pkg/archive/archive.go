@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// sidecarsPrefix prefixes the keys of the SSZ encoded sidecars of a
+	// slot, which are followed by the slot and sidecarsSuffix.
+	sidecarsPrefix = "sidecars/"
+	// sidecarsSuffix is the suffix of the keys of the sidecars of a slot.
+	sidecarsSuffix = ".ssz"
+	// indexPrefix prefixes the keys of the versioned hash index, which are
+	// followed by the hex encoded versioned hash of a blob and hold the slot
+	// the blob was included in.
+	indexPrefix = "index/"
+	// cursorKey is the key of the cursor, which holds the slot up to which
+	// the sidecars of all finalized blocks are archived.
+	cursorKey = "cursor"
+)
+
+// Archive keeps the blob sidecars of finalized blocks for longer than the
+// availability store, which prunes them once they are out of the data
+// availability window. It holds the sidecars of each slot, along with an
+// index of the slot each blob, identified by its versioned hash, was included
+// in, and a cursor past which the sidecars are not archived yet.
+type Archive struct {
+	// backend is the object store the archive is kept in.
+	backend Backend
+	// cursor is the slot up to which the sidecars of all finalized blocks
+	// are archived.
+	cursor atomic.Uint64
+}
+
+// New creates a new archive kept in the given backend.
+func New(backend Backend) *Archive {
+	return &Archive{backend: backend}
+}
+
+// Put archives the sidecars of a block, followed by their index entries, so
+// that the index never points to sidecars that are not archived.
+func (a *Archive) Put(ctx context.Context, sidecars *types.BlobSidecars) error {
+	if sidecars.IsNil() || sidecars.Len() == 0 {
+		return nil
+	}
+	// All the sidecars belong to the same block at this point.
+	slot := sidecars.Sidecars[0].BeaconBlockHeader.GetSlot()
+	bz, err := sidecars.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if err = a.backend.Put(ctx, sidecarsKey(slot), bz); err != nil {
+		return err
+	}
+	for _, sidecar := range sidecars.Sidecars {
+		if err = a.backend.Put(
+			ctx,
+			indexKey(sidecar.KzgCommitment.ToVersionedHash()),
+			[]byte(slot.Base10()),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBlobSidecars returns the archived sidecars of the block at the given
+// slot, or ErrNotFound. A nil archive, as provided when archiving is
+// disabled, holds no sidecars.
+func (a *Archive) GetBlobSidecars(
+	ctx context.Context,
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	if a == nil {
+		return nil, ErrNotFound
+	}
+	bz, err := a.backend.Get(ctx, sidecarsKey(slot))
+	if err != nil {
+		return nil, err
+	}
+	sidecars := &types.BlobSidecars{}
+	if err = sidecars.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+	return sidecars, nil
+}
+
+// GetBlobSidecar returns the archived sidecar of the blob with the given
+// versioned hash, or ErrNotFound.
+func (a *Archive) GetBlobSidecar(
+	ctx context.Context,
+	versionedHash common.ExecutionHash,
+) (*types.BlobSidecar, error) {
+	if a == nil {
+		return nil, ErrNotFound
+	}
+	bz, err := a.backend.Get(ctx, indexKey(versionedHash))
+	if err != nil {
+		return nil, err
+	}
+	slot, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidIndexEntry, "%q", bz)
+	}
+	sidecars, err := a.GetBlobSidecars(ctx, math.Slot(slot))
+	if err != nil {
+		return nil, err
+	}
+	for _, sidecar := range sidecars.Sidecars {
+		if sidecar.KzgCommitment.ToVersionedHash() == versionedHash {
+			return sidecar, nil
+		}
+	}
+	return nil, errors.Wrapf(
+		ErrInvalidIndexEntry, "blob %s is not in slot %d", versionedHash, slot,
+	)
+}
+
+// LoadCursor reads the cursor from the archive, which is zero if it was never
+// set.
+func (a *Archive) LoadCursor(ctx context.Context) (math.Slot, error) {
+	bz, err := a.backend.Get(ctx, cursorKey)
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	slot, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(ErrInvalidCursor, "%q", bz)
+	}
+	a.cursor.Store(slot)
+	return math.Slot(slot), nil
+}
+
+// SetCursor stores the slot up to which the sidecars of all finalized blocks
+// are archived.
+func (a *Archive) SetCursor(ctx context.Context, slot math.Slot) error {
+	if err := a.backend.Put(
+		ctx, cursorKey, []byte(slot.Base10()),
+	); err != nil {
+		return err
+	}
+	a.cursor.Store(slot.Unwrap())
+	return nil
+}
+
+// Cursor returns the slot up to which the sidecars of all finalized blocks
+// are archived, as last loaded or set. It is safe for concurrent use.
+func (a *Archive) Cursor() math.Slot {
+	return math.Slot(a.cursor.Load())
+}
+
+// sidecarsKey returns the key of the sidecars of the given slot.
+func sidecarsKey(slot math.Slot) string {
+	return sidecarsPrefix + slot.Base10() + sidecarsSuffix
+}
+
+// indexKey returns the index key of the blob with the given versioned hash.
+func indexKey(versionedHash common.ExecutionHash) string {
+	return indexPrefix + versionedHash.Hex()
+}
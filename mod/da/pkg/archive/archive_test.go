@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive_test
+
+import (
+	"context"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testSidecars returns the sidecars of a block at the given slot with a blob
+// per commitment byte.
+func testSidecars(slot math.Slot, commitments ...byte) *types.BlobSidecars {
+	header := &ctypes.BeaconBlockHeader{}
+	header = header.New(slot, 1, common.Root{1}, common.Root{2}, common.Root{3})
+	sidecars := &types.BlobSidecars{}
+	for i, c := range commitments {
+		sidecars.Sidecars = append(sidecars.Sidecars, &types.BlobSidecar{
+			Index:             uint64(i),
+			Blob:              eip4844.Blob{c},
+			KzgCommitment:     eip4844.KZGCommitment{c},
+			KzgProof:          eip4844.KZGProof{c},
+			BeaconBlockHeader: header,
+			InclusionProof:    make([]common.Root, 8),
+		})
+	}
+	return sidecars
+}
+
+func TestArchive_DirBackend(t *testing.T) {
+	ctx := context.Background()
+	ar := archive.New(archive.NewDirBackend(t.TempDir()))
+
+	sidecars := testSidecars(7, 0xaa, 0xbb)
+	require.NoError(t, ar.Put(ctx, sidecars))
+	// Blocks without blobs are not archived.
+	require.NoError(t, ar.Put(ctx, testSidecars(8)))
+
+	got, err := ar.GetBlobSidecars(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, sidecars, got)
+
+	_, err = ar.GetBlobSidecars(ctx, 8)
+	require.ErrorIs(t, err, archive.ErrNotFound)
+
+	// Blobs are found by their versioned hash.
+	sidecar, err := ar.GetBlobSidecar(
+		ctx, sidecars.Sidecars[1].KzgCommitment.ToVersionedHash(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sidecars.Sidecars[1], sidecar)
+
+	_, err = ar.GetBlobSidecar(
+		ctx, eip4844.KZGCommitment{0xcc}.ToVersionedHash(),
+	)
+	require.ErrorIs(t, err, archive.ErrNotFound)
+}
+
+func TestNewBackend(t *testing.T) {
+	cfg := archive.DefaultConfig()
+	backend, err := archive.NewBackend(cfg, t.TempDir())
+	require.NoError(t, err)
+	require.IsType(t, &archive.DirBackend{}, backend)
+
+	cfg.Backend = archive.BackendS3
+	_, err = archive.NewBackend(cfg, "")
+	require.ErrorIs(t, err, archive.ErrInvalidS3Config)
+
+	cfg.Backend = "tape"
+	_, err = archive.NewBackend(cfg, "")
+	require.ErrorIs(t, err, archive.ErrUnknownBackend)
+}
+
+func TestArchive_Cursor(t *testing.T) {
+	ctx := context.Background()
+	ar := archive.New(archive.NewDirBackend(t.TempDir()))
+
+	cursor, err := ar.LoadCursor(ctx)
+	require.NoError(t, err)
+	require.Zero(t, cursor)
+
+	require.NoError(t, ar.SetCursor(ctx, 42))
+	require.Equal(t, math.Slot(42), ar.Cursor())
+}
+
+func TestHoldBackPruneRange(t *testing.T) {
+	ar := archive.New(archive.NewDirBackend(t.TempDir()))
+	pruneRangeFn := archive.HoldBackPruneRange(
+		ar,
+		func(event async.Event[math.Slot]) (uint64, uint64) {
+			return 0, event.Data().Unwrap()
+		},
+	)
+	event := func(slot math.Slot) async.Event[math.Slot] {
+		return async.NewEvent(
+			context.Background(), async.BeaconBlockFinalized, slot,
+		)
+	}
+
+	// Nothing is pruned before the cursor is set.
+	start, end := pruneRangeFn(event(100))
+	require.Equal(t, []uint64{0, 1}, []uint64{start, end})
+
+	require.NoError(t, ar.SetCursor(context.Background(), 50))
+	start, end = pruneRangeFn(event(100))
+	require.Equal(t, []uint64{0, 51}, []uint64{start, end})
+	start, end = pruneRangeFn(event(20))
+	require.Equal(t, []uint64{0, 20}, []uint64{start, end})
+}
+
+func TestArchive_Nil(t *testing.T) {
+	var ar *archive.Archive
+	_, err := ar.GetBlobSidecars(context.Background(), 7)
+	require.ErrorIs(t, err, archive.ErrNotFound)
+}
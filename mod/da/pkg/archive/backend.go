@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// Backend is the object store the archive is kept in.
+type Backend interface {
+	// Put stores the value under the given slash separated key, overriding
+	// any previous value.
+	Put(ctx context.Context, key string, value []byte) error
+	// Get returns the value stored under the given key, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// NewBackend creates the backend selected by the given configuration,
+// resolving a relative archive directory against the home directory.
+func NewBackend(cfg Config, homeDir string) (Backend, error) {
+	switch cfg.Backend {
+	case BackendDir:
+		dir := cfg.Dir
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(homeDir, dir)
+		}
+		return NewDirBackend(dir), nil
+	case BackendS3:
+		return NewS3Backend(
+			cfg.S3Endpoint,
+			cfg.S3Bucket,
+			cfg.S3Region,
+			cfg.S3AccessKeyID,
+			cfg.S3SecretAccessKey,
+			&http.Client{Timeout: cfg.S3Timeout},
+		)
+	default:
+		return nil, errors.Wrapf(ErrUnknownBackend, "%q", cfg.Backend)
+	}
+}
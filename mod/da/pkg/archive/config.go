@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import "time"
+
+const (
+	// BackendDir archives the sidecars to a local directory tree.
+	BackendDir = "dir"
+	// BackendS3 archives the sidecars to an S3-compatible object store.
+	BackendS3 = "s3"
+
+	// defaultDir is the default archive directory, relative to the home
+	// directory.
+	defaultDir = "data/blob-archive"
+	// defaultS3Region is the default region requests are signed for, which
+	// is the one MinIO expects unless configured otherwise.
+	defaultS3Region = "us-east-1"
+	// defaultS3Timeout is the default timeout of a request to the object
+	// store.
+	defaultS3Timeout = 30 * time.Second
+)
+
+// Config is the configuration of the blob archive.
+type Config struct {
+	// Enabled determines if the sidecars of finalized blocks are archived.
+	Enabled bool `mapstructure:"enabled"`
+	// Backend is the archive backend, either "dir" or "s3".
+	Backend string `mapstructure:"backend"`
+	// Dir is the directory of the "dir" backend. Relative paths are
+	// resolved against the home directory.
+	Dir string `mapstructure:"dir"`
+	// S3Endpoint is the URL of the S3-compatible API, e.g.
+	// http://localhost:9000 for a local MinIO.
+	S3Endpoint string `mapstructure:"s3-endpoint"`
+	// S3Bucket is the bucket the sidecars are archived to.
+	S3Bucket string `mapstructure:"s3-bucket"`
+	// S3Region is the region requests are signed for.
+	S3Region string `mapstructure:"s3-region"`
+	// S3AccessKeyID is the access key ID requests are signed with.
+	S3AccessKeyID string `mapstructure:"s3-access-key-id"`
+	// S3SecretAccessKey is the secret access key requests are signed with.
+	S3SecretAccessKey string `mapstructure:"s3-secret-access-key"`
+	// S3Timeout is the timeout of a request to the object store.
+	S3Timeout time.Duration `mapstructure:"s3-timeout"`
+}
+
+// DefaultConfig returns the default configuration of the blob archive, which
+// is disabled.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:   false,
+		Backend:   BackendDir,
+		Dir:       defaultDir,
+		S3Region:  defaultS3Region,
+		S3Timeout: defaultS3Timeout,
+	}
+}
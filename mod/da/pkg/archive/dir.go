@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// DirBackend keeps the archive in a local directory tree, with a file per
+// key.
+type DirBackend struct {
+	// root is the directory the keys are relative to.
+	root string
+}
+
+// NewDirBackend creates a new backend keeping the archive under root.
+func NewDirBackend(root string) *DirBackend {
+	return &DirBackend{root: root}
+}
+
+// Put writes the value to the file of the key. The value is written to a
+// temporary file first and renamed into place, so that a crash never leaves
+// a partially written value behind.
+func (b *DirBackend) Put(_ context.Context, key string, value []byte) error {
+	path := b.path(key)
+	dir := filepath.Dir(path)
+	//#nosec:G301 // the archive is meant to be shared.
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(value); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Sync(); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get reads the value from the file of the key.
+func (b *DirBackend) Get(_ context.Context, key string) ([]byte, error) {
+	value, err := os.ReadFile(b.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrap(ErrNotFound, key)
+	}
+	return value, err
+}
+
+// path returns the path of the file of the key.
+func (b *DirBackend) path(key string) string {
+	return filepath.Join(b.root, filepath.FromSlash(key))
+}
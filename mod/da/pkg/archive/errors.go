@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNotFound is returned when a key is not in the archive.
+	ErrNotFound = errors.New("not found in blob archive")
+	// ErrUnknownBackend is returned when the configured backend is unknown.
+	ErrUnknownBackend = errors.New("unknown blob archive backend")
+	// ErrInvalidS3Config is returned when the object store configuration is
+	// invalid.
+	ErrInvalidS3Config = errors.New("invalid object store configuration")
+	// ErrUnexpectedStatus is returned when the object store answers a
+	// request with an unexpected status.
+	ErrUnexpectedStatus = errors.New("unexpected status from object store")
+	// ErrInvalidIndexEntry is returned when an entry of the versioned hash
+	// index does not point to a sidecar in the archive.
+	ErrInvalidIndexEntry = errors.New("invalid blob archive index entry")
+	// ErrInvalidCursor is returned when the cursor of the archive is not a
+	// slot.
+	ErrInvalidCursor = errors.New("invalid blob archive cursor")
+)
@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// sigV4Algorithm is the algorithm of AWS Signature Version 4.
+	sigV4Algorithm = "AWS4-HMAC-SHA256"
+	// sigV4Service is the service requests are signed for.
+	sigV4Service = "s3"
+	// sigV4Terminator terminates the credential scope.
+	sigV4Terminator = "aws4_request"
+	// amzDateFormat is the format of the X-Amz-Date header.
+	amzDateFormat = "20060102T150405Z"
+	// scopeDateFormat is the format of the date of the credential scope.
+	scopeDateFormat = "20060102"
+	// headerAmzDate is the header holding the time the request was signed.
+	headerAmzDate = "X-Amz-Date"
+	// headerAmzContentSHA256 is the header holding the hash of the payload.
+	headerAmzContentSHA256 = "X-Amz-Content-Sha256"
+)
+
+// S3Backend keeps the archive in a bucket of an S3-compatible object store,
+// such as AWS S3 or MinIO, with an object per key. Objects are addressed
+// path-style, so that any endpoint works without DNS for the bucket.
+type S3Backend struct {
+	// endpoint is the URL of the S3-compatible API.
+	endpoint *url.URL
+	// bucket is the bucket the objects are kept in.
+	bucket string
+	// region is the region requests are signed for.
+	region string
+	// accessKeyID is the access key ID requests are signed with.
+	accessKeyID string
+	// secretAccessKey is the secret access key requests are signed with.
+	secretAccessKey string
+	// httpClient issues the requests.
+	httpClient *http.Client
+	// now returns the time requests are signed at.
+	now func() time.Time
+}
+
+// NewS3Backend creates a new backend keeping the archive in the given bucket
+// of the object store served at endpoint.
+func NewS3Backend(
+	endpoint string,
+	bucket string,
+	region string,
+	accessKeyID string,
+	secretAccessKey string,
+	httpClient *http.Client,
+) (*S3Backend, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Wrapf(ErrInvalidS3Config, "endpoint %q", endpoint)
+	}
+	if bucket == "" {
+		return nil, errors.Wrap(ErrInvalidS3Config, "bucket is not set")
+	}
+	return &S3Backend{
+		endpoint:        u,
+		bucket:          bucket,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		httpClient:      httpClient,
+		now:             time.Now,
+	}, nil
+}
+
+// Put uploads the value as the object of the key.
+func (b *S3Backend) Put(ctx context.Context, key string, value []byte) error {
+	resp, err := b.do(ctx, http.MethodPut, key, value)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return statusError(resp)
+	}
+	return nil
+}
+
+// Get downloads the object of the key.
+func (b *S3Backend) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := b.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, errors.Wrap(ErrNotFound, key)
+	default:
+		return nil, statusError(resp)
+	}
+}
+
+// do issues a signed request for the object of the key.
+func (b *S3Backend) do(
+	ctx context.Context,
+	method string,
+	key string,
+	body []byte,
+) (*http.Response, error) {
+	u := *b.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + b.bucket + "/" + key
+	req, err := http.NewRequestWithContext(
+		ctx, method, u.String(), bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	signV4(
+		req, body, b.now(), b.region, b.accessKeyID, b.secretAccessKey,
+	)
+	return b.httpClient.Do(req)
+}
+
+// statusError returns the error of a response with an unexpected status,
+// including the beginning of its body, which holds the error of the store.
+func statusError(resp *http.Response) error {
+	//nolint:mnd // enough for the error code and message.
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return errors.Wrapf(
+		ErrUnexpectedStatus, "%s: %s", resp.Status, bytes.TrimSpace(msg),
+	)
+}
+
+// signV4 signs the request with AWS Signature Version 4, as described in
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html
+// Every header set on the request is signed along with the host.
+//
+//nolint:lll // link.
+func signV4(
+	req *http.Request,
+	body []byte,
+	now time.Time,
+	region string,
+	accessKeyID string,
+	secretAccessKey string,
+) {
+	now = now.UTC()
+	payloadHash := sha256.Sum256(body)
+	req.Header.Set(headerAmzDate, now.Format(amzDateFormat))
+	req.Header.Set(headerAmzContentSHA256, hex.EncodeToString(payloadHash[:]))
+
+	// Canonicalize the headers, which are sorted by their lowercase name.
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(
+			strings.Join(values, ","),
+		)
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get(headerAmzContentSHA256),
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+
+	scope := strings.Join([]string{
+		now.Format(scopeDateFormat), region, sigV4Service, sigV4Terminator,
+	}, "/")
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		now.Format(amzDateFormat),
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, part := range []string{
+		now.Format(scopeDateFormat), region, sigV4Service, sigV4Terminator,
+	} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", sigV4Algorithm+
+		" Credential="+accessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+
+		", Signature="+signature,
+	)
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of the data with the given key.
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"context"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// putAttempts is the number of attempts made to archive the sidecars of
+	// a block before leaving them to the next finalized block.
+	putAttempts = 3
+	// retryInterval is the time waited between two attempts.
+	retryInterval = time.Second
+)
+
+// Service archives the blob sidecars of finalized blocks, long before they
+// are pruned from the availability store.
+//
+// The sidecars are read from the availability store, which holds the
+// sidecars of a block, recovered ones included, before the block is
+// finalized. The archive keeps a cursor, the slot up to which the sidecars
+// of all finalized blocks are archived, and on each finalized block the
+// service archives the slots from the cursor up to the block. The slots left
+// behind on start, or after a failure, are thus backfilled, while the
+// availability store holds back pruning past the cursor. Archiving happens
+// in the background, as the object store may be slow to answer.
+type Service[BeaconBlockT BeaconBlock] struct {
+	// config is the configuration of the archive.
+	config Config
+	// archive is the archive the sidecars are written to.
+	archive *Archive
+	// availabilityStore is the store the sidecars are read from.
+	availabilityStore AvailabilityStore
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// subFinalizedBlocks is a channel holding BeaconBlockFinalized events.
+	subFinalizedBlocks chan async.Event[BeaconBlockT]
+	// finalized holds the slot of the last finalized block, replaced by the
+	// next one if it is not taken yet.
+	finalized chan math.Slot
+	// stopped is closed once the archiving has stopped.
+	stopped chan struct{}
+}
+
+// NewService creates a new archive service. The archive may be nil if the
+// service is disabled.
+func NewService[BeaconBlockT BeaconBlock](
+	config Config,
+	archive *Archive,
+	availabilityStore AvailabilityStore,
+	dispatcher asynctypes.EventDispatcher,
+	logger log.Logger,
+) *Service[BeaconBlockT] {
+	return &Service[BeaconBlockT]{
+		config:             config,
+		archive:            archive,
+		availabilityStore:  availabilityStore,
+		dispatcher:         dispatcher,
+		logger:             logger,
+		subFinalizedBlocks: make(chan async.Event[BeaconBlockT]),
+		finalized:          make(chan math.Slot, 1),
+		stopped:            make(chan struct{}),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_]) Name() string {
+	return "blob-archive"
+}
+
+// Start subscribes the service to the finalized block events and starts
+// archiving the sidecars of finalized blocks.
+func (s *Service[_]) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		close(s.stopped)
+		return nil
+	}
+
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlocks,
+	); err != nil {
+		return err
+	}
+
+	go s.archiveLoop(ctx)
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// Stop waits for the sidecars being archived when the context passed to
+// Start was done to be archived.
+func (s *Service[_]) Stop(ctx context.Context) error {
+	select {
+	case <-s.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// eventLoop hands the slots of the finalized blocks over to the archive loop,
+// so that the events are not held up by a slow archive.
+func (s *Service[_]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-s.subFinalizedBlocks:
+			if !ok {
+				return
+			}
+			// Only the last finalized slot matters to the archive loop.
+			select {
+			case <-s.finalized:
+			default:
+			}
+			s.finalized <- event.Data().GetSlot()
+		}
+	}
+}
+
+// archiveLoop loads the cursor of the archive and archives the sidecars up to
+// each finalized slot.
+func (s *Service[_]) archiveLoop(ctx context.Context) {
+	defer close(s.stopped)
+	// Requests are not cancelled midway, the loop stops in between slots.
+	reqCtx := context.WithoutCancel(ctx)
+
+	cursor, err := s.archive.LoadCursor(reqCtx)
+	for err != nil {
+		s.logger.Error("Failed to load blob archive cursor", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+		cursor, err = s.archive.LoadCursor(reqCtx)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case slot := <-s.finalized:
+			cursor = s.archiveUpTo(ctx, reqCtx, cursor, slot)
+		}
+	}
+}
+
+// archiveUpTo archives the sidecars of the slots after the cursor up to the
+// finalized slot, and returns the new cursor. It stops at the first slot
+// that fails to be archived, which is retried with the next finalized block.
+// Slots without sidecars in the availability store had no blobs, or were
+// pruned before the archive was enabled.
+func (s *Service[_]) archiveUpTo(
+	ctx, reqCtx context.Context,
+	cursor, finalized math.Slot,
+) math.Slot {
+	stored := cursor
+	for slot := cursor + 1; slot <= finalized && ctx.Err() == nil; slot++ {
+		sidecars, err := s.availabilityStore.GetBlobSidecars(slot)
+		if errors.Is(err, store.ErrSidecarsNotFound) {
+			cursor = slot
+			continue
+		} else if err != nil {
+			s.logger.Error(
+				"Failed to read blob sidecars to archive",
+				"slot", slot.Base10(), "error", err,
+			)
+			break
+		}
+		if !s.put(reqCtx, slot, sidecars) {
+			break
+		}
+		// The cursor is stored with each archived block, so that a restart
+		// does not archive the blocks again.
+		cursor = slot
+		if s.storeCursor(reqCtx, cursor) {
+			stored = cursor
+		}
+	}
+	if cursor != stored {
+		s.storeCursor(reqCtx, cursor)
+	}
+	return cursor
+}
+
+// storeCursor stores the cursor in the archive and reports whether it was
+// stored. The availability store is pruned up to the stored cursor.
+func (s *Service[_]) storeCursor(ctx context.Context, cursor math.Slot) bool {
+	if err := s.archive.SetCursor(ctx, cursor); err != nil {
+		s.logger.Error(
+			"Failed to store blob archive cursor",
+			"slot", cursor.Base10(), "error", err,
+		)
+		return false
+	}
+	return true
+}
+
+// put archives the sidecars of a block, retrying a few times on failure, and
+// reports whether they were archived.
+func (s *Service[_]) put(
+	ctx context.Context,
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) bool {
+	var err error
+	for attempt := range putAttempts {
+		if attempt > 0 {
+			time.Sleep(retryInterval)
+		}
+		if err = s.archive.Put(ctx, sidecars); err == nil {
+			s.logger.Info(
+				"Archived blob sidecars 🗄️",
+				"slot", slot.Base10(), "num_sidecars", sidecars.Len(),
+			)
+			return true
+		}
+	}
+	s.logger.Error(
+		"Failed to archive blob sidecars",
+		"slot", slot.Base10(), "error", err,
+	)
+	return false
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+type testBlock math.Slot
+
+func (b testBlock) GetSlot() math.Slot { return math.Slot(b) }
+
+func (b testBlock) HashTreeRoot() common.Root { return common.Root{byte(b)} }
+
+// testDispatcher hands the finalized blocks subscription over to the test.
+type testDispatcher struct {
+	finalized chan async.Event[testBlock]
+}
+
+func (*testDispatcher) Publish(async.BaseEvent) error { return nil }
+
+func (d *testDispatcher) Subscribe(_ async.EventID, ch any) error {
+	d.finalized = ch.(chan async.Event[testBlock])
+	return nil
+}
+
+func (*testDispatcher) Unsubscribe(async.EventID, any) error { return nil }
+
+// testStore holds the sidecars of some slots, and fails to read the slot in
+// failing.
+type testStore struct {
+	sidecars map[math.Slot]*types.BlobSidecars
+	failing  chan math.Slot
+}
+
+func (s *testStore) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	select {
+	case failing := <-s.failing:
+		if failing == slot {
+			return nil, errUnavailable
+		}
+		s.failing <- failing
+	default:
+	}
+	if sidecars, ok := s.sidecars[slot]; ok {
+		return sidecars, nil
+	}
+	return nil, store.ErrSidecarsNotFound
+}
+
+func TestService_Backfill(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	ar := archive.New(archive.NewDirBackend(dir))
+	require.NoError(t, ar.SetCursor(ctx, 2))
+	st := &testStore{
+		sidecars: map[math.Slot]*types.BlobSidecars{
+			2: testSidecars(2, 0x02),
+			3: testSidecars(3, 0x03),
+			4: testSidecars(4, 0x04),
+			6: testSidecars(6, 0x06),
+		},
+		failing: make(chan math.Slot, 1),
+	}
+	// Slot 4 fails once, and is archived with the next finalized block.
+	st.failing <- 4
+
+	dispatcher := &testDispatcher{}
+	cfg := archive.DefaultConfig()
+	cfg.Enabled = true
+	svc := archive.NewService[testBlock](
+		cfg, ar, st, dispatcher, noop.NewLogger[any](),
+	)
+	require.NoError(t, svc.Start(ctx))
+
+	finalize := func(slot math.Slot, cursor math.Slot) {
+		dispatcher.finalized <- async.NewEvent(
+			ctx, async.BeaconBlockFinalized, testBlock(slot),
+		)
+		require.Eventually(t, func() bool {
+			return ar.Cursor() == cursor
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+	finalize(5, 3)
+	finalize(6, 6)
+
+	// The slots up to the stored cursor are not archived again.
+	_, err := ar.GetBlobSidecars(ctx, 2)
+	require.ErrorIs(t, err, archive.ErrNotFound)
+	for _, slot := range []math.Slot{3, 4, 6} {
+		got, getErr := ar.GetBlobSidecars(ctx, slot)
+		require.NoError(t, getErr)
+		require.Equal(t, st.sidecars[slot], got)
+	}
+
+	cancel()
+	require.NoError(t, svc.Stop(context.Background()))
+
+	// The cursor survives a restart.
+	cursor, err := archive.New(archive.NewDirBackend(dir)).LoadCursor(
+		context.Background(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(6), cursor)
+}
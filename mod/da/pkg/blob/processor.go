@@ -21,8 +21,10 @@
 package blob
 
 import (
+	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -45,6 +47,9 @@ type Processor[
 	chainSpec common.ChainSpec
 	// verifier is responsible for verifying the blobs.
 	verifier BlobVerifier[BlobSidecarsT]
+	// deferredVerifier verifies the KZG proofs of the processed sidecars in
+	// the background, nil if deferred verification is disabled.
+	deferredVerifier DeferredVerifier
 	// blockBodyOffsetFn is a function that calculates the block body offset
 	// based on the slot and chain specifications.
 	blockBodyOffsetFn func(math.Slot, common.ChainSpec) uint64
@@ -52,7 +57,8 @@ type Processor[
 	metrics *processorMetrics
 }
 
-// NewProcessor creates a new blob processor.
+// NewProcessor creates a new blob processor. The deferred verifier may be nil
+// if the sidecars are not to be verified once processed.
 func NewProcessor[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
@@ -65,6 +71,7 @@ func NewProcessor[
 	logger log.Logger,
 	chainSpec common.ChainSpec,
 	verifier BlobVerifier[BlobSidecarsT],
+	deferredVerifier DeferredVerifier,
 	blockBodyOffsetFn func(math.Slot, common.ChainSpec) uint64,
 	telemetrySink TelemetrySink,
 ) *Processor[
@@ -78,6 +85,7 @@ func NewProcessor[
 		logger:            logger,
 		chainSpec:         chainSpec,
 		verifier:          verifier,
+		deferredVerifier:  deferredVerifier,
 		blockBodyOffsetFn: blockBodyOffsetFn,
 		metrics:           newProcessorMetrics(telemetrySink),
 	}
@@ -85,6 +93,7 @@ func NewProcessor[
 
 // VerifySidecars verifies the blobs and ensures they match the local state.
 func (sp *Processor[AvailabilityStoreT, _, _, _, BlobSidecarsT]) VerifySidecars(
+	ctx context.Context,
 	sidecars BlobSidecarsT,
 ) error {
 	startTime := time.Now()
@@ -99,6 +108,7 @@ func (sp *Processor[AvailabilityStoreT, _, _, _, BlobSidecarsT]) VerifySidecars(
 
 	// Verify the blobs and ensure they match the local state.
 	return sp.verifier.VerifySidecars(
+		ctx,
 		sidecars,
 		sp.blockBodyOffsetFn(
 			sidecars.Get(0).GetBeaconBlockHeader().GetSlot(),
@@ -126,8 +136,26 @@ func (sp *Processor[
 
 	// If we have reached this point, we can safely assume that the blobs are
 	// valid and can be persisted, as well as that index 0 is filled.
-	return avs.Persist(
-		sidecars.Get(0).GetBeaconBlockHeader().GetSlot(),
-		sidecars,
-	)
+	slot := sidecars.Get(0).GetBeaconBlockHeader().GetSlot()
+	if err := avs.Persist(slot, sidecars); err != nil {
+		return err
+	}
+
+	// The sidecars of finalized blocks are only verified when proposed, not
+	// when applied by block sync. The blocks are already committed, so an
+	// invalid blob can only be reported.
+	if sp.deferredVerifier != nil {
+		sp.deferredVerifier.Submit(
+			kzg.ArgsFromSidecars(sidecars),
+			func(err error) {
+				if err != nil {
+					sp.logger.Error(
+						"Deferred KZG verification of finalized blobs failed",
+						"slot", slot.Base10(), "error", err,
+					)
+				}
+			},
+		)
+	}
+	return nil
 }
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer records the spans of the blob verification. It resolves to a no-op
+// tracer unless a tracer provider is installed.
+//
+//nolint:gochecknoglobals // tracers are looked up once per package.
+var tracer = otel.Tracer("github.com/berachain/beacon-kit/mod/da/pkg/blob")
+
+// startSidecarsSpan starts a span for the given sidecars, annotated with the
+// slot and root of the block they belong to.
+func startSidecarsSpan[
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BlobSidecarT Sidecar[BeaconBlockHeaderT],
+	BlobSidecarsT Sidecars[BlobSidecarT],
+](
+	ctx context.Context,
+	name string,
+	sidecars BlobSidecarsT,
+) (context.Context, trace.Span) {
+	ctx, span := tracer.Start(ctx, name, trace.WithAttributes(
+		attribute.Int("num_blobs", sidecars.Len()),
+	))
+	if sidecars.Len() == 0 || !span.IsRecording() {
+		return ctx, span
+	}
+
+	header := sidecars.Get(0).GetBeaconBlockHeader()
+	span.SetAttributes(
+		//#nosec:G115 // slot will never overflow an int64.
+		attribute.Int64("slot", int64(header.GetSlot().Unwrap())),
+		attribute.String("block_root", header.HashTreeRoot().String()),
+	)
+	return ctx, span
+}
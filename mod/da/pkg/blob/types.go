@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -57,13 +58,23 @@ type BeaconBlockBody interface {
 
 type BeaconBlockHeader interface {
 	GetSlot() math.Slot
+	HashTreeRoot() common.Root
 }
 
 //nolint:revive // name conflict
 type BlobVerifier[BlobSidecarsT any] interface {
 	VerifyInclusionProofs(scs BlobSidecarsT, kzgOffset uint64) error
-	VerifyKZGProofs(scs BlobSidecarsT) error
-	VerifySidecars(sidecars BlobSidecarsT, kzgOffset uint64) error
+	VerifyKZGProofs(ctx context.Context, scs BlobSidecarsT) error
+	VerifySidecars(
+		ctx context.Context, sidecars BlobSidecarsT, kzgOffset uint64,
+	) error
+}
+
+// DeferredVerifier verifies the KZG proofs of blobs in the background.
+type DeferredVerifier interface {
+	// Submit adds the blobs to be verified. The callback is called with the
+	// result of their verification.
+	Submit(args *kzgtypes.BlobProofArgs, callback func(error))
 }
 
 type Sidecar[BeaconBlockHeaderT any] interface {
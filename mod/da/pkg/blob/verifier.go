@@ -25,7 +25,9 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/spans"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -59,11 +61,18 @@ func NewVerifier[
 
 // VerifySidecars verifies the blobs for both inclusion as well
 // as the KZG proofs.
-func (bv *Verifier[_, _, BlobSidecarsT]) VerifySidecars(
-	sidecars BlobSidecarsT, kzgOffset uint64,
-) error {
+func (bv *Verifier[
+	BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT,
+]) VerifySidecars(
+	ctx context.Context, sidecars BlobSidecarsT, kzgOffset uint64,
+) (err error) {
+	ctx, span := startSidecarsSpan[BeaconBlockHeaderT, BlobSidecarT](
+		ctx, "VerifySidecars", sidecars,
+	)
+	defer func() { spans.End(span, err) }()
+
 	var (
-		g, _      = errgroup.WithContext(context.Background())
+		g, _      = errgroup.WithContext(ctx)
 		startTime = time.Now()
 	)
 
@@ -83,7 +92,7 @@ func (bv *Verifier[_, _, BlobSidecarsT]) VerifySidecars(
 
 	// Verify the KZG proofs on the blobs concurrently.
 	g.Go(func() error {
-		return bv.VerifyKZGProofs(sidecars)
+		return bv.VerifyKZGProofs(ctx, sidecars)
 	})
 
 	g.Go(func() error {
@@ -106,9 +115,20 @@ func (bv *Verifier[_, _, BlobSidecarsT]) VerifyInclusionProofs(
 }
 
 // VerifyKZGProofs verifies the sidecars.
-func (bv *Verifier[_, _, BlobSidecarsT]) VerifyKZGProofs(
+func (bv *Verifier[
+	BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT,
+]) VerifyKZGProofs(
+	ctx context.Context,
 	scs BlobSidecarsT,
-) error {
+) (err error) {
+	_, span := startSidecarsSpan[BeaconBlockHeaderT, BlobSidecarT](
+		ctx, "VerifyKZGProofs", scs,
+	)
+	span.SetAttributes(attribute.String(
+		"implementation", bv.proofVerifier.GetImplementation(),
+	))
+	defer func() { spans.End(span, err) }()
+
 	start := time.Now()
 	defer bv.metrics.measureVerifyKZGProofsDuration(
 		start, math.U64(scs.Len()),
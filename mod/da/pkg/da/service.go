@@ -24,16 +24,23 @@ import (
 	"context"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
 // The Data Availability service is responsible for verifying and processing
-// incoming blob sidecars.
+// incoming blob sidecars. Sidecars that fail verification or are missing from
+// a proposal are recovered from the blob pool of the execution client.
 //
 
 type Service[
 	AvailabilityStoreT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
 	BlobSidecarsT BlobSidecar,
 ] struct {
 	avs AvailabilityStoreT
@@ -41,50 +48,83 @@ type Service[
 		AvailabilityStoreT,
 		BlobSidecarsT,
 	]
-	dispatcher asynctypes.EventDispatcher
-	logger     log.Logger
+	// blobFetcher fetches blobs from the blob pool of the execution client.
+	blobFetcher BlobFetcher
+	// sidecarFactory builds the sidecars of recovered blobs.
+	sidecarFactory SidecarFactory[BeaconBlockT, BlobSidecarsT]
+	dispatcher     asynctypes.EventDispatcher
+	logger         log.Logger
+	// subBlockReceived is a channel holding BeaconBlockReceived events.
+	subBlockReceived chan async.Event[BeaconBlockT]
 	// subSidecarsReceived is a channel holding SidecarsReceived events.
 	subSidecarsReceived chan async.Event[BlobSidecarsT]
+	// subFinalBlockReceived is a channel holding FinalBeaconBlockReceived
+	// events.
+	subFinalBlockReceived chan async.Event[BeaconBlockT]
 	// subFinalBlobSidecars is a channel holding FinalSidecarsReceived events.
 	subFinalBlobSidecars chan async.Event[BlobSidecarsT]
+	// proposal pairs the block and sidecars of the proposal being verified.
+	proposal pending[BeaconBlockT, BlobSidecarsT]
+	// final pairs the block and sidecars of the block being finalized.
+	final pending[BeaconBlockT, BlobSidecarsT]
+	// recovered holds the sidecars recovered for proposed blocks by block
+	// root, until the block of the height is finalized.
+	recovered map[common.Root]BlobSidecarsT
 }
 
 // NewService returns a new DA service.
 func NewService[
 	AvailabilityStoreT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
 	BlobSidecarsT BlobSidecar,
 ](
 	avs AvailabilityStoreT,
 	bp BlobProcessor[
 		AvailabilityStoreT, BlobSidecarsT,
 	],
+	blobFetcher BlobFetcher,
+	sidecarFactory SidecarFactory[BeaconBlockT, BlobSidecarsT],
 	dispatcher asynctypes.EventDispatcher,
 	logger log.Logger,
 ) *Service[
-	AvailabilityStoreT, BlobSidecarsT,
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
 ] {
 	return &Service[
-		AvailabilityStoreT, BlobSidecarsT,
+		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
 	]{
-		avs:                  avs,
-		bp:                   bp,
-		dispatcher:           dispatcher,
-		logger:               logger,
-		subSidecarsReceived:  make(chan async.Event[BlobSidecarsT]),
-		subFinalBlobSidecars: make(chan async.Event[BlobSidecarsT]),
+		avs:                   avs,
+		bp:                    bp,
+		blobFetcher:           blobFetcher,
+		sidecarFactory:        sidecarFactory,
+		dispatcher:            dispatcher,
+		logger:                logger,
+		subBlockReceived:      make(chan async.Event[BeaconBlockT]),
+		subSidecarsReceived:   make(chan async.Event[BlobSidecarsT]),
+		subFinalBlockReceived: make(chan async.Event[BeaconBlockT]),
+		subFinalBlobSidecars:  make(chan async.Event[BlobSidecarsT]),
+		recovered:             make(map[common.Root]BlobSidecarsT),
 	}
 }
 
 // Name returns the name of the service.
-func (s *Service[_, _]) Name() string {
+func (s *Service[_, _, _, _]) Name() string {
 	return "da"
 }
 
-// Start subscribes the DA service to SidecarsReceived and FinalSidecarsReceived
-// events and begins the main event loop to handle them accordingly.
-func (s *Service[_, _]) Start(ctx context.Context) error {
+// Start subscribes the DA service to the block and sidecars events of
+// proposals and finalized blocks and begins the main event loop to handle
+// them accordingly.
+func (s *Service[_, _, _, _]) Start(ctx context.Context) error {
 	var err error
 
+	// subscribe to BeaconBlockReceived events
+	if err = s.dispatcher.Subscribe(
+		async.BeaconBlockReceived, s.subBlockReceived,
+	); err != nil {
+		return err
+	}
+
 	// subscribe to SidecarsReceived events
 	if err = s.dispatcher.Subscribe(
 		async.SidecarsReceived, s.subSidecarsReceived,
@@ -92,6 +132,13 @@ func (s *Service[_, _]) Start(ctx context.Context) error {
 		return err
 	}
 
+	// subscribe to FinalBeaconBlockReceived events
+	if err = s.dispatcher.Subscribe(
+		async.FinalBeaconBlockReceived, s.subFinalBlockReceived,
+	); err != nil {
+		return err
+	}
+
 	// subscribe to FinalSidecarsReceived events
 	if err = s.dispatcher.Subscribe(
 		async.FinalSidecarsReceived, s.subFinalBlobSidecars,
@@ -104,32 +151,101 @@ func (s *Service[_, _]) Start(ctx context.Context) error {
 	return nil
 }
 
-// eventLoop listens and handles SidecarsReceived and FinalSidecarsReceived
-// events.
-func (s *Service[_, _]) eventLoop(ctx context.Context) {
+// eventLoop listens to the block and sidecars events and handles the
+// sidecars once the block they belong to has been received as well.
+func (s *Service[_, _, _, _]) eventLoop(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subSidecarsReceived:
-			s.handleSidecarsReceived(event)
-		case event := <-s.subFinalBlobSidecars:
-			s.handleFinalSidecarsReceived(event)
+		case event, ok := <-s.subBlockReceived:
+			if !ok {
+				return
+			}
+			if s.proposal.setBlock(event) {
+				s.handleSidecarsReceived(s.proposal.take())
+			}
+		case event, ok := <-s.subSidecarsReceived:
+			if !ok {
+				return
+			}
+			if s.proposal.setSidecars(event) {
+				s.handleSidecarsReceived(s.proposal.take())
+			}
+		case event, ok := <-s.subFinalBlockReceived:
+			if !ok {
+				return
+			}
+			if s.final.setBlock(event) {
+				s.handleFinalSidecarsReceived(s.final.take())
+			}
+		case event, ok := <-s.subFinalBlobSidecars:
+			if !ok {
+				return
+			}
+			if s.final.setSidecars(event) {
+				s.handleFinalSidecarsReceived(s.final.take())
+			}
 		}
 	}
 }
 
+// pending pairs the block and sidecars events of a block, which are published
+// separately and may be received in any order.
+type pending[BeaconBlockT, BlobSidecarsT any] struct {
+	blk      async.Event[BeaconBlockT]
+	sidecars async.Event[BlobSidecarsT]
+}
+
+// setBlock sets the block event, replacing a stale one, and returns true if
+// the sidecars event has been received as well.
+func (p *pending[BeaconBlockT, _]) setBlock(
+	event async.Event[BeaconBlockT],
+) bool {
+	p.blk = event
+	return p.sidecars != nil
+}
+
+// setSidecars sets the sidecars event and returns true if the block event has
+// been received as well.
+func (p *pending[_, BlobSidecarsT]) setSidecars(
+	event async.Event[BlobSidecarsT],
+) bool {
+	p.sidecars = event
+	return p.blk != nil
+}
+
+// take returns the paired events and resets the pending pair.
+func (p *pending[BeaconBlockT, BlobSidecarsT]) take() (
+	async.Event[BeaconBlockT], async.Event[BlobSidecarsT],
+) {
+	blk, sidecars := p.blk, p.sidecars
+	p.blk, p.sidecars = nil, nil
+	return blk, sidecars
+}
+
 /* -------------------------------------------------------------------------- */
 /*                               Event Handlers                             */
 /* -------------------------------------------------------------------------- */
 
-// handleFinalSidecarsReceived handles the BlobSidecarsProcessRequest
-// event.
-// It processes the sidecars and publishes a BlobSidecarsProcessed event.
-func (s *Service[_, BlobSidecarsT]) handleFinalSidecarsReceived(
+// handleFinalSidecarsReceived handles the FinalSidecarsReceived event along
+// with the finalized block. It processes the sidecars, or those recovered
+// for the block when it was proposed.
+func (s *Service[
+	_, BeaconBlockT, _, BlobSidecarsT,
+]) handleFinalSidecarsReceived(
+	blkMsg async.Event[BeaconBlockT],
 	msg async.Event[BlobSidecarsT],
 ) {
-	if err := s.processSidecars(msg.Context(), msg.Data()); err != nil {
+	sidecars := msg.Data()
+	if recovered, ok := s.recovered[blkMsg.Data().HashTreeRoot()]; ok {
+		sidecars = recovered
+	}
+	// The recovered sidecars of the proposals that were not finalized are
+	// no longer needed.
+	clear(s.recovered)
+
+	if err := s.processSidecars(msg.Context(), sidecars); err != nil {
 		s.logger.Error(
 			"Failed to process blob sidecars",
 			"error",
@@ -138,14 +254,20 @@ func (s *Service[_, BlobSidecarsT]) handleFinalSidecarsReceived(
 	}
 }
 
-// handleSidecarsReceived handles the SidecarsVerifyRequest event.
-// It verifies the sidecars and publishes a SidecarsVerified event.
-func (s *Service[_, BlobSidecarsT]) handleSidecarsReceived(
+// handleSidecarsReceived handles the SidecarsReceived event along with the
+// proposed block. It verifies the sidecars, recovering them from the
+// execution client if needed, and publishes a SidecarsVerified event.
+func (s *Service[
+	_, BeaconBlockT, _, BlobSidecarsT,
+]) handleSidecarsReceived(
+	blkMsg async.Event[BeaconBlockT],
 	msg async.Event[BlobSidecarsT],
 ) {
-	var sidecarsErr error
 	// verify the sidecars.
-	if sidecarsErr = s.verifySidecars(msg.Data()); sidecarsErr != nil {
+	sidecars, sidecarsErr := s.verifyProposalSidecars(
+		msg.Context(), blkMsg.Data(), msg.Data(),
+	)
+	if sidecarsErr != nil {
 		s.logger.Error(
 			"Failed to receive blob sidecars",
 			"error",
@@ -156,7 +278,7 @@ func (s *Service[_, BlobSidecarsT]) handleSidecarsReceived(
 	// emit the sidecars verification event with error from verifySidecars
 	if err := s.dispatcher.Publish(
 		async.NewEvent(
-			msg.Context(), async.SidecarsVerified, msg.Data(), sidecarsErr,
+			msg.Context(), async.SidecarsVerified, sidecars, sidecarsErr,
 		),
 	); err != nil {
 		s.logger.Error("failed to publish event", "err", err)
@@ -168,7 +290,7 @@ func (s *Service[_, BlobSidecarsT]) handleSidecarsReceived(
 /* -------------------------------------------------------------------------- */
 
 // ProcessSidecars processes the blob sidecars.
-func (s *Service[_, BlobSidecarsT]) processSidecars(
+func (s *Service[_, _, _, BlobSidecarsT]) processSidecars(
 	_ context.Context,
 	sidecars BlobSidecarsT,
 ) error {
@@ -181,7 +303,8 @@ func (s *Service[_, BlobSidecarsT]) processSidecars(
 }
 
 // VerifyIncomingBlobs receives blobs from the network and processes them.
-func (s *Service[_, BlobSidecarsT]) verifySidecars(
+func (s *Service[_, _, _, BlobSidecarsT]) verifySidecars(
+	ctx context.Context,
 	sidecars BlobSidecarsT,
 ) error {
 	// If there are no blobs to verify, return early.
@@ -194,7 +317,7 @@ func (s *Service[_, BlobSidecarsT]) verifySidecars(
 	)
 
 	// Verify the blobs and ensure they match the local state.
-	if err := s.bp.VerifySidecars(sidecars); err != nil {
+	if err := s.bp.VerifySidecars(ctx, sidecars); err != nil {
 		s.logger.Error(
 			"rejecting incoming blob sidecars",
 			"reason", err,
@@ -210,3 +333,96 @@ func (s *Service[_, BlobSidecarsT]) verifySidecars(
 
 	return nil
 }
+
+// verifyProposalSidecars verifies the sidecars of the proposed block. If they
+// fail verification or are missing some of the blobs of the block, they are
+// recovered from the execution client instead. The recovered sidecars are
+// kept until the block is finalized.
+func (s *Service[
+	_, BeaconBlockT, _, BlobSidecarsT,
+]) verifyProposalSidecars(
+	ctx context.Context,
+	blk BeaconBlockT,
+	sidecars BlobSidecarsT,
+) (BlobSidecarsT, error) {
+	var (
+		numBlobs    = len(blk.GetBody().GetBlobKzgCommitments())
+		numSidecars int
+		err         = s.verifySidecars(ctx, sidecars)
+	)
+	if !sidecars.IsNil() {
+		numSidecars = sidecars.Len()
+	}
+	if numBlobs == 0 || (err == nil && numSidecars == numBlobs) {
+		return sidecars, err
+	}
+
+	s.logger.Warn(
+		"Recovering blob sidecars from the execution client",
+		"slot", blk.GetSlot().Base10(),
+		"num_blobs", numBlobs,
+		"num_sidecars", numSidecars,
+		"reason", err,
+	)
+	recovered, recoverErr := s.recoverSidecars(ctx, blk)
+	if recoverErr != nil {
+		s.logger.Error(
+			"Failed to recover blob sidecars",
+			"error", recoverErr,
+		)
+		return sidecars, err
+	}
+
+	s.recovered[blk.HashTreeRoot()] = recovered
+	s.logger.Info(
+		"Recovered blob sidecars from the execution client",
+		"num_blobs", numBlobs,
+	)
+	return recovered, nil
+}
+
+// recoverSidecars rebuilds the sidecars of the block from the blobs in the
+// blob pool of the execution client. The sidecars are verified, including
+// the KZG proofs of the blobs against the commitments of the block, so that
+// they can be persisted.
+func (s *Service[
+	_, BeaconBlockT, _, BlobSidecarsT,
+]) recoverSidecars(
+	ctx context.Context,
+	blk BeaconBlockT,
+) (BlobSidecarsT, error) {
+	var (
+		sidecars        BlobSidecarsT
+		commitments     = blk.GetBody().GetBlobKzgCommitments()
+		versionedHashes = commitments.ToVersionedHashes()
+	)
+	blobs, err := s.blobFetcher.GetBlobs(ctx, versionedHashes)
+	if err != nil {
+		return sidecars, err
+	}
+
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{
+		Commitments: commitments,
+		Proofs:      make([]eip4844.KZGProof, len(blobs)),
+		Blobs:       make([]*eip4844.Blob, len(blobs)),
+	}
+	for i, blob := range blobs {
+		if blob == nil || blob.Blob == nil {
+			return sidecars, errors.Wrapf(
+				ErrBlobUnavailable, "versioned hash %s", versionedHashes[i],
+			)
+		}
+		bundle.Proofs[i], bundle.Blobs[i] = blob.Proof, blob.Blob
+	}
+
+	// The inclusion proofs of the blobs in the block are rebuilt by the
+	// sidecar factory.
+	if sidecars, err = s.sidecarFactory.BuildSidecars(
+		blk, bundle,
+	); err != nil {
+		return sidecars, err
+	}
+	return sidecars, s.bp.VerifySidecars(ctx, sidecars)
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errInvalidSidecars = errors.New("invalid sidecars")
+
+type testCommitments = eip4844.KZGCommitments[common.ExecutionHash]
+
+type testBody struct {
+	commitments testCommitments
+}
+
+func (b *testBody) GetBlobKzgCommitments() testCommitments {
+	return b.commitments
+}
+
+type testBlock struct {
+	slot math.Slot
+	body *testBody
+}
+
+func (b *testBlock) GetSlot() math.Slot {
+	return b.slot
+}
+
+func (b *testBlock) GetBody() *testBody {
+	return b.body
+}
+
+func (b *testBlock) HashTreeRoot() common.Root {
+	return common.Root{byte(b.slot)}
+}
+
+type testSidecars struct {
+	num       int
+	invalid   bool
+	recovered bool
+}
+
+func (s *testSidecars) Len() int {
+	return s.num
+}
+
+func (s *testSidecars) IsNil() bool {
+	return s == nil
+}
+
+type testProcessor struct{}
+
+func (testProcessor) ProcessSidecars(struct{}, *testSidecars) error {
+	return nil
+}
+
+func (testProcessor) VerifySidecars(
+	_ context.Context, sidecars *testSidecars,
+) error {
+	if sidecars.invalid {
+		return errInvalidSidecars
+	}
+	return nil
+}
+
+type testBlobFetcher struct {
+	blobs []*engineprimitives.BlobAndProofV1
+	calls int
+}
+
+func (f *testBlobFetcher) GetBlobs(
+	context.Context, []common.ExecutionHash,
+) ([]*engineprimitives.BlobAndProofV1, error) {
+	f.calls++
+	return f.blobs, nil
+}
+
+type testSidecarFactory struct{}
+
+func (testSidecarFactory) BuildSidecars(
+	_ *testBlock, bundle engineprimitives.BlobsBundle,
+) (*testSidecars, error) {
+	return &testSidecars{num: len(bundle.GetBlobs()), recovered: true}, nil
+}
+
+func newTestService(
+	fetcher *testBlobFetcher,
+) *Service[struct{}, *testBlock, *testBody, *testSidecars] {
+	return NewService[struct{}, *testBlock, *testBody, *testSidecars](
+		struct{}{}, testProcessor{}, fetcher, testSidecarFactory{}, nil,
+		noop.NewLogger[any](),
+	)
+}
+
+func TestService_VerifyProposalSidecars(t *testing.T) {
+	blk := &testBlock{
+		slot: 1,
+		body: &testBody{
+			commitments: testCommitments{{0x01}, {0x02}},
+		},
+	}
+	pool := []*engineprimitives.BlobAndProofV1{
+		{Blob: &eip4844.Blob{0x01}}, {Blob: &eip4844.Blob{0x02}},
+	}
+
+	t.Run("complete sidecars are not recovered", func(t *testing.T) {
+		fetcher := &testBlobFetcher{blobs: pool}
+		s := newTestService(fetcher)
+		sidecars := &testSidecars{num: 2}
+
+		verified, err := s.verifyProposalSidecars(
+			context.Background(), blk, sidecars,
+		)
+		require.NoError(t, err)
+		require.Same(t, sidecars, verified)
+		require.Zero(t, fetcher.calls)
+		require.Empty(t, s.recovered)
+	})
+
+	t.Run("incomplete sidecars are recovered", func(t *testing.T) {
+		s := newTestService(&testBlobFetcher{blobs: pool})
+
+		verified, err := s.verifyProposalSidecars(
+			context.Background(), blk, &testSidecars{num: 1},
+		)
+		require.NoError(t, err)
+		require.True(t, verified.recovered)
+		require.Equal(t, 2, verified.Len())
+		require.Same(t, verified, s.recovered[blk.HashTreeRoot()])
+	})
+
+	t.Run("invalid sidecars are recovered", func(t *testing.T) {
+		s := newTestService(&testBlobFetcher{blobs: pool})
+
+		verified, err := s.verifyProposalSidecars(
+			context.Background(), blk, &testSidecars{num: 2, invalid: true},
+		)
+		require.NoError(t, err)
+		require.True(t, verified.recovered)
+	})
+
+	t.Run("blobs missing from the pool", func(t *testing.T) {
+		s := newTestService(&testBlobFetcher{
+			blobs: []*engineprimitives.BlobAndProofV1{pool[0], nil},
+		})
+
+		_, err := s.verifyProposalSidecars(
+			context.Background(), blk, &testSidecars{num: 2, invalid: true},
+		)
+		require.ErrorIs(t, err, errInvalidSidecars)
+		require.Empty(t, s.recovered)
+
+		_, err = s.recoverSidecars(context.Background(), blk)
+		require.ErrorIs(t, err, ErrBlobUnavailable)
+	})
+}
@@ -20,6 +20,15 @@
 
 package da
 
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
 // BlobProcessor is the interface for the blobs processor.
 type BlobProcessor[AvailabilityStoreT any, BlobSidecarsT any] interface {
 	// ProcessSidecars processes the blobs and ensures they match the local
@@ -30,6 +39,7 @@ type BlobProcessor[AvailabilityStoreT any, BlobSidecarsT any] interface {
 	) error
 	// VerifySidecars verifies the blobs and ensures they match the local state.
 	VerifySidecars(
+		ctx context.Context,
 		sidecars BlobSidecarsT,
 	) error
 }
@@ -41,3 +51,39 @@ type BlobSidecar interface {
 	// IsNil checks if the sidecar is nil.
 	IsNil() bool
 }
+
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[BeaconBlockBodyT BeaconBlockBody] interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+	// HashTreeRoot returns the root of the block.
+	HashTreeRoot() common.Root
+}
+
+// BeaconBlockBody is the interface for the body of a beacon block.
+type BeaconBlockBody interface {
+	// GetBlobKzgCommitments returns the KZG commitments of the blobs of the
+	// block.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+}
+
+// BlobFetcher is the interface for fetching blobs from the blob pool of the
+// execution client.
+type BlobFetcher interface {
+	// GetBlobs returns the blobs with the given versioned hashes along with
+	// their KZG proofs. The entry of a blob missing from the pool is nil.
+	GetBlobs(
+		ctx context.Context, versionedHashes []common.ExecutionHash,
+	) ([]*engineprimitives.BlobAndProofV1, error)
+}
+
+// SidecarFactory is the interface for building the blob sidecars of a block.
+type SidecarFactory[BeaconBlockT, BlobSidecarsT any] interface {
+	// BuildSidecars builds the sidecars of the given block from the blobs
+	// bundle.
+	BuildSidecars(
+		blk BeaconBlockT, bundle engineprimitives.BlobsBundle,
+	) (BlobSidecarsT, error)
+}
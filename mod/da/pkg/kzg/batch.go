@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg
+
+import (
+	"sync"
+	"time"
+
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+)
+
+// BatchVerifier defers the verification of blobs, so that the blobs of
+// several blocks are verified together in a single VerifyBlobProofBatch call.
+//
+// Submitting blobs does not wait for their verification. The blobs submitted
+// within a window, or until the batch holds maxBlobs blobs, are verified
+// together in the background, and the result for each submission is handed
+// to its callback. If a batch does not verify, each submission is verified on
+// its own so that only the ones holding an invalid blob fail.
+type BatchVerifier struct {
+	// verifier verifies the batches.
+	verifier BlobProofVerifier
+	// window is how long the first submission of a batch waits for others.
+	window time.Duration
+	// maxBlobs is the number of blobs after which a batch is verified
+	// without waiting for the window to elapse.
+	maxBlobs int
+
+	// mu protects pending.
+	mu sync.Mutex
+	// pending is the batch accepting submissions, if any.
+	pending *batch
+}
+
+// batch is a set of submissions verified together.
+type batch struct {
+	// args holds the blobs of all the submissions.
+	args kzgtypes.BlobProofArgs
+	// bounds holds the [start, end) range of each submission in args.
+	bounds [][2]int
+	// callbacks holds the callback of each submission.
+	callbacks []func(error)
+	// timer verifies the batch once the window elapses.
+	timer *time.Timer
+}
+
+// NewBatchVerifier creates a new verifier batching the blobs submitted within
+// window, up to maxBlobs blobs.
+func NewBatchVerifier(
+	verifier BlobProofVerifier,
+	window time.Duration,
+	maxBlobs int,
+) *BatchVerifier {
+	return &BatchVerifier{
+		verifier: verifier,
+		window:   window,
+		maxBlobs: maxBlobs,
+	}
+}
+
+// Submit adds the blobs to the pending batch. The callback is called with
+// the result of their verification once the batch has been verified.
+func (v *BatchVerifier) Submit(
+	args *kzgtypes.BlobProofArgs,
+	callback func(error),
+) {
+	if len(args.Blobs) == 0 {
+		callback(nil)
+		return
+	}
+
+	v.mu.Lock()
+	b := v.pending
+	if b == nil {
+		b = new(batch)
+		b.timer = time.AfterFunc(v.window, func() { v.flush(b) })
+		v.pending = b
+	}
+	start := len(b.args.Blobs)
+	b.args.Blobs = append(b.args.Blobs, args.Blobs...)
+	b.args.Proofs = append(b.args.Proofs, args.Proofs...)
+	b.args.Commitments = append(b.args.Commitments, args.Commitments...)
+	b.bounds = append(b.bounds, [2]int{start, len(b.args.Blobs)})
+	b.callbacks = append(b.callbacks, callback)
+	full := len(b.args.Blobs) >= v.maxBlobs
+	v.mu.Unlock()
+
+	if full {
+		go v.flush(b)
+	}
+}
+
+// flush verifies the batch, unless it has been taken by an earlier flush.
+func (v *BatchVerifier) flush(b *batch) {
+	v.mu.Lock()
+	if v.pending != b {
+		v.mu.Unlock()
+		return
+	}
+	v.pending = nil
+	b.timer.Stop()
+	v.mu.Unlock()
+
+	v.verify(b)
+}
+
+// verify verifies all the blobs of the batch at once. If the batch does not
+// verify, each submission is verified on its own.
+func (v *BatchVerifier) verify(b *batch) {
+	err := v.verifyArgs(&b.args)
+	if err == nil || len(b.bounds) == 1 {
+		for _, callback := range b.callbacks {
+			callback(err)
+		}
+		return
+	}
+
+	for i, bounds := range b.bounds {
+		b.callbacks[i](v.verifyArgs(&kzgtypes.BlobProofArgs{
+			Blobs:       b.args.Blobs[bounds[0]:bounds[1]],
+			Proofs:      b.args.Proofs[bounds[0]:bounds[1]],
+			Commitments: b.args.Commitments[bounds[0]:bounds[1]],
+		}))
+	}
+}
+
+// verifyArgs verifies the blobs with the underlying verifier.
+func (v *BatchVerifier) verifyArgs(args *kzgtypes.BlobProofArgs) error {
+	if len(args.Blobs) == 1 {
+		// This method is fastest for a single blob.
+		return v.verifier.VerifyBlobProof(
+			args.Blobs[0], args.Proofs[0], args.Commitments[0],
+		)
+	}
+	return v.verifier.VerifyBlobProofBatch(args)
+}
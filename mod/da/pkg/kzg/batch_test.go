@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// submitAll submits the args to the verifier, one after the other as the
+// blocks they belong to are finalized, and returns the result of each
+// submission once all of them have been verified.
+func submitAll(
+	verifier *kzg.BatchVerifier, args ...*kzgtypes.BlobProofArgs,
+) []error {
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(args))
+	)
+	wg.Add(len(args))
+	for i, arg := range args {
+		verifier.Submit(arg, func(err error) {
+			errs[i] = err
+			wg.Done()
+		})
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestBatchVerifier_BatchesAcrossSubmissions(t *testing.T) {
+	fake := &countingVerifier{}
+	verifier := kzg.NewBatchVerifier(fake, time.Minute, 6)
+
+	// Submitting does not wait for the verification, so the blobs of
+	// consecutive blocks end up in the same batch.
+	errs := submitAll(verifier, newArgs(1, 2), newArgs(2, 2), newArgs(3, 2))
+	require.Equal(t, []error{nil, nil, nil}, errs)
+
+	// The batch is full before the window elapses.
+	require.Equal(t, []int{6}, fake.batches)
+	require.Zero(t, fake.single)
+}
+
+func TestBatchVerifier_VerifiesAfterWindow(t *testing.T) {
+	fake := &countingVerifier{}
+	verifier := kzg.NewBatchVerifier(fake, 10*time.Millisecond, 64)
+
+	require.Equal(t, []error{nil}, submitAll(verifier, newArgs(1, 1)))
+	require.Equal(t, 1, fake.single)
+	require.Empty(t, fake.batches)
+}
+
+func TestBatchVerifier_AttributesFailures(t *testing.T) {
+	fake := &countingVerifier{}
+	verifier := kzg.NewBatchVerifier(fake, time.Minute, 4)
+
+	valid, invalid := newArgs(1, 2), newArgs(2, 2)
+	invalid.Blobs[1][0] = 1
+
+	errs := submitAll(verifier, valid, invalid)
+	require.NoError(t, errs[0])
+	require.ErrorIs(t, errs[1], errInvalidProof)
+	require.Equal(t, []int{4, 2, 2}, fake.batches)
+}
+
+func TestBatchVerifier_SkipsEmptySubmissions(t *testing.T) {
+	fake := &countingVerifier{}
+	verifier := kzg.NewBatchVerifier(fake, time.Minute, 4)
+
+	require.Equal(t,
+		[]error{nil}, submitAll(verifier, &kzgtypes.BlobProofArgs{}),
+	)
+	require.Zero(t, fake.single)
+	require.Empty(t, fake.batches)
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg
+
+import (
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// CachedVerifier is a BlobProofVerifier that remembers the blobs it has
+// verified, so that the sidecars verified in ProcessProposal are not verified
+// again in FinalizeBlock.
+//
+// Blobs are keyed by the hash of their commitment, proof and blob. The blob
+// is part of the key as a valid commitment and proof must not make another
+// blob pass verification.
+type CachedVerifier struct {
+	BlobProofVerifier
+	// verified holds the keys of the verified blobs.
+	verified *lru.Cache[[32]byte, struct{}]
+}
+
+// NewCachedVerifier creates a new verifier remembering up to size verified
+// blobs.
+func NewCachedVerifier(
+	verifier BlobProofVerifier,
+	size int,
+) (*CachedVerifier, error) {
+	verified, err := lru.New[[32]byte, struct{}](size)
+	if err != nil {
+		return nil, err
+	}
+	return &CachedVerifier{
+		BlobProofVerifier: verifier,
+		verified:          verified,
+	}, nil
+}
+
+// VerifyBlobProof verifies the blob, unless it has been verified before.
+func (v *CachedVerifier) VerifyBlobProof(
+	blob *eip4844.Blob,
+	proof eip4844.KZGProof,
+	commitment eip4844.KZGCommitment,
+) error {
+	key := cacheKey(blob, proof, commitment)
+	if v.verified.Contains(key) {
+		return nil
+	}
+	if err := v.BlobProofVerifier.VerifyBlobProof(
+		blob, proof, commitment,
+	); err != nil {
+		return err
+	}
+	v.verified.Add(key, struct{}{})
+	return nil
+}
+
+// VerifyBlobProofBatch verifies the blobs that have not been verified before
+// in a single batch.
+func (v *CachedVerifier) VerifyBlobProofBatch(
+	args *kzgtypes.BlobProofArgs,
+) error {
+	var (
+		keys       = make([][32]byte, 0, len(args.Blobs))
+		unverified = &kzgtypes.BlobProofArgs{}
+	)
+	for i, blob := range args.Blobs {
+		key := cacheKey(blob, args.Proofs[i], args.Commitments[i])
+		if v.verified.Contains(key) {
+			continue
+		}
+		keys = append(keys, key)
+		unverified.Blobs = append(unverified.Blobs, blob)
+		unverified.Proofs = append(unverified.Proofs, args.Proofs[i])
+		unverified.Commitments = append(
+			unverified.Commitments, args.Commitments[i],
+		)
+	}
+
+	var err error
+	switch len(keys) {
+	case 0:
+		return nil
+	case 1:
+		err = v.BlobProofVerifier.VerifyBlobProof(
+			unverified.Blobs[0],
+			unverified.Proofs[0],
+			unverified.Commitments[0],
+		)
+	default:
+		err = v.BlobProofVerifier.VerifyBlobProofBatch(unverified)
+	}
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		v.verified.Add(key, struct{}{})
+	}
+	return nil
+}
+
+// cacheKey returns the key of a blob along with its proof and commitment.
+func cacheKey(
+	blob *eip4844.Blob,
+	proof eip4844.KZGProof,
+	commitment eip4844.KZGCommitment,
+) [32]byte {
+	preimage := make([]byte, 0, len(commitment)+len(proof)+len(blob))
+	preimage = append(preimage, commitment[:]...)
+	preimage = append(preimage, proof[:]...)
+	preimage = append(preimage, blob[:]...)
+	return sha256.Hash(preimage)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/stretchr/testify/require"
+)
+
+var errInvalidProof = errors.New("invalid proof")
+
+// countingVerifier is a fake verifier counting the blobs it verifies. A blob
+// is invalid if its first byte is set.
+type countingVerifier struct {
+	mu      sync.Mutex
+	single  int
+	batches []int
+}
+
+func (v *countingVerifier) GetImplementation() string {
+	return "counting"
+}
+
+func (v *countingVerifier) VerifyBlobProof(
+	blob *eip4844.Blob, _ eip4844.KZGProof, _ eip4844.KZGCommitment,
+) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.single++
+	if blob[0] != 0 {
+		return errInvalidProof
+	}
+	return nil
+}
+
+func (v *countingVerifier) VerifyBlobProofBatch(
+	args *kzgtypes.BlobProofArgs,
+) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.batches = append(v.batches, len(args.Blobs))
+	for _, blob := range args.Blobs {
+		if blob[0] != 0 {
+			return errInvalidProof
+		}
+	}
+	return nil
+}
+
+// newArgs returns the args of n blobs, the i-th blob having the given tag.
+func newArgs(tag byte, n int) *kzgtypes.BlobProofArgs {
+	args := &kzgtypes.BlobProofArgs{}
+	for i := range n {
+		blob := &eip4844.Blob{}
+		blob[1] = tag
+		args.Blobs = append(args.Blobs, blob)
+		args.Proofs = append(args.Proofs, eip4844.KZGProof{tag, byte(i)})
+		args.Commitments = append(
+			args.Commitments, eip4844.KZGCommitment{tag, byte(i)},
+		)
+	}
+	return args
+}
+
+func TestCachedVerifier_SkipsVerifiedBlobs(t *testing.T) {
+	fake := &countingVerifier{}
+	verifier, err := kzg.NewCachedVerifier(fake, 16)
+	require.NoError(t, err)
+
+	args := newArgs(1, 3)
+	require.NoError(t, verifier.VerifyBlobProofBatch(args))
+	require.NoError(t, verifier.VerifyBlobProofBatch(args))
+	require.Equal(t, []int{3}, fake.batches)
+
+	// Only the blob that has not been verified yet is verified.
+	args.Blobs = append(args.Blobs, newArgs(2, 1).Blobs...)
+	args.Proofs = append(args.Proofs, newArgs(2, 1).Proofs...)
+	args.Commitments = append(args.Commitments, newArgs(2, 1).Commitments...)
+	require.NoError(t, verifier.VerifyBlobProofBatch(args))
+	require.Equal(t, []int{3}, fake.batches)
+	require.Equal(t, 1, fake.single)
+
+	require.NoError(t, verifier.VerifyBlobProof(
+		args.Blobs[0], args.Proofs[0], args.Commitments[0],
+	))
+	require.Equal(t, 1, fake.single)
+}
+
+func TestCachedVerifier_DoesNotCacheFailures(t *testing.T) {
+	fake := &countingVerifier{}
+	verifier, err := kzg.NewCachedVerifier(fake, 16)
+	require.NoError(t, err)
+
+	args := newArgs(1, 1)
+	args.Blobs[0][0] = 1
+	for range 2 {
+		require.ErrorIs(t, verifier.VerifyBlobProof(
+			args.Blobs[0], args.Proofs[0], args.Commitments[0],
+		), errInvalidProof)
+	}
+	require.Equal(t, 2, fake.single)
+}
+
+func TestCachedVerifier_KeysOnBlob(t *testing.T) {
+	fake := &countingVerifier{}
+	verifier, err := kzg.NewCachedVerifier(fake, 16)
+	require.NoError(t, err)
+
+	args := newArgs(1, 1)
+	require.NoError(t, verifier.VerifyBlobProof(
+		args.Blobs[0], args.Proofs[0], args.Commitments[0],
+	))
+
+	// A verified commitment and proof must not vouch for another blob.
+	invalid := &eip4844.Blob{1}
+	require.ErrorIs(t, verifier.VerifyBlobProof(
+		invalid, args.Proofs[0], args.Commitments[0],
+	), errInvalidProof)
+}
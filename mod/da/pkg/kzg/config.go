@@ -20,12 +20,22 @@
 
 package kzg
 
+import "time"
+
 const (
 	// defaultTrustedSetupPath is the default path to the trusted setup.
 	defaultTrustedSetupPath = "./testing/files/kzg-trusted-setup.json"
 	// defaultImplementation is the default KZG implementation to use.
 	// Options are `crate-crypto/go-kzg-4844` or `ethereum/c-kzg-4844`.
 	defaultImplementation = "crate-crypto/go-kzg-4844"
+	// defaultCacheSize is the default number of verified blobs to remember.
+	defaultCacheSize = 4096
+	// defaultBatchWindow is the default window to batch the verification of
+	// finalized blobs over. Deferred verification is disabled by default.
+	defaultBatchWindow = 0
+	// defaultBatchMaxBlobs is the default number of blobs after which a
+	// batch is verified without waiting for the window to elapse.
+	defaultBatchMaxBlobs = 64
 )
 
 type Config struct {
@@ -33,6 +43,16 @@ type Config struct {
 	TrustedSetupPath string `mapstructure:"trusted-setup-path"`
 	// Implementation is the KZG implementation to use.
 	Implementation string `mapstructure:"implementation"`
+	// CacheSize is the number of verified blobs to remember, so that they
+	// are not verified again. A size of 0 disables the cache.
+	CacheSize int `mapstructure:"cache-size"`
+	// BatchWindow is how long the verification of the blobs of finalized
+	// blocks is deferred, to verify the blobs of several blocks together.
+	// A window of 0 disables the deferred verification.
+	BatchWindow time.Duration `mapstructure:"batch-window"`
+	// BatchMaxBlobs is the number of blobs after which a batch is verified
+	// without waiting for the window to elapse.
+	BatchMaxBlobs int `mapstructure:"batch-max-blobs"`
 }
 
 // DefaultConfig returns the default configuration.
@@ -40,5 +60,8 @@ func DefaultConfig() Config {
 	return Config{
 		TrustedSetupPath: defaultTrustedSetupPath,
 		Implementation:   defaultImplementation,
+		CacheSize:        defaultCacheSize,
+		BatchWindow:      defaultBatchWindow,
+		BatchMaxBlobs:    defaultBatchMaxBlobs,
 	}
 }
@@ -39,4 +39,7 @@ func TestDefaultConfig(t *testing.T) {
 		"crate-crypto/go-kzg-4844",
 		cfg.Implementation,
 	)
+	require.Equal(t, 4096, cfg.CacheSize)
+	require.Zero(t, cfg.BatchWindow)
+	require.Equal(t, 64, cfg.BatchMaxBlobs)
 }
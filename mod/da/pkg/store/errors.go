@@ -34,4 +34,12 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrInvalidCommitments is returned when the commitments stored for a
+	// slot are not a whole number of KZG commitments.
+	ErrInvalidCommitments = errors.New("invalid stored commitments")
+
+	// ErrSidecarsNotFound is returned when no sidecars are stored for a
+	// slot.
+	ErrSidecarsNotFound = errors.New("blob sidecars not found")
 )
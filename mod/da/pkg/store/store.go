@@ -27,10 +27,15 @@ import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/sourcegraph/conc/iter"
 )
 
+// commitmentsKey is the key of the KZG commitments of the sidecars stored for
+// a slot, which allows the sidecars to be looked up by slot alone.
+var commitmentsKey = []byte("commitments")
+
 // Store is the default implementation of the AvailabilityStore.
 type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	// IndexDB is a basic database interface.
@@ -61,14 +66,21 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	slot math.Slot,
 	body BeaconBlockBodyT,
 ) bool {
-	for _, commitment := range body.GetBlobKzgCommitments() {
+	commitments := body.GetBlobKzgCommitments()
+	for _, commitment := range commitments {
 		// Check if the block data is available in the IndexDB
 		blockData, err := s.IndexDB.Has(slot.Unwrap(), commitment[:])
 		if err != nil || !blockData {
 			return false
 		}
 	}
-	return true
+	if len(commitments) == 0 {
+		return true
+	}
+	// The sidecars are only looked up by slot once their commitments are
+	// stored.
+	stored, err := s.IndexDB.Has(slot.Unwrap(), commitmentsKey)
+	return err == nil && stored
 }
 
 // Persist ensures the sidecar data remains accessible, utilizing parallel
@@ -112,8 +124,55 @@ func (s *Store[BeaconBlockT]) Persist(
 		return err
 	}
 
+	// The commitments are stored last, so that the sidecars of a slot are
+	// only looked up once all of them are stored.
+	size := len(eip4844.KZGCommitment{})
+	commitments := make([]byte, 0, sidecars.Len()*size)
+	for _, sidecar := range sidecars.Sidecars {
+		commitments = append(commitments, sidecar.KzgCommitment[:]...)
+	}
+	if err := s.Set(slot.Unwrap(), commitmentsKey, commitments); err != nil {
+		return err
+	}
+
 	s.logger.Info("Successfully stored all blob sidecars 🚗",
 		"slot", slot.Base10(), "num_sidecars", sidecars.Len(),
 	)
 	return nil
 }
+
+// GetBlobSidecars returns the sidecars stored for the block at the given
+// slot, or ErrSidecarsNotFound.
+func (s *Store[_]) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	stored, err := s.IndexDB.Has(slot.Unwrap(), commitmentsKey)
+	if err != nil {
+		return nil, err
+	} else if !stored {
+		return nil, ErrSidecarsNotFound
+	}
+	commitments, err := s.IndexDB.Get(slot.Unwrap(), commitmentsKey)
+	if err != nil {
+		return nil, err
+	}
+	size := len(eip4844.KZGCommitment{})
+	if len(commitments)%size != 0 {
+		return nil, ErrInvalidCommitments
+	}
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, 0, len(commitments)/size),
+	}
+	for i := 0; i < len(commitments); i += size {
+		bz, err := s.IndexDB.Get(slot.Unwrap(), commitments[i:i+size])
+		if err != nil {
+			return nil, err
+		}
+		sidecar := &types.BlobSidecar{}
+		if err = sidecar.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+	return sidecars, nil
+}
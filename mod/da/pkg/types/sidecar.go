@@ -21,8 +21,11 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
@@ -106,6 +109,31 @@ func (b *BlobSidecar) GetBeaconBlockHeader() *types.BeaconBlockHeader {
 	return b.BeaconBlockHeader
 }
 
+// MarshalJSON marshals the sidecar as served by the beacon node API. Block
+// headers are not signed on their own in BeaconKit, so the signature of the
+// signed block header is left empty.
+func (b *BlobSidecar) MarshalJSON() ([]byte, error) {
+	type signedBlockHeader struct {
+		Message   *types.BeaconBlockHeader `json:"message"`
+		Signature crypto.BLSSignature      `json:"signature"`
+	}
+	return json.Marshal(struct {
+		Index          math.U64              `json:"index"`
+		Blob           eip4844.Blob          `json:"blob"`
+		KzgCommitment  eip4844.KZGCommitment `json:"kzg_commitment"`
+		KzgProof       eip4844.KZGProof      `json:"kzg_proof"`
+		Header         signedBlockHeader     `json:"signed_block_header"`
+		InclusionProof []common.Root         `json:"kzg_commitment_inclusion_proof"`
+	}{
+		Index:          math.U64(b.Index),
+		Blob:           b.Blob,
+		KzgCommitment:  b.KzgCommitment,
+		KzgProof:       b.KzgProof,
+		Header:         signedBlockHeader{Message: b.BeaconBlockHeader},
+		InclusionProof: b.InclusionProof,
+	})
+}
+
 // DefineSSZ defines the SSZ encoding for the BlobSidecar object.
 func (b *BlobSidecar) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineUint64(codec, &b.Index)
@@ -22,6 +22,9 @@
 package types
 
 import (
+	"encoding/json"
+	"slices"
+
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/karalabe/ssz"
 	"github.com/sourcegraph/conc/iter"
@@ -50,6 +53,30 @@ func (bs *BlobSidecars) Get(index int) *BlobSidecar {
 	return bs.Sidecars[index]
 }
 
+// Filter returns the sidecars with the given indices, or all of them if no
+// index is given.
+func (bs *BlobSidecars) Filter(indices []uint64) *BlobSidecars {
+	if len(indices) == 0 {
+		return bs
+	}
+	filtered := &BlobSidecars{Sidecars: make([]*BlobSidecar, 0, len(indices))}
+	for _, sidecar := range bs.Sidecars {
+		if slices.Contains(indices, sidecar.Index) {
+			filtered.Sidecars = append(filtered.Sidecars, sidecar)
+		}
+	}
+	return filtered
+}
+
+// MarshalJSON marshals the sidecars as a list, as served by the beacon node
+// API.
+func (bs *BlobSidecars) MarshalJSON() ([]byte, error) {
+	if bs.IsNil() {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(bs.Sidecars)
+}
+
 // IsNil checks to see if blobs are nil.
 func (bs *BlobSidecars) IsNil() bool {
 	return bs == nil || bs.Sidecars == nil
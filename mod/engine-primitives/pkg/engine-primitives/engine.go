@@ -26,6 +26,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
 // ClientVersionV1 contains information which identifies a client
@@ -104,3 +105,34 @@ type PayloadStatusV1 struct {
 
 // PayloadID is an identifier for the payload build process.
 type PayloadID = bytes.B8
+
+// ExecutionPayloadBodyV1 as per the EngineAPI Specification:
+// https://github.com/ethereum/execution-apis/blob/main/src/engine/shanghai.md#executionpayloadbodyv1
+//
+//nolint:lll // link.
+type ExecutionPayloadBodyV1 struct {
+	// Transactions is the list of transactions of the payload.
+	Transactions []bytes.Bytes `json:"transactions"`
+	// Withdrawals is the list of withdrawals of the payload.
+	Withdrawals Withdrawals `json:"withdrawals"`
+}
+
+// GetTransactions returns the transactions of the payload body.
+func (b *ExecutionPayloadBodyV1) GetTransactions() Transactions {
+	txs := make(Transactions, len(b.Transactions))
+	for i, tx := range b.Transactions {
+		txs[i] = tx
+	}
+	return txs
+}
+
+// BlobAndProofV1 as per the EngineAPI Specification:
+// https://github.com/ethereum/execution-apis/blob/main/src/engine/cancun.md#blobandproofv1
+//
+//nolint:lll // link.
+type BlobAndProofV1 struct {
+	// Blob is the blob data.
+	Blob *eip4844.Blob `json:"blob"`
+	// Proof is the KZG proof of the blob.
+	Proof eip4844.KZGProof `json:"proof"`
+}
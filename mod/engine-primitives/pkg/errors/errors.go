@@ -93,6 +93,24 @@ var (
 		"nil payload status received from execution client",
 	)
 
+	// ErrPayloadBodyUnavailable is returned when the execution client does
+	// not know the body of a payload, e.g. because it pruned its history.
+	ErrPayloadBodyUnavailable = errors.New(
+		"payload body is not available from the execution client",
+	)
+
+	// ErrGetBlobsUnsupported is returned when the execution client does not
+	// support fetching blobs from its blob pool.
+	ErrGetBlobsUnsupported = errors.New(
+		"execution client does not support engine_getBlobsV1",
+	)
+
+	// ErrInvalidGetBlobsResponse is returned when the execution client does
+	// not return an entry for each requested blob.
+	ErrInvalidGetBlobsResponse = errors.New(
+		"invalid response to engine_getBlobsV1",
+	)
+
 	// ErrEngineAPITimeout is returned when the engine API call times out.
 	ErrEngineAPITimeout = errors.New(
 		"engine API call timed out",
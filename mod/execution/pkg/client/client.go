@@ -24,15 +24,19 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
 	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	lru "github.com/hashicorp/golang-lru/v2"
 )
 
 // EngineClient is a struct that holds a pointer to an Eth1Client.
@@ -51,6 +55,16 @@ type EngineClient[
 	metrics *clientMetrics
 	// capabilities is a map of capabilities that the execution client has.
 	capabilities map[string]struct{}
+	// connected is set once the connection to the execution client has been
+	// verified.
+	connected atomic.Bool
+	// recorder, if set, records the traffic with the execution client.
+	recorder *ethclientrpc.Recorder
+	// payloadBodies caches the payload bodies fetched from the execution
+	// client by their block hash.
+	payloadBodies *lru.Cache[
+		common.ExecutionHash, *engineprimitives.ExecutionPayloadBodyV1,
+	]
 }
 
 // New creates a new engine client EngineClient.
@@ -65,23 +79,34 @@ func New[
 	jwtSecret *jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
+	recorder *ethclientrpc.Recorder,
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	opts := []func(*ethclientrpc.Client){
+		ethclientrpc.WithJWTSecret(jwtSecret),
+		ethclientrpc.WithJWTRefreshInterval(cfg.RPCJWTRefreshInterval),
+	}
+	if recorder != nil {
+		opts = append(opts, ethclientrpc.WithRecorder(recorder))
+	}
+	payloadBodies, err := lru.New[
+		common.ExecutionHash, *engineprimitives.ExecutionPayloadBodyV1,
+	](payloadBodiesCacheSize)
+	if err != nil {
+		panic(err)
+	}
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
 		cfg:    cfg,
 		logger: logger,
 		Client: ethclient.New[ExecutionPayloadT](
-			ethclientrpc.NewClient(
-				cfg.RPCDialURL.String(),
-				ethclientrpc.WithJWTSecret(jwtSecret),
-				ethclientrpc.WithJWTRefreshInterval(
-					cfg.RPCJWTRefreshInterval,
-				),
-			)),
-		capabilities: make(map[string]struct{}),
-		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
+			ethclientrpc.NewClient(cfg.RPCDialURL.String(), opts...),
+		),
+		capabilities:  make(map[string]struct{}),
+		eth1ChainID:   eth1ChainID,
+		metrics:       newClientMetrics(telemetrySink, logger),
+		recorder:      recorder,
+		payloadBodies: payloadBodies,
 	}
 }
 
@@ -109,6 +134,7 @@ func (s *EngineClient[
 	// If the connection connection succeeds, we can skip the
 	// connection initialization loop.
 	if err := s.verifyChainIDAndConnection(ctx); err == nil {
+		s.connected.Store(true)
 		return nil
 	}
 
@@ -130,11 +156,35 @@ func (s *EngineClient[
 				}
 				continue
 			}
+			s.connected.Store(true)
 			return nil
 		}
 	}
 }
 
+// Stop closes the connection to the execution client and the recording of
+// its traffic, if any.
+func (s *EngineClient[
+	_, _,
+]) Stop(context.Context) error {
+	s.connected.Store(false)
+	if s.recorder == nil {
+		return s.Client.Close()
+	}
+	return errors.Join(s.Client.Close(), s.recorder.Close())
+}
+
+// Status returns an error if the connection to the execution client has not
+// been established.
+func (s *EngineClient[
+	_, _,
+]) Status() error {
+	if !s.connected.Load() {
+		return ErrNotStarted
+	}
+	return nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
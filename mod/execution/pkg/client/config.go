@@ -32,6 +32,8 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultRecordMaxSize           = 100 << 20
+	defaultRecordMaxFiles          = 10
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -47,6 +49,8 @@ func DefaultConfig() Config {
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		RecordMaxSize:           defaultRecordMaxSize,
+		RecordMaxFiles:          defaultRecordMaxFiles,
 	}
 }
 
@@ -67,4 +71,13 @@ type Config struct {
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// RecordPath is the path of the file the execution client traffic is
+	// recorded to. Recording is disabled if empty.
+	RecordPath string `mapstructure:"record-path"`
+	// RecordMaxSize is the size in bytes after which the recording is
+	// rotated.
+	RecordMaxSize uint64 `mapstructure:"record-max-size"`
+	// RecordMaxFiles is the number of rotated recordings kept besides the
+	// current one.
+	RecordMaxFiles uint64 `mapstructure:"record-max-files"`
 }
@@ -28,7 +28,11 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/spans"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 /* -------------------------------------------------------------------------- */
@@ -43,7 +47,15 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
-) (*common.ExecutionHash, error) {
+) (_ *common.ExecutionHash, err error) {
+	ctx, span := tracer.Start(ctx, "NewPayload")
+	defer func() { spans.End(span, err) }()
+	if parentBeaconBlockRoot != nil {
+		span.SetAttributes(attribute.String(
+			"parent_block_root", parentBeaconBlockRoot.String(),
+		))
+	}
+
 	var (
 		startTime    = time.Now()
 		cctx, cancel = s.createContextWithTimeout(ctx)
@@ -90,7 +102,13 @@ func (s *EngineClient[
 	state *engineprimitives.ForkchoiceStateV1,
 	attrs PayloadAttributesT,
 	forkVersion uint32,
-) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+) (_ *engineprimitives.PayloadID, _ *common.ExecutionHash, err error) {
+	ctx, span := tracer.Start(ctx, "ForkchoiceUpdated", trace.WithAttributes(
+		attribute.String("head_block_hash", state.HeadBlockHash.Hex()),
+		attribute.Bool("with_attributes", !attrs.IsNil()),
+	))
+	defer func() { spans.End(span, err) }()
+
 	var (
 		startTime    = time.Now()
 		cctx, cancel = s.createContextWithTimeout(ctx)
@@ -140,7 +158,12 @@ func (s *EngineClient[
 	ctx context.Context,
 	payloadID engineprimitives.PayloadID,
 	forkVersion uint32,
-) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+) (_ engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], err error) {
+	ctx, span := tracer.Start(ctx, "GetPayload", trace.WithAttributes(
+		attribute.String("payload_id", payloadID.String()),
+	))
+	defer func() { spans.End(span, err) }()
+
 	var (
 		startTime    = time.Now()
 		cctx, cancel = s.createContextWithTimeout(ctx)
@@ -166,6 +189,186 @@ func (s *EngineClient[
 	return result, nil
 }
 
+/* -------------------------------------------------------------------------- */
+/*                              GetPayloadBodies                              */
+/* -------------------------------------------------------------------------- */
+
+const (
+	// maxPayloadBodiesPerRequest is the number of payload bodies requested
+	// from the execution client at once, which it must always serve.
+	maxPayloadBodiesPerRequest = 32
+	// payloadBodiesCacheSize is the number of payload bodies cached.
+	payloadBodiesCacheSize = 128
+)
+
+// GetPayloadBodiesByHash returns the bodies of the payloads with the given
+// block hashes, in order. Bodies are served from the cache if possible, and
+// the others are fetched in batches with engine_getPayloadBodiesByHashV1.
+// It fails with ErrPayloadBodyUnavailable if the execution client does not
+// know a payload, e.g. because it pruned its history.
+func (s *EngineClient[
+	_, _,
+]) GetPayloadBodiesByHash(
+	ctx context.Context,
+	hashes []common.ExecutionHash,
+) (_ []*engineprimitives.ExecutionPayloadBodyV1, err error) {
+	ctx, span := tracer.Start(ctx, "GetPayloadBodiesByHash", trace.WithAttributes(
+		attribute.Int("count", len(hashes)),
+	))
+	defer func() { spans.End(span, err) }()
+
+	var (
+		bodies  = make([]*engineprimitives.ExecutionPayloadBodyV1, len(hashes))
+		missing = make([]int, 0, len(hashes))
+	)
+	for i, hash := range hashes {
+		var ok bool
+		if bodies[i], ok = s.payloadBodies.Get(hash); !ok {
+			missing = append(missing, i)
+		}
+	}
+
+	for len(missing) > 0 {
+		batch := missing[:min(len(missing), maxPayloadBodiesPerRequest)]
+		missing = missing[len(batch):]
+
+		batchHashes := make([]common.ExecutionHash, len(batch))
+		for j, i := range batch {
+			batchHashes[j] = hashes[i]
+		}
+		var result []*engineprimitives.ExecutionPayloadBodyV1
+		if result, err = s.getPayloadBodies(
+			ctx, func(ctx context.Context) (
+				[]*engineprimitives.ExecutionPayloadBodyV1, error,
+			) {
+				return s.Client.GetPayloadBodiesByHashV1(ctx, batchHashes)
+			},
+		); err != nil {
+			return nil, err
+		}
+
+		for j, i := range batch {
+			if j >= len(result) || result[j] == nil {
+				return nil, errors.Wrapf(
+					engineerrors.ErrPayloadBodyUnavailable,
+					"block hash %s", hashes[i],
+				)
+			}
+			bodies[i] = result[j]
+			s.payloadBodies.Add(hashes[i], result[j])
+		}
+	}
+	return bodies, nil
+}
+
+// GetPayloadBodiesByRange returns the bodies of the count payloads from the
+// given block number on, fetched in batches with
+// engine_getPayloadBodiesByRangeV1. Payloads past the head of the execution
+// client are left out. It fails with ErrPayloadBodyUnavailable if the
+// execution client does not know a payload, e.g. because it pruned its
+// history.
+func (s *EngineClient[
+	_, _,
+]) GetPayloadBodiesByRange(
+	ctx context.Context,
+	start, count math.U64,
+) (_ []*engineprimitives.ExecutionPayloadBodyV1, err error) {
+	ctx, span := tracer.Start(ctx, "GetPayloadBodiesByRange", trace.WithAttributes(
+		attribute.Int64("start", int64(start.Unwrap())),
+		attribute.Int64("count", int64(count.Unwrap())),
+	))
+	defer func() { spans.End(span, err) }()
+
+	bodies := make([]*engineprimitives.ExecutionPayloadBodyV1, 0, count)
+	for from := start; from < start+count; from += maxPayloadBodiesPerRequest {
+		batchCount := min(start+count-from, maxPayloadBodiesPerRequest)
+		var result []*engineprimitives.ExecutionPayloadBodyV1
+		if result, err = s.getPayloadBodies(
+			ctx, func(ctx context.Context) (
+				[]*engineprimitives.ExecutionPayloadBodyV1, error,
+			) {
+				return s.Client.GetPayloadBodiesByRangeV1(ctx, from, batchCount)
+			},
+		); err != nil {
+			return nil, err
+		}
+
+		for j, body := range result {
+			if body == nil {
+				return nil, errors.Wrapf(
+					engineerrors.ErrPayloadBodyUnavailable,
+					"block number %d", from.Unwrap()+uint64(j),
+				)
+			}
+		}
+		bodies = append(bodies, result...)
+
+		// The execution client stops at its head.
+		if math.U64(len(result)) < batchCount {
+			break
+		}
+	}
+	return bodies, nil
+}
+
+// getPayloadBodies runs a request for payload bodies to the execution client
+// under the RPC timeout.
+func (s *EngineClient[
+	_, _,
+]) getPayloadBodies(
+	ctx context.Context,
+	request func(context.Context) (
+		[]*engineprimitives.ExecutionPayloadBodyV1, error,
+	),
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	result, err := request(cctx)
+	if err != nil {
+		return nil, s.handleRPCError(err)
+	}
+	return result, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  GetBlobs                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetBlobs returns the blobs with the given versioned hashes, along with
+// their KZG proofs, from the blob pool of the execution client. The entry of
+// a blob missing from the pool is nil.
+func (s *EngineClient[
+	_, _,
+]) GetBlobs(
+	ctx context.Context,
+	versionedHashes []common.ExecutionHash,
+) (_ []*engineprimitives.BlobAndProofV1, err error) {
+	ctx, span := tracer.Start(ctx, "GetBlobs", trace.WithAttributes(
+		attribute.Int("count", len(versionedHashes)),
+	))
+	defer func() { spans.End(span, err) }()
+
+	if _, ok := s.capabilities[ethclient.GetBlobsV1]; !ok {
+		return nil, engineerrors.ErrGetBlobsUnsupported
+	}
+
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	result, err := s.Client.GetBlobsV1(cctx, versionedHashes)
+	if err != nil {
+		return nil, s.handleRPCError(err)
+	}
+	if len(result) != len(versionedHashes) {
+		return nil, errors.Wrapf(
+			engineerrors.ErrInvalidGetBlobsResponse,
+			"expected %d blobs, got %d", len(versionedHashes), len(result),
+		)
+	}
+	return result, nil
+}
+
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
 // JSON-RPC.
 func (s *EngineClient[
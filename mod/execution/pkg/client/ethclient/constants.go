@@ -27,6 +27,9 @@ func BeaconKitSupportedCapabilities() []string {
 		NewPayloadMethodV3,
 		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethodV3,
+		GetPayloadBodiesByHashV1,
+		GetPayloadBodiesByRangeV1,
+		GetBlobsV1,
 		GetClientVersionV1,
 	}
 }
@@ -39,6 +42,15 @@ const (
 	ForkchoiceUpdatedMethodV3 = "engine_forkchoiceUpdatedV3"
 	// GetPayloadMethodV3 for retrieving a payload in Deneb.
 	GetPayloadMethodV3 = "engine_getPayloadV3"
+	// GetPayloadBodiesByHashV1 for retrieving the bodies of payloads by
+	// their block hashes.
+	GetPayloadBodiesByHashV1 = "engine_getPayloadBodiesByHashV1"
+	// GetPayloadBodiesByRangeV1 for retrieving the bodies of payloads by a
+	// range of block numbers.
+	GetPayloadBodiesByRangeV1 = "engine_getPayloadBodiesByRangeV1"
+	// GetBlobsV1 for retrieving blobs from the blob pool by their versioned
+	// hashes.
+	GetBlobsV1 = "engine_getBlobsV1"
 	// BlockByHashMethod for retrieving a block by its hash.
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
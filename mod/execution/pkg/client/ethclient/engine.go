@@ -26,6 +26,7 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -156,6 +157,61 @@ func (s *Client[ExecutionPayloadT]) GetPayloadV3(
 	return result, nil
 }
 
+/* -------------------------------------------------------------------------- */
+/*                              GetPayloadBodies                              */
+/* -------------------------------------------------------------------------- */
+
+// GetPayloadBodiesByHashV1 calls the engine_getPayloadBodiesByHashV1 method
+// via JSON-RPC. The body of a payload unknown to the execution client, e.g.
+// because it pruned its history, is nil.
+func (s *Client[ExecutionPayloadT]) GetPayloadBodiesByHashV1(
+	ctx context.Context,
+	hashes []common.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0, len(hashes))
+	if err := s.Call(
+		ctx, &result, GetPayloadBodiesByHashV1, hashes,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPayloadBodiesByRangeV1 calls the engine_getPayloadBodiesByRangeV1
+// method via JSON-RPC. The bodies of the payloads unknown to the execution
+// client are nil, and those past its head are left out.
+func (s *Client[ExecutionPayloadT]) GetPayloadBodiesByRangeV1(
+	ctx context.Context,
+	start, count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0, count)
+	if err := s.Call(
+		ctx, &result, GetPayloadBodiesByRangeV1, start, count,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  GetBlobs                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetBlobsV1 calls the engine_getBlobsV1 method via JSON-RPC. The entry of a
+// blob missing from the blob pool of the execution client is nil.
+func (s *Client[ExecutionPayloadT]) GetBlobsV1(
+	ctx context.Context,
+	versionedHashes []common.ExecutionHash,
+) ([]*engineprimitives.BlobAndProofV1, error) {
+	result := make([]*engineprimitives.BlobAndProofV1, 0, len(versionedHashes))
+	if err := s.Call(
+		ctx, &result, GetBlobsV1, versionedHashes,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Other                                   */
 /* -------------------------------------------------------------------------- */
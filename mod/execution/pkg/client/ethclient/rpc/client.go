@@ -23,6 +23,7 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"sync"
@@ -52,6 +53,9 @@ type Client struct {
 
 	// header is the HTTP header used for RPC requests.
 	header http.Header
+
+	// recorder, if set, records every call made by the client.
+	recorder *Recorder
 }
 
 // New create new rpc client with given url.
@@ -98,7 +102,8 @@ func (rpc *Client) Start(ctx context.Context) {
 	}
 }
 
-// Close closes the RPC client.
+// Close closes the RPC client. The recorder, if any, is left open as the
+// client may be reconnected.
 func (rpc *Client) Close() error {
 	rpc.client.CloseIdleConnections()
 	return nil
@@ -123,6 +128,20 @@ func (rpc *Client) Call(
 // Call returns raw response of method call.
 func (rpc *Client) CallRaw(
 	ctx context.Context, method string, params ...any,
+) (json.RawMessage, error) {
+	if rpc.recorder == nil {
+		return rpc.callRaw(ctx, method, params...)
+	}
+
+	start := time.Now()
+	result, err := rpc.callRaw(ctx, method, params...)
+	rpc.record(start, method, params, result, err)
+	return result, err
+}
+
+// callRaw sends the call and returns the raw result.
+func (rpc *Client) callRaw(
+	ctx context.Context, method string, params ...any,
 ) (json.RawMessage, error) {
 	// Pull a request from the pool, we know that it already has the correct
 	// JSONRPC version and ID set.
@@ -178,3 +197,34 @@ func (rpc *Client) CallRaw(
 
 	return resp.Result, nil
 }
+
+// record records a call made by the client. Recording is best effort and
+// never fails the call.
+func (rpc *Client) record(
+	start time.Time,
+	method string,
+	params []any,
+	result json.RawMessage,
+	err error,
+) {
+	record := &Record{
+		Time:     start,
+		Duration: time.Since(start),
+		Method:   method,
+		Result:   result,
+	}
+	//#nosec:G703 // params were already marshalled for the call.
+	record.Params, _ = json.Marshal(params)
+
+	var rpcErr Error
+	switch {
+	case err == nil:
+	case errors.As(err, &rpcErr):
+		record.Error = &rpcErr
+	default:
+		record.Failure = err.Error()
+	}
+
+	//#nosec:G703 // recording is best effort.
+	_ = rpc.recorder.Record(record)
+}
@@ -22,4 +22,9 @@ package rpc
 
 import "errors"
 
-var ErrNilResponse = errors.New("nil response")
+var (
+	ErrNilResponse = errors.New("nil response")
+
+	// ErrRecorderClosed is returned when recording to a closed recorder.
+	ErrRecorderClosed = errors.New("recorder closed")
+)
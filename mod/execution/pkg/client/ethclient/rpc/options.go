@@ -39,3 +39,10 @@ func WithJWTRefreshInterval(interval time.Duration) func(rpc *Client) {
 		rpc.jwtRefreshInterval = interval
 	}
 }
+
+// WithRecorder sets the recorder every call of the RPC client is recorded to.
+func WithRecorder(recorder *Recorder) func(rpc *Client) {
+	return func(rpc *Client) {
+		rpc.recorder = recorder
+	}
+}
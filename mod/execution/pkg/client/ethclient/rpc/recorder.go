@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+)
+
+// maxRecordSize bounds the size of a single recorded call when reading a
+// recording back, as payloads with blobs can be large.
+const maxRecordSize = 64 << 20
+
+// Record is a single JSON-RPC call captured by a Recorder.
+type Record struct {
+	// Time is when the request was sent.
+	Time time.Time `json:"time"`
+	// Duration is how long the call took to complete.
+	Duration time.Duration `json:"duration"`
+	// Method is the RPC method that was called.
+	Method string `json:"method"`
+	// Params are the parameters the method was called with.
+	Params json.RawMessage `json:"params"`
+	// Result is the raw result of the call, if it succeeded.
+	Result json.RawMessage `json:"result,omitempty"`
+	// Error is the JSON-RPC error returned by the server, if any.
+	Error *Error `json:"error,omitempty"`
+	// Failure is the transport error of the call, if any.
+	Failure string `json:"failure,omitempty"`
+}
+
+// Recorder writes every call made by a Client, one JSON record per line, to
+// a file that is rotated once it exceeds a maximum size.
+type Recorder struct {
+	// mu protects the fields below for concurrent calls.
+	mu sync.Mutex
+	// path is the path of the file currently written to.
+	path string
+	// maxSize is the size in bytes after which the file is rotated.
+	maxSize int64
+	// maxFiles is the number of rotated files kept besides the current one.
+	maxFiles int
+	// file is the file currently written to.
+	file *os.File
+	// size is the size in bytes of the current file.
+	size int64
+}
+
+// NewRecorder opens a recorder appending to the file at path. Once the file
+// exceeds maxSize bytes it is renamed to path.1, shifting older files up to
+// path.<maxFiles>; older files are removed.
+func NewRecorder(path string, maxSize uint64, maxFiles uint64) (
+	*Recorder, error,
+) {
+	r := &Recorder{
+		path: path,
+		//#nosec:G115 // sizes realistically fit.
+		maxSize: int64(maxSize),
+		//#nosec:G115 // counts realistically fit.
+		maxFiles: int(maxFiles),
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record appends the record to the recording, rotating it first if needed.
+func (r *Recorder) Record(record *Record) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	bz = append(bz, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return ErrRecorderClosed
+	}
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(bz)) > r.maxSize {
+		if err = r.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := r.file.Write(bz)
+	r.size += int64(n)
+	return err
+}
+
+// Close closes the recording.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// open opens the current file for appending.
+func (r *Recorder) open() error {
+	//#nosec:G302,G304 // the recording path is configured by the operator.
+	file, err := os.OpenFile(
+		r.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600,
+	)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return errors.Join(err, file.Close())
+	}
+	r.file, r.size = file, info.Size()
+	return nil
+}
+
+// rotate shifts the rotated files, moves the current file to path.1 and opens
+// a fresh one.
+func (r *Recorder) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	r.file = nil
+
+	if r.maxFiles == 0 {
+		if err := os.Remove(r.path); err != nil {
+			return err
+		}
+		return r.open()
+	}
+	if err := os.Remove(rotatedPath(r.path, r.maxFiles)); err != nil &&
+		!os.IsNotExist(err) {
+		return err
+	}
+	for i := r.maxFiles - 1; i > 0; i-- {
+		if err := os.Rename(
+			rotatedPath(r.path, i), rotatedPath(r.path, i+1),
+		); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(r.path, rotatedPath(r.path, 1)); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+// ReadRecords reads back a recording made by a Recorder at path, including
+// its rotated files, in the order the calls were made.
+func ReadRecords(path string) ([]*Record, error) {
+	paths := []string{path}
+	for i := 1; ; i++ {
+		if _, err := os.Stat(rotatedPath(path, i)); err != nil {
+			if os.IsNotExist(err) {
+				break
+			}
+			return nil, err
+		}
+		paths = append(paths, rotatedPath(path, i))
+	}
+
+	records := make([]*Record, 0)
+	for i := len(paths) - 1; i >= 0; i-- {
+		fileRecords, err := readRecordsFile(paths[i])
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, fileRecords...)
+	}
+	return records, nil
+}
+
+// readRecordsFile reads the records of a single file of a recording.
+func readRecordsFile(path string) ([]*Record, error) {
+	//#nosec:G304 // the recording path is provided by the operator.
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return decodeRecords(file)
+}
+
+// decodeRecords decodes newline delimited records.
+func decodeRecords(reader io.Reader) ([]*Record, error) {
+	var (
+		records = make([]*Record, 0)
+		scanner = bufio.NewScanner(reader)
+	)
+	scanner.Buffer(nil, maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := new(Record)
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, errors.Wrapf(err, "record on line %d", line)
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+// rotatedPath returns the path of the i-th rotated file of a recording.
+func rotatedPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+// echoServer answers every call with its parameters, or an error for
+// the "fail" method.
+func echoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     int             `json:"id"`
+				Method string          `json:"method"`
+				Params json.RawMessage `json:"params"`
+			}
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(body, &req))
+			resp := map[string]any{"id": req.ID, "jsonrpc": "2.0"}
+			if req.Method == "fail" {
+				resp["error"] = rpc.Error{Code: -1, Message: "failed"}
+			} else {
+				resp["result"] = req.Params
+			}
+			bz, err := json.Marshal(resp)
+			require.NoError(t, err)
+			_, err = w.Write(bz)
+			require.NoError(t, err)
+		},
+	))
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "engine.rec")
+	// Small enough for every call to rotate the recording.
+	recorder, err := rpc.NewRecorder(path, 1, 2)
+	require.NoError(t, err)
+
+	server := echoServer(t)
+	client := rpc.NewClient(server.URL, rpc.WithRecorder(recorder))
+	ctx := context.Background()
+	for _, param := range []string{"a", "b", "c"} {
+		_, err = client.CallRaw(ctx, "echo", param)
+		require.NoError(t, err)
+	}
+	_, err = client.CallRaw(ctx, "fail")
+	require.Error(t, err)
+	server.Close()
+	_, err = client.CallRaw(ctx, "echo", "d")
+	require.Error(t, err)
+	require.NoError(t, recorder.Close())
+
+	// Only the current file and two rotated ones are kept.
+	records, err := rpc.ReadRecords(path)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "echo", records[0].Method)
+	require.JSONEq(t, `["c"]`, string(records[0].Result))
+	require.Equal(t, "fail", records[1].Method)
+	require.Equal(t, &rpc.Error{Code: -1, Message: "failed"}, records[1].Error)
+	require.NotEmpty(t, records[2].Failure)
+
+	// The recording is served back to a client, transport failures
+	// included.
+	replayer := rpc.NewReplayer(records)
+	replay := httptest.NewServer(replayer)
+	defer replay.Close()
+	client = rpc.NewClient(replay.URL)
+
+	result, err := client.CallRaw(ctx, "echo", "c")
+	require.NoError(t, err)
+	require.JSONEq(t, `["c"]`, string(result))
+	_, err = client.CallRaw(ctx, "fail")
+	require.Equal(t, rpc.Error{Code: -1, Message: "failed"}, err)
+	_, err = client.CallRaw(ctx, "echo", "d")
+	require.Error(t, err)
+	require.Empty(t, replayer.Divergences())
+
+	// Calls that do not match the recording are answered with the last
+	// record of the same method and reported.
+	_, err = client.CallRaw(ctx, "echo", "e")
+	require.Error(t, err)
+	_, err = client.CallRaw(ctx, "unknown")
+	require.Error(t, err)
+	require.Equal(t, []string{"echo", "unknown"}, replayer.Divergences())
+	require.Equal(t, 5, replayer.Calls())
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+)
+
+// errCodeNotRecorded is the JSON-RPC error code answered for methods that
+// were never recorded.
+const errCodeNotRecorded = -32601
+
+// Replayer is an http.Handler serving JSON-RPC calls from a recording, so
+// that a session with an execution client can be replayed offline.
+//
+// A call is answered with the next unserved record of the same method and
+// parameters. A call that does not match any record diverges from the
+// recording and is answered with the next unserved record of the same
+// method. The last record of a method keeps being served once all records
+// have been, as clients may poll idempotent methods more often than
+// recorded.
+type Replayer struct {
+	// mu protects the fields below for concurrent calls.
+	mu sync.Mutex
+	// byCall holds the records of each method and parameters, in order.
+	byCall map[string][]*Record
+	// byMethod holds the records of each method, in order.
+	byMethod map[string][]*Record
+	// served is the set of records that have been served.
+	served map[*Record]struct{}
+	// calls is the number of calls answered.
+	calls int
+	// divergences holds the methods of the calls that did not match the
+	// recording, in order.
+	divergences []string
+}
+
+// NewReplayer returns a replayer serving the given records.
+func NewReplayer(records []*Record) *Replayer {
+	r := &Replayer{
+		byCall:   make(map[string][]*Record),
+		byMethod: make(map[string][]*Record),
+		served:   make(map[*Record]struct{}),
+	}
+	for _, record := range records {
+		key := callKey(record.Method, record.Params)
+		r.byCall[key] = append(r.byCall[key], record)
+		r.byMethod[record.Method] = append(r.byMethod[record.Method], record)
+	}
+	return r
+}
+
+// ServeHTTP answers a JSON-RPC call from the recording.
+func (r *Replayer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var call struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err = json.Unmarshal(body, &call); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	record := r.next(call.Method, call.Params)
+	resp := struct {
+		ID      json.RawMessage `json:"id"`
+		JSONRPC string          `json:"jsonrpc"`
+		Result  json.RawMessage `json:"result,omitempty"`
+		Error   *Error          `json:"error,omitempty"`
+	}{ID: call.ID, JSONRPC: "2.0"}
+	switch {
+	case record == nil:
+		resp.Error = &Error{
+			Code:    errCodeNotRecorded,
+			Message: "method " + call.Method + " was not recorded",
+		}
+	case record.Failure != "":
+		// The recorded call never got an answer.
+		http.Error(w, record.Failure, http.StatusServiceUnavailable)
+		return
+	case record.Error != nil:
+		resp.Error = record.Error
+	default:
+		resp.Result = record.Result
+	}
+
+	bz, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	//#nosec:G104 // the client is gone if the write fails.
+	_, _ = w.Write(bz)
+}
+
+// Calls returns the number of calls answered.
+func (r *Replayer) Calls() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+// Divergences returns the methods of the calls that did not match the
+// recording, in the order they were made.
+func (r *Replayer) Divergences() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.divergences...)
+}
+
+// next returns the record to answer a call with, or nil if the method was
+// never recorded.
+func (r *Replayer) next(method string, params json.RawMessage) *Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+
+	if record := r.take(r.byCall[callKey(method, params)]); record != nil {
+		return record
+	}
+	r.divergences = append(r.divergences, method)
+	return r.take(r.byMethod[method])
+}
+
+// take marks and returns the first unserved record, or the last record if
+// all have been served.
+func (r *Replayer) take(records []*Record) *Record {
+	for _, record := range records {
+		if _, ok := r.served[record]; !ok {
+			r.served[record] = struct{}{}
+			return record
+		}
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	return records[len(records)-1]
+}
+
+// callKey identifies a call by its method and compacted parameters.
+func callKey(method string, params json.RawMessage) string {
+	buf := new(bytes.Buffer)
+	if err := json.Compact(buf, params); err != nil {
+		return method + string(params)
+	}
+	return method + buf.String()
+}
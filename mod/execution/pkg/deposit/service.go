@@ -60,6 +60,8 @@ type Service[
 	// failedBlocks is a map of blocks that failed to be processed
 	// and should be retried.
 	failedBlocks map[math.U64]struct{}
+	// wg tracks the event loop and catchup fetcher goroutines.
+	wg sync.WaitGroup
 }
 
 // NewService creates a new instance of the Service struct.
@@ -108,14 +110,42 @@ func (s *Service[
 		return err
 	}
 
+	//nolint:mnd // two goroutines.
+	s.wg.Add(2)
+
 	// Listen for finalized block events and fetch deposits for the block.
-	go s.eventLoop(ctx)
+	go func() {
+		defer s.wg.Done()
+		s.eventLoop(ctx)
+	}()
 
 	// Catchup deposits for failed blocks.
-	go s.depositCatchupFetcher(ctx)
+	go func() {
+		defer s.wg.Done()
+		s.depositCatchupFetcher(ctx)
+	}()
 	return nil
 }
 
+// Stop waits for the deposit fetchers to exit, which happens once the
+// context passed to Start is done and in-flight deposits have been stored.
+func (s *Service[
+	_, _, _, _, _,
+]) Stop(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // eventLoop starts the main event loop to listen and handle
 // BeaconBlockFinalized events.
 func (s *Service[
@@ -125,7 +155,10 @@ func (s *Service[
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subFinalizedBlockEvents:
+		case event, ok := <-s.subFinalizedBlockEvents:
+			if !ok {
+				return
+			}
 			s.depositFetcher(ctx, event)
 		}
 	}
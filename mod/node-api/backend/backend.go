@@ -42,7 +42,7 @@ type Backend[
 		ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	BeaconStateMarshallableT any,
-	BlobSidecarsT any,
+	BlobSidecarsT BlobSidecars[BlobSidecarsT],
 	BlockStoreT BlockStore[BeaconBlockT],
 	ContextT context.Context,
 	DepositT any,
@@ -63,6 +63,8 @@ type Backend[
 	sb   StorageBackendT
 	cs   common.ChainSpec
 	node NodeT
+	fr   FeeRecipients
+	ar   BlobArchive[BlobSidecarsT]
 
 	sp StateProcessor[BeaconStateT]
 }
@@ -80,7 +82,7 @@ func New[
 		ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	BeaconStateMarshallableT any,
-	BlobSidecarsT any,
+	BlobSidecarsT BlobSidecars[BlobSidecarsT],
 	BlockStoreT BlockStore[BeaconBlockT],
 	ContextT context.Context,
 	DepositT any,
@@ -101,6 +103,8 @@ func New[
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
 	sp StateProcessor[BeaconStateT],
+	fr FeeRecipients,
+	ar BlobArchive[BlobSidecarsT],
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
@@ -118,6 +122,8 @@ func New[
 		sb: storageBackend,
 		cs: cs,
 		sp: sp,
+		fr: fr,
+		ar: ar,
 	}
 }
 
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BlobSidecarsAtSlot returns the sidecars with the given indices, or all of
+// them if no index is given, of the block at the given slot. Sidecars that
+// were pruned from the availability store are read from the blob archive.
+func (b Backend[
+	_, _, _, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlobSidecarsAtSlot(
+	ctx context.Context, slot math.Slot, indices []uint64,
+) (*types.ValidatorResponse, error) {
+	sidecars, err := b.sb.AvailabilityStore().GetBlobSidecars(slot)
+	if err != nil {
+		var archiveErr error
+		if sidecars, archiveErr = b.ar.GetBlobSidecars(
+			ctx, slot,
+		); archiveErr != nil {
+			return nil, errors.Wrapf(
+				handlertypes.ErrNotFound,
+				"blob sidecars at slot %d: %v", slot,
+				errors.Join(err, archiveErr),
+			)
+		}
+	}
+	return &types.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                sidecars.Filter(indices),
+	}, nil
+}
@@ -21,9 +21,15 @@
 package backend
 
 import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
 	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // BlockHeader returns the block header at the given slot.
@@ -42,6 +48,55 @@ func (b Backend[
 	return blockHeader, err
 }
 
+// BlockSignatureAtSlot returns the proposer signature of the block at the
+// given slot. The genesis block is not signed, and signatures are only kept
+// by the block store, so the signature is empty if the block store does not
+// hold the block (e.g. it is disabled or the block is out of its window).
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlockSignatureAtSlot(slot math.Slot) (crypto.BLSSignature, error) {
+	if slot == 0 {
+		return crypto.BLSSignature{}, nil
+	}
+	// The store returns an empty signature for blocks it does not hold.
+	sig, _ := b.sb.BlockStore().GetSignatureBySlot(slot)
+	return sig, nil
+}
+
+// BlockAtSlot returns the signed block at the given slot. The block store only
+// keeps blinded blocks, so the execution payload is rebuilt from the execution
+// client, which fails if the block is out of the store's window or the
+// execution client has pruned the payload.
+func (b Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlockAtSlot(
+	ctx context.Context, slot math.Slot,
+) (*types.BlockResponse, error) {
+	blk, err := b.sb.BlockStore().GetBlockBySlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrapf(
+			handlertypes.ErrNotFound, "block at slot %d: %v", slot, err,
+		)
+	}
+	sig, err := b.sb.BlockStore().GetSignatureBySlot(slot)
+	if err != nil {
+		return nil, errors.Wrapf(
+			handlertypes.ErrNotFound, "block at slot %d: %v", slot, err,
+		)
+	}
+	return &types.BlockResponse{
+		Version: version.Name(b.cs.ActiveForkVersionForSlot(slot)),
+		ValidatorResponse: types.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			Finalized:           false, // stubbed
+			Data: &types.SignedBlock[BeaconBlockT]{
+				Message:   blk,
+				Signature: sig,
+			},
+		},
+	}, nil
+}
+
 // GetBlockRoot returns the root of the block at the given stateID.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
@@ -56,6 +111,68 @@ func (b Backend[
 	return st.GetBlockRootAtIndex(slot.Unwrap() % b.cs.SlotsPerHistoricalRoot())
 }
 
+// HistoricalBlockRootsAtSlot returns the block roots accumulated by the
+// historical summary covering the given slot, along with the index of that
+// historical summary. The summaries are indexed from the first slot of the
+// period of the first summary, and the block roots are read from the block
+// store, so the blocks of that period must still be held by the store.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) HistoricalBlockRootsAtSlot(
+	slot math.Slot,
+) ([]common.Root, uint64, error) {
+	// Ensure the historical summary has been accumulated by the latest state.
+	st, _, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, 0, err
+	}
+	summaries, err := st.GetHistoricalSummaries()
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(summaries) == 0 {
+		return nil, 0, errors.Wrapf(
+			handlertypes.ErrNotFound,
+			"slot %d is not yet accumulated by a historical summary", slot,
+		)
+	}
+	startSlot, err := st.GetHistoricalSummariesStartSlot()
+	if err != nil {
+		return nil, 0, err
+	}
+	if slot < startSlot {
+		return nil, 0, errors.Wrapf(
+			handlertypes.ErrNotFound,
+			"slot %d precedes the historical summaries starting at slot %d",
+			slot, startSlot,
+		)
+	}
+	period := b.cs.SlotsPerHistoricalRoot()
+	summaryIndex := (slot - startSlot).Unwrap() / period
+	if summaryIndex >= uint64(len(summaries)) {
+		return nil, 0, errors.Wrapf(
+			handlertypes.ErrNotFound,
+			"slot %d is not yet accumulated by a historical summary", slot,
+		)
+	}
+
+	// The root of the block at a slot is the parent root of the block at the
+	// next slot, which covers the genesis block the store does not hold.
+	periodStart := startSlot + math.Slot(summaryIndex*period)
+	blockRoots := make([]common.Root, period)
+	for i := range period {
+		if blockRoots[i], err = b.sb.BlockStore().GetParentBlockRootBySlot(
+			periodStart + math.Slot(i+1),
+		); err != nil {
+			return nil, 0, errors.Wrapf(
+				handlertypes.ErrNotFound, "block root at slot %d: %v",
+				periodStart+math.Slot(i), err,
+			)
+		}
+	}
+	return blockRoots, summaryIndex, nil
+}
+
 // TODO: Implement this.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// FinalityCheckpointsAtSlot returns the finality checkpoints of the state at
+// the given slot. CometBFT finalizes every block it commits, so the current
+// justified and finalized checkpoints are both the latest block of the epoch
+// of the state, and the previous justified checkpoint is the last block of
+// the epoch before it.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) FinalityCheckpointsAtSlot(
+	slot math.Slot,
+) (*beacontypes.FinalityCheckpointsData, error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := b.cs.SlotToEpoch(slot)
+	current, err := b.checkpoint(st, epoch, slot)
+	if err != nil {
+		return nil, err
+	}
+	// There is no epoch before genesis, which has the empty checkpoint.
+	previous := &beacontypes.Checkpoint{}
+	if epoch > 0 {
+		if previous, err = b.checkpoint(st, epoch-1, slot); err != nil {
+			return nil, err
+		}
+	}
+	return &beacontypes.FinalityCheckpointsData{
+		PreviousJustified: previous,
+		CurrentJustified:  current,
+		Finalized:         current,
+	}, nil
+}
+
+// CommitteesAtSlot returns the committees of every slot of the given epoch,
+// as seen by the state at the given slot. An epoch of 0 is inferred from the
+// slot. Each slot has a single committee, the CometBFT validator set of the
+// state.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) CommitteesAtSlot(
+	slot math.Slot,
+	epoch math.Epoch,
+) ([]*beacontypes.CommitteeData, error) {
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+	if epoch, err = b.committeeEpoch(slot, epoch); err != nil {
+		return nil, err
+	}
+	validators, err := b.activeValidators(st, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		slotsPerEpoch = b.cs.SlotsPerEpoch()
+		indices       = make([]string, len(validators))
+		votingPowers  = make([]string, len(validators))
+		committees    = make([]*beacontypes.CommitteeData, slotsPerEpoch)
+	)
+	for i, val := range validators {
+		indices[i] = strconv.FormatUint(val.index.Unwrap(), 10)
+		votingPowers[i] = strconv.FormatInt(val.votingPower, 10)
+	}
+	for i := range committees {
+		committees[i] = &beacontypes.CommitteeData{
+			Index:        0,
+			Slot:         epoch.Unwrap()*slotsPerEpoch + uint64(i),
+			Validators:   indices,
+			VotingPowers: votingPowers,
+		}
+	}
+	return committees, nil
+}
+
+// SyncCommitteesAtSlot returns the sync committee of the given epoch, as seen
+// by the state at the given slot. An epoch of 0 is inferred from the slot.
+// The sync committee is the CometBFT validator set of the state in a single
+// aggregate.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SyncCommitteesAtSlot(
+	slot math.Slot,
+	epoch math.Epoch,
+) (*beacontypes.SyncCommitteeData, error) {
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = b.committeeEpoch(slot, epoch); err != nil {
+		return nil, err
+	}
+	validators, err := b.activeValidators(st, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]string, len(validators))
+	for i, val := range validators {
+		indices[i] = strconv.FormatUint(val.index.Unwrap(), 10)
+	}
+	return &beacontypes.SyncCommitteeData{
+		Validators:          indices,
+		ValidatorAggregates: [][]string{indices},
+	}, nil
+}
+
+// ValidatorSetAtSlot returns the CometBFT validator set signing the block at
+// the given slot, with the proposer priorities of its validators and the app
+// hash committed to by executing the block.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ValidatorSetAtSlot(
+	slot math.Slot,
+) (*beacontypes.ValidatorSetData, error) {
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G701 // not an issue in practice.
+	candidates, err := b.node.ProposerCandidates(int64(slot))
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G701 // not an issue in practice.
+	appHash, err := b.node.AppHash(int64(slot))
+	if err != nil {
+		return nil, err
+	}
+
+	data := &beacontypes.ValidatorSetData{
+		Height:     slot.Unwrap(),
+		AppHash:    appHash,
+		Validators: make([]*beacontypes.CometBFTValidatorData, len(candidates)),
+	}
+	for i, candidate := range candidates {
+		var index math.ValidatorIndex
+		index, err = st.ValidatorIndexByPubkey(candidate.Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		data.TotalVotingPower += candidate.VotingPower
+		data.Validators[i] = &beacontypes.CometBFTValidatorData{
+			Index:            index.Unwrap(),
+			Pubkey:           candidate.Pubkey,
+			VotingPower:      candidate.VotingPower,
+			ProposerPriority: candidate.ProposerPriority,
+		}
+	}
+	return data, nil
+}
+
+// checkpoint returns the checkpoint of the given epoch as seen by the state
+// at the given slot, which is the latest block of the epoch up to that slot.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) checkpoint(
+	st BeaconStateT,
+	epoch math.Epoch,
+	slot math.Slot,
+) (*beacontypes.Checkpoint, error) {
+	slotsPerEpoch := math.Slot(b.cs.SlotsPerEpoch())
+	last := min(slot, (epoch+1)*slotsPerEpoch-1)
+	root, err := st.GetBlockRootAtIndex(
+		last.Unwrap() % b.cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &beacontypes.Checkpoint{
+		Epoch: epoch.Unwrap(),
+		Root:  root,
+	}, nil
+}
+
+// committeeEpoch resolves the epoch of the committees requested from the
+// state at the given slot, inferring an epoch of 0 from the slot. Like on
+// Ethereum, only the previous, current and next epochs of the state are
+// served, as the validator set of other epochs may differ.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) committeeEpoch(
+	slot math.Slot,
+	epoch math.Epoch,
+) (math.Epoch, error) {
+	current := b.cs.SlotToEpoch(slot)
+	if epoch == 0 {
+		return current, nil
+	}
+	if epoch+1 < current || epoch > current+1 {
+		return 0, errors.Wrapf(
+			handlertypes.ErrInvalidRequest,
+			"epoch %d is not within one epoch of the state at slot %d",
+			epoch, slot,
+		)
+	}
+	return epoch, nil
+}
+
+// activeValidator is a validator of the CometBFT validator set.
+type activeValidator struct {
+	index       math.ValidatorIndex
+	votingPower int64
+}
+
+// activeValidators returns the CometBFT validator set signing the block at
+// the given slot, ordered by index. Validator set changes of the beacon state
+// take effect in CometBFT two blocks after the epoch boundary they are made
+// at, so the validator set is read from CometBFT rather than from the state.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) activeValidators(
+	st BeaconStateT,
+	slot math.Slot,
+) ([]*activeValidator, error) {
+	//#nosec:G701 // not an issue in practice.
+	candidates, err := b.node.ProposerCandidates(int64(slot))
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]*activeValidator, len(candidates))
+	for i, candidate := range candidates {
+		var index math.ValidatorIndex
+		if index, err = st.ValidatorIndexByPubkey(candidate.Pubkey); err != nil {
+			return nil, err
+		}
+		active[i] = &activeValidator{
+			index:       index,
+			votingPower: candidate.VotingPower,
+		}
+	}
+	slices.SortFunc(active, func(a, b *activeValidator) int {
+		return cmp.Compare(a.index, b.index)
+	})
+	return active, nil
+}
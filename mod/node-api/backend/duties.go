@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// ProposerDutiesAtEpoch returns the dependent root and the proposer of every
+// slot in the given epoch, which may be at most one epoch ahead of the latest
+// block.
+//
+// Proposers of slots up to the latest block are read from the block headers.
+// Proposers of later slots are predicted by replaying CometBFT's proposer
+// selection from the validator set at the latest block, with the voting
+// powers updated at every epoch boundary from the effective balances in the
+// latest state. The predictions are best-effort: they assume every block is
+// decided in the first round and that effective balances do not change.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProposerDutiesAtEpoch(
+	epoch math.Epoch,
+) (common.Root, []*validatortypes.ProposerDutyData, error) {
+	st, latest, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	if epoch > b.cs.SlotToEpoch(latest)+1 {
+		return common.Root{}, nil, errors.Wrapf(
+			handlertypes.ErrInvalidRequest,
+			"epoch %d is too far ahead of the latest slot %d", epoch, latest,
+		)
+	}
+
+	slotsPerEpoch := math.Slot(b.cs.SlotsPerEpoch())
+	startSlot := epoch * slotsPerEpoch
+	endSlot := startSlot + slotsPerEpoch
+
+	dependentRoot, err := b.dependentRoot(startSlot, latest)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+
+	duties := make([]*validatortypes.ProposerDutyData, 0, slotsPerEpoch)
+	for slot := startSlot; slot < min(endSlot, latest+1); slot++ {
+		// There is no block at genesis.
+		if slot == 0 {
+			continue
+		}
+		var duty *validatortypes.ProposerDutyData
+		if duty, err = b.proposerDutyAtSlot(slot); err != nil {
+			return common.Root{}, nil, err
+		}
+		duties = append(duties, duty)
+	}
+	if endSlot <= latest+1 {
+		return dependentRoot, duties, nil
+	}
+
+	predicted, err := b.predictProposerDuties(
+		st, latest, max(startSlot, latest+1), endSlot,
+	)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	return dependentRoot, append(duties, predicted...), nil
+}
+
+// dependentRoot returns the root of the block the proposers of the epoch
+// starting at the given slot depend on, i.e. the block of the slot before
+// it, or of the latest block if that slot is still ahead.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) dependentRoot(startSlot, latest math.Slot) (common.Root, error) {
+	slot := latest
+	if startSlot > 0 && startSlot-1 < latest {
+		slot = startSlot - 1
+	}
+	return b.BlockRootAtSlot(slot)
+}
+
+// proposerDutyAtSlot returns the proposer of the block at the given slot.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) proposerDutyAtSlot(
+	slot math.Slot,
+) (*validatortypes.ProposerDutyData, error) {
+	st, _, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+	validator, err := st.ValidatorByIndex(header.GetProposerIndex())
+	if err != nil {
+		return nil, err
+	}
+	return &validatortypes.ProposerDutyData{
+		Pubkey:         validator.GetPubkey(),
+		ValidatorIndex: header.GetProposerIndex().Unwrap(),
+		Slot:           slot.Unwrap(),
+	}, nil
+}
+
+// predictProposerDuties predicts the proposers of the slots in [start, end)
+// by replaying CometBFT's proposer selection from the latest block onwards.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) predictProposerDuties(
+	st BeaconStateT,
+	latest, start, end math.Slot,
+) ([]*validatortypes.ProposerDutyData, error) {
+	//#nosec:G701 // not an issue in practice.
+	schedule, err := b.node.ProposerSchedule(int64(latest))
+	if err != nil {
+		return nil, err
+	}
+	validators, err := st.GetValidatorsByEffectiveBalance()
+	if err != nil {
+		return nil, err
+	}
+	updates := make(transition.ValidatorUpdates, len(validators))
+	for i, val := range validators {
+		updates[i] = &transition.ValidatorUpdate{
+			Pubkey:           val.GetPubkey(),
+			EffectiveBalance: val.GetEffectiveBalance(),
+		}
+	}
+
+	var (
+		slotsPerEpoch = math.Slot(b.cs.SlotsPerEpoch())
+		duties        = make([]*validatortypes.ProposerDutyData, 0, end-start)
+	)
+	for slot := latest + 1; slot < end; slot++ {
+		// Validator updates returned at an epoch boundary take effect two
+		// blocks later.
+		if slot > 2 && (slot-2)%slotsPerEpoch == 0 {
+			if err = schedule.Update(updates); err != nil {
+				return nil, err
+			}
+		}
+		var pubkey crypto.BLSPubkey
+		if pubkey, err = schedule.Next(); err != nil {
+			return nil, err
+		}
+		if slot < start {
+			continue
+		}
+
+		var index math.ValidatorIndex
+		index, err = st.ValidatorIndexByPubkey(pubkey)
+		if err != nil {
+			return nil, err
+		}
+		duties = append(duties, &validatortypes.ProposerDutyData{
+			Pubkey:         pubkey,
+			ValidatorIndex: index.Unwrap(),
+			Slot:           slot.Unwrap(),
+			Predicted:      true,
+		})
+	}
+	return duties, nil
+}
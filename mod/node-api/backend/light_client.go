@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// lightClientDelay is the number of heights a block must be buried under to
+// be verifiable by light clients. The root of the block at slot n is only in
+// the beacon state at height n+1, whose app hash is in the CometBFT header of
+// height n+2.
+const lightClientDelay = 2
+
+// LightClientBootstrap returns the light client data of the block at the
+// given slot.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _,
+]) LightClientBootstrap(
+	slot math.Slot,
+) (*beacontypes.LightClientResponse[BeaconBlockHeaderT], error) {
+	latest, err := b.latestLightClientSlot()
+	if err != nil {
+		return nil, err
+	}
+	if slot == 0 || slot > latest {
+		return nil, errors.Wrapf(
+			handlertypes.ErrNotFound,
+			"block at slot %d is not verifiable yet", slot,
+		)
+	}
+	return b.lightClientDataAtSlot(slot)
+}
+
+// LightClientUpdates returns the light client data of the last block of each
+// of the given periods, skipping periods without a verifiable block yet. A
+// period is an epoch, the interval at which the validator set may change, so
+// that light clients can follow every change of the validator set.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _,
+]) LightClientUpdates(
+	startPeriod, count uint64,
+) ([]*beacontypes.LightClientResponse[BeaconBlockHeaderT], error) {
+	latest, err := b.latestLightClientSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	slotsPerPeriod := b.cs.SlotsPerEpoch()
+	updates := make(
+		[]*beacontypes.LightClientResponse[BeaconBlockHeaderT], 0, count,
+	)
+	for period := startPeriod; period < startPeriod+count; period++ {
+		slot := min(math.Slot((period+1)*slotsPerPeriod-1), latest)
+		if slot == 0 || slot < math.Slot(period*slotsPerPeriod) {
+			break
+		}
+		var update *beacontypes.LightClientResponse[BeaconBlockHeaderT]
+		if update, err = b.lightClientDataAtSlot(slot); err != nil {
+			return nil, err
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+// LightClientLatestUpdate returns the light client data of the latest block
+// verifiable by light clients. CometBFT provides single slot finality, so the
+// latest verifiable block is final.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _,
+]) LightClientLatestUpdate() (
+	*beacontypes.LightClientResponse[BeaconBlockHeaderT], error,
+) {
+	latest, err := b.latestLightClientSlot()
+	if err != nil {
+		return nil, err
+	}
+	if latest == 0 {
+		return nil, errors.Wrap(
+			handlertypes.ErrNotFound, "no block is verifiable yet",
+		)
+	}
+	return b.lightClientDataAtSlot(latest)
+}
+
+// latestLightClientSlot returns the latest slot whose block is verifiable by
+// light clients, or 0 if there is none yet.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) latestLightClientSlot() (math.Slot, error) {
+	_, latest, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return 0, err
+	}
+	if latest <= lightClientDelay {
+		return 0, nil
+	}
+	return latest - lightClientDelay, nil
+}
+
+// lightClientDataAtSlot bundles the header of the block at the given slot
+// with the proof of its root and the CometBFT commit signing the app hash the
+// proof is against.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _,
+]) lightClientDataAtSlot(
+	slot math.Slot,
+) (*beacontypes.LightClientResponse[BeaconBlockHeaderT], error) {
+	header, err := b.BlockHeaderAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	//#nosec:G701 // not an issue in practice.
+	proof, err := b.node.ProveBlockRoot(
+		int64(slot+1), slot.Unwrap()%b.cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G701 // not an issue in practice.
+	lightBlock, err := b.node.LightBlock(int64(slot + lightClientDelay))
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacontypes.LightClientResponse[BeaconBlockHeaderT]{
+		Version: version.Name(b.cs.ActiveForkVersionForSlot(slot)),
+		Data: &beacontypes.LightClientData[BeaconBlockHeaderT]{
+			Header: &beacontypes.LightClientHeader[BeaconBlockHeaderT]{
+				Beacon: header,
+			},
+			HeaderProof:  storeProofData(proof),
+			SignedHeader: lightBlock.SignedHeader,
+			ValidatorSet: lightBlock.ValidatorSet,
+		},
+	}, nil
+}
+
+// storeProofData converts a store proof to its API representation.
+func storeProofData(proof *transition.StoreProof) *beacontypes.StoreProofData {
+	data := &beacontypes.StoreProofData{
+		Height:   proof.Height,
+		Key:      proof.Key,
+		Value:    proof.Value,
+		ProofOps: make([]*beacontypes.ProofOpData, len(proof.ProofOps)),
+	}
+	for i, op := range proof.ProofOps {
+		data.ProofOps[i] = &beacontypes.ProofOpData{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		}
+	}
+	return data
+}
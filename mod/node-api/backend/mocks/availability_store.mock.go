@@ -22,6 +22,64 @@ func (_m *AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT]) EXPECT() *Availabi
 	return &AvailabilityStore_Expecter[BeaconBlockBodyT, BlobSidecarsT]{mock: &_m.Mock}
 }
 
+// GetBlobSidecars provides a mock function with given fields: _a0
+func (_m *AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT]) GetBlobSidecars(_a0 math.U64) (BlobSidecarsT, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobSidecars")
+	}
+
+	var r0 BlobSidecarsT
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (BlobSidecarsT, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) BlobSidecarsT); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(BlobSidecarsT)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AvailabilityStore_GetBlobSidecars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobSidecars'
+type AvailabilityStore_GetBlobSidecars_Call[BeaconBlockBodyT any, BlobSidecarsT any] struct {
+	*mock.Call
+}
+
+// GetBlobSidecars is a helper method to define mock.On call
+//   - _a0 math.U64
+func (_e *AvailabilityStore_Expecter[BeaconBlockBodyT, BlobSidecarsT]) GetBlobSidecars(_a0 interface{}) *AvailabilityStore_GetBlobSidecars_Call[BeaconBlockBodyT, BlobSidecarsT] {
+	return &AvailabilityStore_GetBlobSidecars_Call[BeaconBlockBodyT, BlobSidecarsT]{Call: _e.mock.On("GetBlobSidecars", _a0)}
+}
+
+func (_c *AvailabilityStore_GetBlobSidecars_Call[BeaconBlockBodyT, BlobSidecarsT]) Run(run func(_a0 math.U64)) *AvailabilityStore_GetBlobSidecars_Call[BeaconBlockBodyT, BlobSidecarsT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *AvailabilityStore_GetBlobSidecars_Call[BeaconBlockBodyT, BlobSidecarsT]) Return(_a0 BlobSidecarsT, _a1 error) *AvailabilityStore_GetBlobSidecars_Call[BeaconBlockBodyT, BlobSidecarsT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AvailabilityStore_GetBlobSidecars_Call[BeaconBlockBodyT, BlobSidecarsT]) RunAndReturn(run func(math.U64) (BlobSidecarsT, error)) *AvailabilityStore_GetBlobSidecars_Call[BeaconBlockBodyT, BlobSidecarsT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsDataAvailable provides a mock function with given fields: _a0, _a1, _a2
 func (_m *AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT]) IsDataAvailable(_a0 context.Context, _a1 math.U64, _a2 BeaconBlockBodyT) bool {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -419,6 +419,176 @@ func (_c *BeaconState_GetGenesisValidatorsRoot_Call[BeaconBlockHeaderT, Eth1Data
 	return _c
 }
 
+// GetHistoricalSummaries provides a mock function with given fields:
+func (_m *BeaconState[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetHistoricalSummaries() ([]*common.HistoricalSummary, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricalSummaries")
+	}
+
+	var r0 []*common.HistoricalSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*common.HistoricalSummary, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*common.HistoricalSummary); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*common.HistoricalSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BeaconState_GetHistoricalSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistoricalSummaries'
+type BeaconState_GetHistoricalSummaries_Call[BeaconBlockHeaderT any, Eth1DataT any, ExecutionPayloadHeaderT any, ForkT any, ValidatorT any, ValidatorsT any, WithdrawalT any] struct {
+	*mock.Call
+}
+
+// GetHistoricalSummaries is a helper method to define mock.On call
+func (_e *BeaconState_Expecter[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetHistoricalSummaries() *BeaconState_GetHistoricalSummaries_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	return &BeaconState_GetHistoricalSummaries_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]{Call: _e.mock.On("GetHistoricalSummaries")}
+}
+
+func (_c *BeaconState_GetHistoricalSummaries_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Run(run func()) *BeaconState_GetHistoricalSummaries_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BeaconState_GetHistoricalSummaries_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Return(_a0 []*common.HistoricalSummary, _a1 error) *BeaconState_GetHistoricalSummaries_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BeaconState_GetHistoricalSummaries_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) RunAndReturn(run func() ([]*common.HistoricalSummary, error)) *BeaconState_GetHistoricalSummaries_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistoricalSummariesStartSlot provides a mock function with given fields:
+func (_m *BeaconState[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetHistoricalSummariesStartSlot() (math.U64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricalSummariesStartSlot")
+	}
+
+	var r0 math.U64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (math.U64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() math.U64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(math.U64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BeaconState_GetHistoricalSummariesStartSlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistoricalSummariesStartSlot'
+type BeaconState_GetHistoricalSummariesStartSlot_Call[BeaconBlockHeaderT any, Eth1DataT any, ExecutionPayloadHeaderT any, ForkT any, ValidatorT any, ValidatorsT any, WithdrawalT any] struct {
+	*mock.Call
+}
+
+// GetHistoricalSummariesStartSlot is a helper method to define mock.On call
+func (_e *BeaconState_Expecter[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetHistoricalSummariesStartSlot() *BeaconState_GetHistoricalSummariesStartSlot_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	return &BeaconState_GetHistoricalSummariesStartSlot_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]{Call: _e.mock.On("GetHistoricalSummariesStartSlot")}
+}
+
+func (_c *BeaconState_GetHistoricalSummariesStartSlot_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Run(run func()) *BeaconState_GetHistoricalSummariesStartSlot_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BeaconState_GetHistoricalSummariesStartSlot_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Return(_a0 math.U64, _a1 error) *BeaconState_GetHistoricalSummariesStartSlot_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BeaconState_GetHistoricalSummariesStartSlot_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) RunAndReturn(run func() (math.U64, error)) *BeaconState_GetHistoricalSummariesStartSlot_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistoricalSummaryAtIndex provides a mock function with given fields: _a0
+func (_m *BeaconState[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetHistoricalSummaryAtIndex(_a0 uint64) (*common.HistoricalSummary, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricalSummaryAtIndex")
+	}
+
+	var r0 *common.HistoricalSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*common.HistoricalSummary, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *common.HistoricalSummary); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.HistoricalSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BeaconState_GetHistoricalSummaryAtIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistoricalSummaryAtIndex'
+type BeaconState_GetHistoricalSummaryAtIndex_Call[BeaconBlockHeaderT any, Eth1DataT any, ExecutionPayloadHeaderT any, ForkT any, ValidatorT any, ValidatorsT any, WithdrawalT any] struct {
+	*mock.Call
+}
+
+// GetHistoricalSummaryAtIndex is a helper method to define mock.On call
+//   - _a0 uint64
+func (_e *BeaconState_Expecter[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetHistoricalSummaryAtIndex(_a0 interface{}) *BeaconState_GetHistoricalSummaryAtIndex_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	return &BeaconState_GetHistoricalSummaryAtIndex_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]{Call: _e.mock.On("GetHistoricalSummaryAtIndex", _a0)}
+}
+
+func (_c *BeaconState_GetHistoricalSummaryAtIndex_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Run(run func(_a0 uint64)) *BeaconState_GetHistoricalSummaryAtIndex_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *BeaconState_GetHistoricalSummaryAtIndex_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) Return(_a0 *common.HistoricalSummary, _a1 error) *BeaconState_GetHistoricalSummaryAtIndex_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BeaconState_GetHistoricalSummaryAtIndex_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) RunAndReturn(run func(uint64) (*common.HistoricalSummary, error)) *BeaconState_GetHistoricalSummaryAtIndex_Call[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestBlockHeader provides a mock function with given fields:
 func (_m *BeaconState[BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT, WithdrawalT]) GetLatestBlockHeader() (BeaconBlockHeaderT, error) {
 	ret := _m.Called()
@@ -3,7 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	common "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+
+	crypto "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+
 	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 
 	mock "github.com/stretchr/testify/mock"
@@ -22,6 +27,123 @@ func (_m *BlockStore[BeaconBlockT]) EXPECT() *BlockStore_Expecter[BeaconBlockT]
 	return &BlockStore_Expecter[BeaconBlockT]{mock: &_m.Mock}
 }
 
+// GetBlockBySlot provides a mock function with given fields: ctx, slot
+func (_m *BlockStore[BeaconBlockT]) GetBlockBySlot(ctx context.Context, slot math.U64) (BeaconBlockT, error) {
+	ret := _m.Called(ctx, slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockBySlot")
+	}
+
+	var r0 BeaconBlockT
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, math.U64) (BeaconBlockT, error)); ok {
+		return rf(ctx, slot)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, math.U64) BeaconBlockT); ok {
+		r0 = rf(ctx, slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(BeaconBlockT)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, math.U64) error); ok {
+		r1 = rf(ctx, slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetBlockBySlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockBySlot'
+type BlockStore_GetBlockBySlot_Call[BeaconBlockT any] struct {
+	*mock.Call
+}
+
+// GetBlockBySlot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slot math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetBlockBySlot(ctx interface{}, slot interface{}) *BlockStore_GetBlockBySlot_Call[BeaconBlockT] {
+	return &BlockStore_GetBlockBySlot_Call[BeaconBlockT]{Call: _e.mock.On("GetBlockBySlot", ctx, slot)}
+}
+
+func (_c *BlockStore_GetBlockBySlot_Call[BeaconBlockT]) Run(run func(ctx context.Context, slot math.U64)) *BlockStore_GetBlockBySlot_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetBlockBySlot_Call[BeaconBlockT]) Return(_a0 BeaconBlockT, _a1 error) *BlockStore_GetBlockBySlot_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetBlockBySlot_Call[BeaconBlockT]) RunAndReturn(run func(context.Context, math.U64) (BeaconBlockT, error)) *BlockStore_GetBlockBySlot_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParentBlockRootBySlot provides a mock function with given fields: slot
+func (_m *BlockStore[BeaconBlockT]) GetParentBlockRootBySlot(slot math.U64) (common.Root, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParentBlockRootBySlot")
+	}
+
+	var r0 common.Root
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (common.Root, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) common.Root); ok {
+		r0 = rf(slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Root)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetParentBlockRootBySlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParentBlockRootBySlot'
+type BlockStore_GetParentBlockRootBySlot_Call[BeaconBlockT any] struct {
+	*mock.Call
+}
+
+// GetParentBlockRootBySlot is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetParentBlockRootBySlot(slot interface{}) *BlockStore_GetParentBlockRootBySlot_Call[BeaconBlockT] {
+	return &BlockStore_GetParentBlockRootBySlot_Call[BeaconBlockT]{Call: _e.mock.On("GetParentBlockRootBySlot", slot)}
+}
+
+func (_c *BlockStore_GetParentBlockRootBySlot_Call[BeaconBlockT]) Run(run func(slot math.U64)) *BlockStore_GetParentBlockRootBySlot_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetParentBlockRootBySlot_Call[BeaconBlockT]) Return(_a0 common.Root, _a1 error) *BlockStore_GetParentBlockRootBySlot_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetParentBlockRootBySlot_Call[BeaconBlockT]) RunAndReturn(run func(math.U64) (common.Root, error)) *BlockStore_GetParentBlockRootBySlot_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetParentSlotByTimestamp provides a mock function with given fields: timestamp
 func (_m *BlockStore[BeaconBlockT]) GetParentSlotByTimestamp(timestamp math.U64) (math.U64, error) {
 	ret := _m.Called(timestamp)
@@ -78,6 +200,64 @@ func (_c *BlockStore_GetParentSlotByTimestamp_Call[BeaconBlockT]) RunAndReturn(r
 	return _c
 }
 
+// GetSignatureBySlot provides a mock function with given fields: slot
+func (_m *BlockStore[BeaconBlockT]) GetSignatureBySlot(slot math.U64) (crypto.BLSSignature, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSignatureBySlot")
+	}
+
+	var r0 crypto.BLSSignature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (crypto.BLSSignature, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) crypto.BLSSignature); ok {
+		r0 = rf(slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(crypto.BLSSignature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetSignatureBySlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSignatureBySlot'
+type BlockStore_GetSignatureBySlot_Call[BeaconBlockT any] struct {
+	*mock.Call
+}
+
+// GetSignatureBySlot is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetSignatureBySlot(slot interface{}) *BlockStore_GetSignatureBySlot_Call[BeaconBlockT] {
+	return &BlockStore_GetSignatureBySlot_Call[BeaconBlockT]{Call: _e.mock.On("GetSignatureBySlot", slot)}
+}
+
+func (_c *BlockStore_GetSignatureBySlot_Call[BeaconBlockT]) Run(run func(slot math.U64)) *BlockStore_GetSignatureBySlot_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetSignatureBySlot_Call[BeaconBlockT]) Return(_a0 crypto.BLSSignature, _a1 error) *BlockStore_GetSignatureBySlot_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetSignatureBySlot_Call[BeaconBlockT]) RunAndReturn(run func(math.U64) (crypto.BLSSignature, error)) *BlockStore_GetSignatureBySlot_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSlotByBlockRoot provides a mock function with given fields: root
 func (_m *BlockStore[BeaconBlockT]) GetSlotByBlockRoot(root common.Root) (math.U64, error) {
 	ret := _m.Called(root)
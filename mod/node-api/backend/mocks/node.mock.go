@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	transition "github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Node is an autogenerated mock type for the Node type
 type Node[ContextT any] struct {
@@ -17,6 +20,64 @@ func (_m *Node[ContextT]) EXPECT() *Node_Expecter[ContextT] {
 	return &Node_Expecter[ContextT]{mock: &_m.Mock}
 }
 
+// AppHash provides a mock function with given fields: height
+func (_m *Node[ContextT]) AppHash(height int64) ([]byte, error) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppHash")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]byte, error)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []byte); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Node_AppHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppHash'
+type Node_AppHash_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// AppHash is a helper method to define mock.On call
+//   - height int64
+func (_e *Node_Expecter[ContextT]) AppHash(height interface{}) *Node_AppHash_Call[ContextT] {
+	return &Node_AppHash_Call[ContextT]{Call: _e.mock.On("AppHash", height)}
+}
+
+func (_c *Node_AppHash_Call[ContextT]) Run(run func(height int64)) *Node_AppHash_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *Node_AppHash_Call[ContextT]) Return(_a0 []byte, _a1 error) *Node_AppHash_Call[ContextT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Node_AppHash_Call[ContextT]) RunAndReturn(run func(int64) ([]byte, error)) *Node_AppHash_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateQueryContext provides a mock function with given fields: height, prove
 func (_m *Node[ContextT]) CreateQueryContext(height int64, prove bool) (ContextT, error) {
 	ret := _m.Called(height, prove)
@@ -74,6 +135,239 @@ func (_c *Node_CreateQueryContext_Call[ContextT]) RunAndReturn(run func(int64, b
 	return _c
 }
 
+// LightBlock provides a mock function with given fields: height
+func (_m *Node[ContextT]) LightBlock(height int64) (*transition.LightBlock, error) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LightBlock")
+	}
+
+	var r0 *transition.LightBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (*transition.LightBlock, error)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) *transition.LightBlock); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transition.LightBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Node_LightBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LightBlock'
+type Node_LightBlock_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// LightBlock is a helper method to define mock.On call
+//   - height int64
+func (_e *Node_Expecter[ContextT]) LightBlock(height interface{}) *Node_LightBlock_Call[ContextT] {
+	return &Node_LightBlock_Call[ContextT]{Call: _e.mock.On("LightBlock", height)}
+}
+
+func (_c *Node_LightBlock_Call[ContextT]) Run(run func(height int64)) *Node_LightBlock_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *Node_LightBlock_Call[ContextT]) Return(_a0 *transition.LightBlock, _a1 error) *Node_LightBlock_Call[ContextT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Node_LightBlock_Call[ContextT]) RunAndReturn(run func(int64) (*transition.LightBlock, error)) *Node_LightBlock_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProposerCandidates provides a mock function with given fields: height
+func (_m *Node[ContextT]) ProposerCandidates(height int64) ([]*transition.ProposerCandidate, error) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProposerCandidates")
+	}
+
+	var r0 []*transition.ProposerCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]*transition.ProposerCandidate, error)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []*transition.ProposerCandidate); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*transition.ProposerCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Node_ProposerCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProposerCandidates'
+type Node_ProposerCandidates_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// ProposerCandidates is a helper method to define mock.On call
+//   - height int64
+func (_e *Node_Expecter[ContextT]) ProposerCandidates(height interface{}) *Node_ProposerCandidates_Call[ContextT] {
+	return &Node_ProposerCandidates_Call[ContextT]{Call: _e.mock.On("ProposerCandidates", height)}
+}
+
+func (_c *Node_ProposerCandidates_Call[ContextT]) Run(run func(height int64)) *Node_ProposerCandidates_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *Node_ProposerCandidates_Call[ContextT]) Return(_a0 []*transition.ProposerCandidate, _a1 error) *Node_ProposerCandidates_Call[ContextT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Node_ProposerCandidates_Call[ContextT]) RunAndReturn(run func(int64) ([]*transition.ProposerCandidate, error)) *Node_ProposerCandidates_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProposerSchedule provides a mock function with given fields: height
+func (_m *Node[ContextT]) ProposerSchedule(height int64) (transition.ProposerSchedule, error) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProposerSchedule")
+	}
+
+	var r0 transition.ProposerSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (transition.ProposerSchedule, error)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) transition.ProposerSchedule); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(transition.ProposerSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Node_ProposerSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProposerSchedule'
+type Node_ProposerSchedule_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// ProposerSchedule is a helper method to define mock.On call
+//   - height int64
+func (_e *Node_Expecter[ContextT]) ProposerSchedule(height interface{}) *Node_ProposerSchedule_Call[ContextT] {
+	return &Node_ProposerSchedule_Call[ContextT]{Call: _e.mock.On("ProposerSchedule", height)}
+}
+
+func (_c *Node_ProposerSchedule_Call[ContextT]) Run(run func(height int64)) *Node_ProposerSchedule_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *Node_ProposerSchedule_Call[ContextT]) Return(_a0 transition.ProposerSchedule, _a1 error) *Node_ProposerSchedule_Call[ContextT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Node_ProposerSchedule_Call[ContextT]) RunAndReturn(run func(int64) (transition.ProposerSchedule, error)) *Node_ProposerSchedule_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProveBlockRoot provides a mock function with given fields: height, index
+func (_m *Node[ContextT]) ProveBlockRoot(height int64, index uint64) (*transition.StoreProof, error) {
+	ret := _m.Called(height, index)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProveBlockRoot")
+	}
+
+	var r0 *transition.StoreProof
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, uint64) (*transition.StoreProof, error)); ok {
+		return rf(height, index)
+	}
+	if rf, ok := ret.Get(0).(func(int64, uint64) *transition.StoreProof); ok {
+		r0 = rf(height, index)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transition.StoreProof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, uint64) error); ok {
+		r1 = rf(height, index)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Node_ProveBlockRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProveBlockRoot'
+type Node_ProveBlockRoot_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// ProveBlockRoot is a helper method to define mock.On call
+//   - height int64
+//   - index uint64
+func (_e *Node_Expecter[ContextT]) ProveBlockRoot(height interface{}, index interface{}) *Node_ProveBlockRoot_Call[ContextT] {
+	return &Node_ProveBlockRoot_Call[ContextT]{Call: _e.mock.On("ProveBlockRoot", height, index)}
+}
+
+func (_c *Node_ProveBlockRoot_Call[ContextT]) Run(run func(height int64, index uint64)) *Node_ProveBlockRoot_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *Node_ProveBlockRoot_Call[ContextT]) Return(_a0 *transition.StoreProof, _a1 error) *Node_ProveBlockRoot_Call[ContextT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Node_ProveBlockRoot_Call[ContextT]) RunAndReturn(run func(int64, uint64) (*transition.StoreProof, error)) *Node_ProveBlockRoot_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNode creates a new instance of Node. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNode[ContextT any](t interface {
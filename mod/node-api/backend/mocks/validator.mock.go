@@ -4,6 +4,8 @@ package mocks
 
 import (
 	backend "github.com/berachain/beacon-kit/mod/node-api/backend"
+	bytes "github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+
 	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 
 	mock "github.com/stretchr/testify/mock"
@@ -22,6 +24,96 @@ func (_m *Validator[WithdrawalCredentialsT]) EXPECT() *Validator_Expecter[Withdr
 	return &Validator_Expecter[WithdrawalCredentialsT]{mock: &_m.Mock}
 }
 
+// GetEffectiveBalance provides a mock function with given fields:
+func (_m *Validator[WithdrawalCredentialsT]) GetEffectiveBalance() math.U64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEffectiveBalance")
+	}
+
+	var r0 math.U64
+	if rf, ok := ret.Get(0).(func() math.U64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(math.U64)
+	}
+
+	return r0
+}
+
+// Validator_GetEffectiveBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEffectiveBalance'
+type Validator_GetEffectiveBalance_Call[WithdrawalCredentialsT backend.WithdrawalCredentials] struct {
+	*mock.Call
+}
+
+// GetEffectiveBalance is a helper method to define mock.On call
+func (_e *Validator_Expecter[WithdrawalCredentialsT]) GetEffectiveBalance() *Validator_GetEffectiveBalance_Call[WithdrawalCredentialsT] {
+	return &Validator_GetEffectiveBalance_Call[WithdrawalCredentialsT]{Call: _e.mock.On("GetEffectiveBalance")}
+}
+
+func (_c *Validator_GetEffectiveBalance_Call[WithdrawalCredentialsT]) Run(run func()) *Validator_GetEffectiveBalance_Call[WithdrawalCredentialsT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Validator_GetEffectiveBalance_Call[WithdrawalCredentialsT]) Return(_a0 math.U64) *Validator_GetEffectiveBalance_Call[WithdrawalCredentialsT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Validator_GetEffectiveBalance_Call[WithdrawalCredentialsT]) RunAndReturn(run func() math.U64) *Validator_GetEffectiveBalance_Call[WithdrawalCredentialsT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPubkey provides a mock function with given fields:
+func (_m *Validator[WithdrawalCredentialsT]) GetPubkey() bytes.B48 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPubkey")
+	}
+
+	var r0 bytes.B48
+	if rf, ok := ret.Get(0).(func() bytes.B48); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bytes.B48)
+	}
+
+	return r0
+}
+
+// Validator_GetPubkey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPubkey'
+type Validator_GetPubkey_Call[WithdrawalCredentialsT backend.WithdrawalCredentials] struct {
+	*mock.Call
+}
+
+// GetPubkey is a helper method to define mock.On call
+func (_e *Validator_Expecter[WithdrawalCredentialsT]) GetPubkey() *Validator_GetPubkey_Call[WithdrawalCredentialsT] {
+	return &Validator_GetPubkey_Call[WithdrawalCredentialsT]{Call: _e.mock.On("GetPubkey")}
+}
+
+func (_c *Validator_GetPubkey_Call[WithdrawalCredentialsT]) Run(run func()) *Validator_GetPubkey_Call[WithdrawalCredentialsT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Validator_GetPubkey_Call[WithdrawalCredentialsT]) Return(_a0 bytes.B48) *Validator_GetPubkey_Call[WithdrawalCredentialsT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Validator_GetPubkey_Call[WithdrawalCredentialsT]) RunAndReturn(run func() bytes.B48) *Validator_GetPubkey_Call[WithdrawalCredentialsT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWithdrawalCredentials provides a mock function with given fields:
 func (_m *Validator[WithdrawalCredentialsT]) GetWithdrawalCredentials() WithdrawalCredentialsT {
 	ret := _m.Called()
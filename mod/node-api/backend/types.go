@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
@@ -42,6 +43,9 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// GetBlobSidecars returns the sidecars stored for the block at the given
+	// slot.
+	GetBlobSidecars(math.Slot) (BlobSidecarsT, error)
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -76,6 +80,21 @@ type BeaconState[
 	]
 }
 
+// BlobArchive is the interface for the long-term archive of the sidecars that
+// were pruned from the availability store.
+type BlobArchive[BlobSidecarsT any] interface {
+	// GetBlobSidecars returns the archived sidecars of the block at the given
+	// slot.
+	GetBlobSidecars(context.Context, math.Slot) (BlobSidecarsT, error)
+}
+
+// BlobSidecars is the interface for the sidecars of a block.
+type BlobSidecars[BlobSidecarsT any] interface {
+	// Filter returns the sidecars with the given indices, or all of them if
+	// no index is given.
+	Filter(indices []uint64) BlobSidecarsT
+}
+
 // BlockStore is the interface for block storage.
 type BlockStore[BeaconBlockT any] interface {
 	// GetSlotByBlockRoot retrieves the slot by a given block root.
@@ -84,6 +103,15 @@ type BlockStore[BeaconBlockT any] interface {
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	// GetParentSlotByTimestamp retrieves the parent slot by a given timestamp.
 	GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+	// GetSignatureBySlot retrieves the proposer signature of the block at
+	// the given slot.
+	GetSignatureBySlot(slot math.Slot) (crypto.BLSSignature, error)
+	// GetParentBlockRootBySlot retrieves the parent root of the block at the
+	// given slot.
+	GetParentBlockRootBySlot(slot math.Slot) (common.Root, error)
+	// GetBlockBySlot retrieves the full block at the given slot, rebuilding
+	// its execution payload from the execution client.
+	GetBlockBySlot(ctx context.Context, slot math.Slot) (BeaconBlockT, error)
 }
 
 // DepositStore defines the interface for deposit storage.
@@ -96,11 +124,36 @@ type DepositStore[DepositT any] interface {
 	EnqueueDeposits(deposits []DepositT) error
 }
 
+// FeeRecipients is the interface for the fee recipients of the local
+// proposers.
+type FeeRecipients interface {
+	// SetFeeRecipients maps each of the given validator indices to its fee
+	// recipient.
+	SetFeeRecipients(
+		recipients map[math.ValidatorIndex]common.ExecutionAddress,
+	) error
+}
+
 // Node is the interface for a node.
 type Node[ContextT any] interface {
+	// AppHash returns the app hash committed to by executing the block at
+	// the given height.
+	AppHash(height int64) ([]byte, error)
 	// CreateQueryContext creates a query context for a given height and proof
 	// flag.
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
+	// ProposerCandidates returns the CometBFT validator set at the given
+	// height together with the proposer priorities of its validators.
+	ProposerCandidates(height int64) ([]*transition.ProposerCandidate, error)
+	// ProposerSchedule returns a schedule predicting the proposers of the
+	// heights after the given one.
+	ProposerSchedule(height int64) (transition.ProposerSchedule, error)
+	// LightBlock returns the CometBFT header at the given height with the
+	// commit that signed it and the validator set that signed the commit.
+	LightBlock(height int64) (*transition.LightBlock, error)
+	// ProveBlockRoot returns the block root at the given index of the beacon
+	// state at the given height, with its proof against the app hash.
+	ProveBlockRoot(height int64, index uint64) (*transition.StoreProof, error)
 }
 
 type StateProcessor[BeaconStateT any] interface {
@@ -121,6 +174,10 @@ type StorageBackend[
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
 type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+	// GetEffectiveBalance returns the effective balance of the validator.
+	GetEffectiveBalance() math.Gwei
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
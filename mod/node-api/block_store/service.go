@@ -43,6 +43,8 @@ type Service[
 	store BlockStoreT
 	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
 	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+	// stopped is closed once the event loop has exited.
+	stopped chan struct{}
 }
 
 // NewService creates a new block service.
@@ -61,6 +63,7 @@ func NewService[
 		dispatcher:            dispatcher,
 		store:                 store,
 		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+		stopped:               make(chan struct{}),
 	}
 }
 
@@ -74,6 +77,7 @@ func (s *Service[_, _]) Name() string {
 func (s *Service[_, _]) Start(ctx context.Context) error {
 	if !s.config.Enabled {
 		s.logger.Warn("block service is disabled, skipping storing blocks")
+		close(s.stopped)
 		return nil
 	}
 
@@ -90,13 +94,28 @@ func (s *Service[_, _]) Start(ctx context.Context) error {
 	return nil
 }
 
+// Stop waits for the event loop to exit, which happens once the context passed
+// to Start is done and the in-flight block, if any, has been stored.
+func (s *Service[_, _]) Stop(ctx context.Context) error {
+	select {
+	case <-s.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // eventLoop is the main event loop for the block service.
 func (s *Service[_, _]) eventLoop(ctx context.Context) {
+	defer close(s.stopped)
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subFinalizedBlkEvents:
+		case event, ok := <-s.subFinalizedBlkEvents:
+			if !ok {
+				return
+			}
 			s.onFinalizeBlock(event)
 		}
 	}
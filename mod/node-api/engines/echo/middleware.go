@@ -42,11 +42,27 @@ func responseMiddleware(
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
+		if stream, ok := data.(*types.StreamResponse); ok && err == nil {
+			return streamResponse(c, stream)
+		}
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
 }
 
+// streamResponse writes the body of a stream response directly to the
+// client. Once the headers have been flushed, errors can no longer be mapped
+// to a status code and are only returned to echo for logging.
+func streamResponse(c Context, stream *types.StreamResponse) error {
+	header := c.Response().Header()
+	for key, value := range stream.Headers {
+		header.Set(key, value)
+	}
+	header.Set(echo.HeaderContentType, stream.ContentType)
+	c.Response().WriteHeader(http.StatusOK)
+	return stream.Write(c.Response())
+}
+
 // responseFromErr converts an error to an HTTP status code and response. If
 // the error is nil, the response is returned as is.
 func responseFromError(data any, err error) (int, any) {
@@ -63,6 +79,11 @@ func responseFromError(data any, err error) (int, any) {
 			Code:    http.StatusBadRequest,
 			Message: err.Error(),
 		}
+	case errors.Is(err, types.ErrServiceUnavailable):
+		return http.StatusServiceUnavailable, ErrorResponse{
+			Code:    http.StatusServiceUnavailable,
+			Message: err.Error(),
+		}
 	case errors.Is(err, types.ErrNotImplemented):
 		return http.StatusNotImplemented, ErrorResponse{
 			Code:    http.StatusNotImplemented,
@@ -21,8 +21,11 @@
 package beacon
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -30,10 +33,13 @@ import (
 type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
 	GenesisBackend
 	BlockBackend[BlockHeaderT]
+	BlobBackend
 	RandaoBackend
 	StateBackend[ForkT]
+	CommitteeBackend
 	ValidatorBackend[ValidatorT]
 	HistoricalBackend[ForkT]
+	LightClientBackend[BlockHeaderT]
 	// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given root from the store.
@@ -49,6 +55,31 @@ type HistoricalBackend[ForkT any] interface {
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
 }
 
+type LightClientBackend[BeaconBlockHeaderT any] interface {
+	LightClientBootstrap(
+		slot math.Slot,
+	) (*types.LightClientResponse[BeaconBlockHeaderT], error)
+	LightClientUpdates(
+		startPeriod, count uint64,
+	) ([]*types.LightClientResponse[BeaconBlockHeaderT], error)
+	LightClientLatestUpdate() (
+		*types.LightClientResponse[BeaconBlockHeaderT], error,
+	)
+}
+
+type CommitteeBackend interface {
+	FinalityCheckpointsAtSlot(
+		slot math.Slot,
+	) (*types.FinalityCheckpointsData, error)
+	CommitteesAtSlot(
+		slot math.Slot, epoch math.Epoch,
+	) ([]*types.CommitteeData, error)
+	SyncCommitteesAtSlot(
+		slot math.Slot, epoch math.Epoch,
+	) (*types.SyncCommitteeData, error)
+	ValidatorSetAtSlot(slot math.Slot) (*types.ValidatorSetData, error)
+}
+
 type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
@@ -57,6 +88,16 @@ type BlockBackend[BeaconBlockHeaderT any] interface {
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+	BlockSignatureAtSlot(slot math.Slot) (crypto.BLSSignature, error)
+	BlockAtSlot(
+		ctx context.Context, slot math.Slot,
+	) (*types.BlockResponse, error)
+}
+
+type BlobBackend interface {
+	BlobSidecarsAtSlot(
+		ctx context.Context, slot math.Slot, indices []uint64,
+	) (*types.ValidatorResponse, error)
 }
 
 type StateBackend[ForkT any] interface {
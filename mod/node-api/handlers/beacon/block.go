@@ -25,6 +25,22 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
 )
 
+// GetBlock returns the signed block for the given block ID, with its
+// execution payload rebuilt from the execution client.
+func (h *Handler[_, ContextT, _, _]) GetBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	return h.backend.BlockAtSlot(c.Request().Context(), slot)
+}
+
 func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockRewardsRequest](
 		c, h.Logger(),
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"strconv"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetFinalityCheckpoints returns the finality checkpoints of a state. Every
+// committed block is final in CometBFT.
+func (h *Handler[_, ContextT, _, _]) GetFinalityCheckpoints(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetFinalityCheckpointsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	checkpoints, err := h.backend.FinalityCheckpointsAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false,
+		Finalized:           true,
+		Data:                checkpoints,
+	}, nil
+}
+
+// GetStateCommittees returns the committees of an epoch, optionally filtered
+// by committee index and slot.
+func (h *Handler[_, ContextT, _, _]) GetStateCommittees(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetStateCommitteesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := optionalU64(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	committees, err := h.backend.CommitteesAtSlot(slot, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*beacontypes.CommitteeData, 0, len(committees))
+	for _, committee := range committees {
+		if req.CommitteeIndex != "" &&
+			req.CommitteeIndex != formatU64(committee.Index) {
+			continue
+		}
+		if req.Slot != "" && req.Slot != formatU64(committee.Slot) {
+			continue
+		}
+		filtered = append(filtered, committee)
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false,
+		Finalized:           true,
+		Data:                filtered,
+	}, nil
+}
+
+// GetSyncCommittees returns the sync committee of an epoch.
+func (h *Handler[_, ContextT, _, _]) GetSyncCommittees(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetSyncCommitteesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := optionalU64(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	committee, err := h.backend.SyncCommitteesAtSlot(slot, epoch)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false,
+		Finalized:           true,
+		Data:                committee,
+	}, nil
+}
+
+// GetValidatorSet returns the native CometBFT validator set signing the
+// block of a state, with the proposer priorities of its validators and the
+// app hash committed to by executing the block.
+func (h *Handler[_, ContextT, _, _]) GetValidatorSet(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetValidatorSetRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	validatorSet, err := h.backend.ValidatorSetAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false,
+		Finalized:           true,
+		Data:                validatorSet,
+	}, nil
+}
+
+// optionalU64 parses an optional decimal query parameter, which is 0 when
+// not set.
+func optionalU64(value string) (math.U64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return utils.U64FromString(value)
+}
+
+// formatU64 formats a uint64 the way query parameters carry it.
+func formatU64(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
@@ -23,7 +23,6 @@ package beacon
 import (
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 )
 
 func (h *Handler[
@@ -43,6 +42,10 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
+	signature, err := h.backend.BlockSignatureAtSlot(header.GetSlot())
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
@@ -51,7 +54,7 @@ func (h *Handler[
 			Canonical: true,
 			Header: &beacontypes.BlockHeader[BeaconBlockHeaderT]{
 				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
+				Signature: signature,
 			},
 		},
 	}, nil
@@ -74,6 +77,10 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
+	signature, err := h.backend.BlockSignatureAtSlot(header.GetSlot())
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
@@ -82,7 +89,7 @@ func (h *Handler[
 			Canonical: true,
 			Header: &beacontypes.BlockHeader[BeaconBlockHeaderT]{
 				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
+				Signature: signature,
 			},
 		},
 	}, nil
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// maxLightClientUpdates is the maximum number of updates served per request,
+// as in the consensus specs.
+const maxLightClientUpdates = 128
+
+// GetLightClientBootstrap returns the light client data of the block with the
+// given root, for light clients to start following the chain from.
+func (h *Handler[_, ContextT, _, _]) GetLightClientBootstrap(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetLightClientBootstrapRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var root common.Root
+	if err = root.UnmarshalText([]byte(req.BlockRoot)); err != nil {
+		return nil, errors.Wrapf(
+			types.ErrInvalidRequest, "invalid block root: %s", err,
+		)
+	}
+	slot, err := h.backend.GetSlotByBlockRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	return h.backend.LightClientBootstrap(slot)
+}
+
+// GetLightClientUpdates returns the light client data of the last block of
+// each of the requested periods.
+func (h *Handler[_, ContextT, _, _]) GetLightClientUpdates(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetLightClientUpdatesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	startPeriod, err := utils.U64FromString(req.StartPeriod)
+	if err != nil {
+		return nil, err
+	}
+	count, err := utils.U64FromString(req.Count)
+	if err != nil {
+		return nil, err
+	}
+	return h.backend.LightClientUpdates(
+		startPeriod.Unwrap(), min(count.Unwrap(), maxLightClientUpdates),
+	)
+}
+
+// GetLightClientFinalityUpdate returns the light client data of the latest
+// verifiable block.
+func (h *Handler[_, ContextT, _, _]) GetLightClientFinalityUpdate(
+	ContextT,
+) (any, error) {
+	return h.backend.LightClientLatestUpdate()
+}
+
+// GetLightClientOptimisticUpdate returns the light client data of the latest
+// verifiable block. Blocks are final as soon as they are committed, so it is
+// the same as the finality update.
+func (h *Handler[_, ContextT, _, _]) GetLightClientOptimisticUpdate(
+	ContextT,
+) (any, error) {
+	return h.backend.LightClientLatestUpdate()
+}
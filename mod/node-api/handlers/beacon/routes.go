@@ -51,7 +51,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/finality_checkpoints",
-			Handler: h.NotImplemented,
+			Handler: h.GetFinalityCheckpoints,
 		},
 		{
 			Method:  http.MethodGet,
@@ -81,12 +81,12 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/committees",
-			Handler: h.NotImplemented,
+			Handler: h.GetStateCommittees,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/sync_committees",
-			Handler: h.NotImplemented,
+			Handler: h.GetSyncCommittees,
 		},
 		{
 			Method:  http.MethodGet,
@@ -126,7 +126,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "eth/v2/beacon/blocks/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlock,
 		},
 		{
 			Method:  http.MethodGet,
@@ -141,7 +141,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blob_sidecars/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlobSidecars,
 		},
 		{
 			Method:  http.MethodPost,
@@ -166,22 +166,22 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/bootstrap/:block_root",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientBootstrap,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/updates",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientUpdates,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/finality_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientFinalityUpdate,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/optimistic_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientOptimisticUpdate,
 		},
 		{
 			Method:  http.MethodGet,
@@ -238,5 +238,10 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Path:    "/eth/v1/beacon/pool/bls_to_execution_changes",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/beacon/states/:state_id/validator_set",
+			Handler: h.GetValidatorSet,
+		},
 	})
 }
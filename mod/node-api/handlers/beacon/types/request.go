@@ -75,6 +75,10 @@ type GetSyncCommitteesRequest struct {
 	EpochOptionalRequest
 }
 
+type GetValidatorSetRequest struct {
+	types.StateIDRequest
+}
+
 type GetRandaoRequest struct {
 	types.StateIDRequest
 	EpochOptionalRequest
@@ -160,7 +164,7 @@ type EpochRequest struct {
 }
 
 type CommitteeIndexRequest struct {
-	CommitteeIndex string `query:"committee_index" validate:"committee_index"`
+	CommitteeIndex string `query:"index" validate:"committee_index"`
 }
 
 type SlotRequest struct {
@@ -176,3 +180,12 @@ type BlobSidecarRequest struct {
 	types.BlockIDRequest
 	Indices []string `query:"indices" validate:"dive,uint64"`
 }
+
+type GetLightClientBootstrapRequest struct {
+	BlockRoot string `param:"block_root" validate:"required"`
+}
+
+type GetLightClientUpdatesRequest struct {
+	StartPeriod string `query:"start_period" validate:"required,numeric"`
+	Count       string `query:"count"        validate:"required,numeric"`
+}
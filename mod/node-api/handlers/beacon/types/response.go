@@ -23,6 +23,8 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 )
 
 type ValidatorResponse struct {
@@ -36,6 +38,12 @@ type BlockResponse struct {
 	ValidatorResponse
 }
 
+// SignedBlock is a beacon block along with its proposer signature.
+type SignedBlock[BeaconBlockT any] struct {
+	Message   BeaconBlockT        `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
 type BlockHeaderResponse[BlockHeaderT any] struct {
 	Root      common.Root                `json:"root"`
 	Canonical bool                       `json:"canonical"`
@@ -43,8 +51,8 @@ type BlockHeaderResponse[BlockHeaderT any] struct {
 }
 
 type BlockHeader[BlockHeaderT any] struct {
-	Message   BlockHeaderT `json:"message"`
-	Signature bytes.B48    `json:"signature"`
+	Message   BlockHeaderT        `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
 }
 
 type GenesisData struct {
@@ -68,11 +76,53 @@ type ValidatorBalanceData struct {
 	Balance uint64 `json:"balance,string"`
 }
 
-//nolint:staticcheck // todo: figure this out.
+// FinalityCheckpointsData holds the checkpoints of a state. CometBFT
+// finalizes every block it commits, so the current justified and finalized
+// checkpoints are those of the epoch of the state.
+type FinalityCheckpointsData struct {
+	PreviousJustified *Checkpoint `json:"previous_justified"`
+	CurrentJustified  *Checkpoint `json:"current_justified"`
+	Finalized         *Checkpoint `json:"finalized"`
+}
+
+// Checkpoint is the root of the latest committed block of an epoch.
+type Checkpoint struct {
+	Epoch uint64      `json:"epoch,string"`
+	Root  common.Root `json:"root"`
+}
+
+// CommitteeData is the committee of a slot, which is the whole CometBFT
+// validator set, as every validator votes on every block.
 type CommitteeData struct {
 	Index      uint64   `json:"index,string"`
 	Slot       uint64   `json:"slot,string"`
-	Validators []uint64 `json:"validators,string"`
+	Validators []string `json:"validators"`
+	// VotingPowers holds the CometBFT voting power of each validator.
+	VotingPowers []string `json:"voting_powers"`
+}
+
+// SyncCommitteeData is the sync committee of an epoch, which is the whole
+// CometBFT validator set in a single aggregate.
+type SyncCommitteeData struct {
+	Validators          []string   `json:"validators"`
+	ValidatorAggregates [][]string `json:"validator_aggregates"`
+}
+
+// ValidatorSetData is the CometBFT validator set signing the block at a
+// height, with the app hash committed to by executing that block.
+type ValidatorSetData struct {
+	Height           uint64                   `json:"height,string"`
+	AppHash          bytes.Bytes              `json:"app_hash"`
+	TotalVotingPower int64                    `json:"total_voting_power,string"`
+	Validators       []*CometBFTValidatorData `json:"validators"`
+}
+
+// CometBFTValidatorData is a member of the CometBFT validator set.
+type CometBFTValidatorData struct {
+	Index            uint64           `json:"index,string"`
+	Pubkey           crypto.BLSPubkey `json:"pubkey"`
+	VotingPower      int64            `json:"voting_power,string"`
+	ProposerPriority int64            `json:"proposer_priority,string"`
 }
 
 type BlockRewardsData struct {
@@ -83,3 +133,50 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+// LightClientResponse is a light client bootstrap or update of the given
+// fork.
+type LightClientResponse[BlockHeaderT any] struct {
+	Version string                         `json:"version"`
+	Data    *LightClientData[BlockHeaderT] `json:"data"`
+}
+
+// LightClientData makes a beacon block header verifiable without trusting the
+// node serving it. The root of the header is proven against the app hash of
+// the next height, which is part of a CometBFT header signed by the validator
+// set through its commit. Light clients follow the validator set with
+// CometBFT's light client verification.
+type LightClientData[BlockHeaderT any] struct {
+	// Header is the beacon block header.
+	Header *LightClientHeader[BlockHeaderT] `json:"header"`
+	// HeaderProof proves the root of the header to be in the block roots
+	// of the beacon state committed to by the app hash.
+	HeaderProof *StoreProofData `json:"header_proof"`
+	// SignedHeader is the CometBFT header carrying the app hash, with the
+	// commit that signed it, as encoded by the CometBFT RPC.
+	SignedHeader json.RawMessage `json:"signed_header"`
+	// ValidatorSet is the CometBFT validator set that signed the commit, as
+	// encoded by the CometBFT RPC.
+	ValidatorSet json.RawMessage `json:"validator_set"`
+}
+
+// LightClientHeader is the header of a light client bootstrap or update.
+type LightClientHeader[BlockHeaderT any] struct {
+	Beacon BlockHeaderT `json:"beacon"`
+}
+
+// StoreProofData is a key/value pair of the beacon store with its ICS-23
+// proof against the app hash of a height.
+type StoreProofData struct {
+	Height   int64          `json:"height,string"`
+	Key      bytes.Bytes    `json:"key"`
+	Value    bytes.Bytes    `json:"value"`
+	ProofOps []*ProofOpData `json:"proof_ops"`
+}
+
+// ProofOpData is a single ICS-23 proof operation.
+type ProofOpData struct {
+	Type string      `json:"type"`
+	Key  bytes.Bytes `json:"key"`
+	Data bytes.Bytes `json:"data"`
+}
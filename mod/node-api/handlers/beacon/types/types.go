@@ -20,9 +20,13 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
 
 // BeaconBlockHeader is the interface for the beacon block header.
 type BeaconBlockHeader interface {
+	GetSlot() math.Slot
 	GetBodyRoot() common.Root
 }
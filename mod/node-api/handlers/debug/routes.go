@@ -27,7 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 )
 
-func (h *Handler[ContextT]) RegisterRoutes(
+func (h *Handler[_, _, ContextT]) RegisterRoutes(
 	logger log.Logger,
 ) {
 	h.SetLogger(logger)
@@ -35,12 +35,17 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v2/debug/beacon/states/:state_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetState,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v2/debug/beacon/states/heads",
-			Handler: h.NotImplemented,
+			Handler: h.GetHeads,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/eth/v2/debug/beacon/heads",
+			Handler: h.GetHeads,
 		},
 		{
 			Method:  http.MethodGet,
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	debugtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+const (
+	// mimeOctetStream is the content type used for SSZ encoded responses.
+	mimeOctetStream = "application/octet-stream"
+	// consensusVersionHeader carries the fork name of SSZ encoded responses,
+	// since unlike JSON responses they have no envelope to hold it.
+	consensusVersionHeader = "Eth-Consensus-Version"
+)
+
+// GetState returns the full beacon state for the given state ID. If the
+// client accepts application/octet-stream the state is streamed SSZ encoded,
+// otherwise it is returned as JSON.
+func (h *Handler[_, _, ContextT]) GetState(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[debugtypes.GetStateRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, slot, err := h.backend.StateFromSlotForProof(slot)
+	if err != nil {
+		return nil, err
+	}
+	marshallable, err := st.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+
+	forkName := version.Name(
+		h.backend.ChainSpec().ActiveForkVersionForSlot(slot),
+	)
+	if acceptsSSZ(c.Request()) {
+		return &types.StreamResponse{
+			ContentType: mimeOctetStream,
+			Headers:     map[string]string{consensusVersionHeader: forkName},
+			Write:       marshallable.MarshalSSZToWriter,
+		}, nil
+	}
+	return &debugtypes.StateResponse{
+		Version:             forkName,
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                marshallable,
+	}, nil
+}
+
+// GetHeads returns the heads of the fork choice. CometBFT provides single
+// slot finality, so there is only ever the one head at the latest slot.
+func (h *Handler[_, _, ContextT]) GetHeads(ContextT) (any, error) {
+	_, slot, err := h.backend.StateFromSlotForProof(utils.Head)
+	if err != nil {
+		return nil, err
+	}
+	root, err := h.backend.BlockRootAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap([]*debugtypes.HeadData{{
+		Root:                root,
+		Slot:                slot.Unwrap(),
+		ExecutionOptimistic: false,
+	}}), nil
+}
+
+// acceptsSSZ returns true if the request lists application/octet-stream in
+// its Accept header.
+func acceptsSSZ(req *http.Request) bool {
+	if req == nil {
+		return false
+	}
+	for _, accept := range strings.Split(req.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err == nil && mediaType == mimeOctetStream {
+			return true
+		}
+	}
+	return false
+}
@@ -18,14 +18,10 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package interfaces
+package types
 
-// DB is the interface for a simple key-value store.
-type DB interface {
-	Get(key []byte) ([]byte, error)
-	Has(key []byte) (bool, error)
-	Set(key []byte, value []byte) error
-	Delete(key []byte) error
+import "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 
-	// TODO: add Batch and full DB stuff.
+type GetStateRequest struct {
+	types.StateIDRequest
 }
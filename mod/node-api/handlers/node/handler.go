@@ -25,8 +25,15 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
+// HealthReporter reports the aggregated health of the node's services.
+type HealthReporter interface {
+	// Statuses returns the health of each service, keyed by service name.
+	Statuses() map[string]error
+}
+
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	health HealthReporter
 }
 
 func NewHandler[ContextT context.Context]() *Handler[ContextT] {
@@ -37,3 +44,9 @@ func NewHandler[ContextT context.Context]() *Handler[ContextT] {
 	}
 	return h
 }
+
+// AttachHealthReporter sets the source of the service health reported by
+// the node health endpoint.
+func (h *Handler[ContextT]) AttachHealthReporter(health HealthReporter) {
+	h.health = health
+}
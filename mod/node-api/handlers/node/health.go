@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
+
+// statusHealthy is reported for services without errors.
+const statusHealthy = "healthy"
+
+// Health returns the health of each of the node's services. It responds
+// with service unavailable if any service is unhealthy.
+func (h *Handler[ContextT]) Health(ContextT) (any, error) {
+	if h.health == nil {
+		return nil, errors.Wrap(
+			types.ErrServiceUnavailable, "health reporter not attached",
+		)
+	}
+
+	var (
+		unhealthy []string
+		statuses  = make(map[string]string)
+	)
+	for name, err := range h.health.Statuses() {
+		if err != nil {
+			statuses[name] = err.Error()
+			unhealthy = append(unhealthy, name)
+			continue
+		}
+		statuses[name] = statusHealthy
+	}
+	if len(unhealthy) > 0 {
+		sort.Strings(unhealthy)
+		return nil, errors.Wrapf(
+			types.ErrServiceUnavailable,
+			"unhealthy services: %s", strings.Join(unhealthy, ", "),
+		)
+	}
+	return types.Wrap(statuses), nil
+}
@@ -21,6 +21,7 @@
 package proof
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -37,4 +38,8 @@ type BlockBackend[BeaconBlockHeaderT any] interface {
 
 type StateBackend[BeaconStateT any] interface {
 	StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error)
+	// HistoricalBlockRootsAtSlot returns the block roots accumulated by the
+	// historical summary covering the given slot, along with the index of
+	// that historical summary.
+	HistoricalBlockRootsAtSlot(slot math.Slot) ([]common.Root, uint64, error)
 }
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetHistoricalBlockRoot returns the block root of the block at the requested
+// slot, which may be older than the block roots of the state, along with the
+// proof through the historical summaries that can be verified against the
+// beacon block root of the given timestamp id. The blocks of the period of
+// the requested slot must still be held by the block store.
+func (h *Handler[
+	BeaconBlockHeaderT, _, _, ContextT, _, _,
+]) GetHistoricalBlockRoot(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.HistoricalBlockRootRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	historicalSlot, err := utils.U64FromString(params.Slot)
+	if err != nil {
+		return nil, err
+	}
+	slot, beaconState, blockHeader, err := h.resolveTimestampID(
+		params.TimestampID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the historical block is already accumulated by a historical
+	// summary in the beacon state.
+	summaries, err := beaconState.GetHistoricalSummaries()
+	if err != nil {
+		return nil, err
+	}
+	blockRoots, summaryIndex, err := h.backend.HistoricalBlockRootsAtSlot(
+		historicalSlot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if summaryIndex >= uint64(len(summaries)) {
+		return nil, errors.Wrapf(
+			handlertypes.ErrNotFound,
+			"slot %d is not yet accumulated by a historical summary",
+			historicalSlot,
+		)
+	}
+
+	// Generate the proof (along with the "correct" beacon block root to
+	// verify against) for the historical block root.
+	h.Logger().Info(
+		"Generating historical block root proof",
+		"slot", slot, "historical_slot", historicalSlot,
+	)
+	rootIndex := historicalSlot.Unwrap() % uint64(len(blockRoots))
+	proof, gIndex, beaconBlockRoot, err := merkle.
+		ProveHistoricalBlockRootInBlock(
+			blockHeader, beaconState, summaryIndex, blockRoots, rootIndex,
+		)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.HistoricalBlockRootResponse[BeaconBlockHeaderT]{
+		BeaconBlockHeader:        blockHeader,
+		BeaconBlockRoot:          beaconBlockRoot,
+		Slot:                     historicalSlot,
+		HistoricalBlockRoot:      blockRoots[rootIndex],
+		HistoricalSummaryIndex:   math.U64(summaryIndex),
+		GeneralizedIndex:         gIndex,
+		HistoricalBlockRootProof: proof,
+	}, nil
+}
@@ -43,10 +43,15 @@ func ProveProposerPubkeyInBlock[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 ) ([]common.Root, common.Root, error) {
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
 	// Get the proof of the proposer pubkey in the beacon state.
 	proposerOffset := ValidatorPubkeyGIndexOffset * bbh.GetProposerIndex()
-	valPubkeyInStateProof, leaf, err := ProveProposerPubkeyInState(
-		bs, proposerOffset,
+	valPubkeyInStateProof, leaf, err := proveProposerPubkeyInState(
+		bsm, proposerOffset,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -63,7 +68,11 @@ func ProveProposerPubkeyInBlock[
 	//nolint:gocritic // ok.
 	combinedProof := append(valPubkeyInStateProof, stateInBlockProof...)
 	beaconRoot, err := verifyProposerInBlock(
-		bbh, proposerOffset, combinedProof, leaf,
+		bbh,
+		gIndicesForVersion(bsm.Version()).zeroValidatorPubkeyBlock,
+		proposerOffset,
+		combinedProof,
+		leaf,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -88,13 +97,23 @@ func ProveProposerPubkeyInState[
 	if err != nil {
 		return nil, common.Root{}, err
 	}
+	return proveProposerPubkeyInState(bsm, proposerOffset)
+}
+
+// proveProposerPubkeyInState generates a proof for the proposer pubkey in the
+// marshallable beacon state, at the generalized index of its fork version.
+func proveProposerPubkeyInState(
+	bsm types.BeaconStateMarshallable,
+	proposerOffset math.U64,
+) ([]common.Root, common.Root, error) {
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
 	//#nosec:G701 // max proposer offset is 8 * (2^40 - 1).
-	gIndex := ZeroValidatorPubkeyGIndexDenebState + int(proposerOffset)
+	gIndex := gIndicesForVersion(bsm.Version()).zeroValidatorPubkeyState +
+		int(proposerOffset)
 	valPubkeyInStateProof, err := stateProofTree.Prove(gIndex)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -113,13 +132,15 @@ func ProveProposerPubkeyInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyProposerInBlock(
 	bbh types.BeaconBlockHeader,
+	zeroValGIndex int,
 	valOffset math.U64,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		merkle.GeneralizedIndex(ZeroValidatorPubkeyGIndexDenebBlock+valOffset),
+		//#nosec:G701 // max proposer offset is 8 * (2^40 - 1).
+		merkle.GeneralizedIndex(zeroValGIndex+int(valOffset)),
 		leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -37,6 +38,7 @@ import (
 func TestBlockProposerPubkeyProof(t *testing.T) {
 	testCases := []struct {
 		name              string
+		forkVersion       uint32
 		numValidators     int
 		slot              math.Slot
 		proposerIndex     math.ValidatorIndex
@@ -47,6 +49,7 @@ func TestBlockProposerPubkeyProof(t *testing.T) {
 	}{
 		{
 			name:              "1 Validator Set",
+			forkVersion:       version.Deneb,
 			numValidators:     1,
 			slot:              4,
 			proposerIndex:     0,
@@ -57,6 +60,7 @@ func TestBlockProposerPubkeyProof(t *testing.T) {
 		},
 		{
 			name:              "Many Validator Set",
+			forkVersion:       version.Deneb,
 			numValidators:     100,
 			slot:              5,
 			proposerIndex:     95,
@@ -65,6 +69,18 @@ func TestBlockProposerPubkeyProof(t *testing.T) {
 			pubKey:            [48]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 1, 2},
 			expectedProofFile: "many_validators_proposer_pubkey_proof.json",
 		},
+		{
+			name:            "Many Validator Set DenebPlus",
+			forkVersion:     version.DenebPlus,
+			numValidators:   100,
+			slot:            5,
+			proposerIndex:   95,
+			parentBlockRoot: common.Root{1, 2, 3, 4, 5, 6},
+			bodyRoot:        common.Root{3, 2, 1, 9, 8, 7},
+			pubKey:          [48]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 1, 2},
+			expectedProofFile: "many_validators_proposer_pubkey_" +
+				"denebplus_proof.json",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -76,7 +92,7 @@ func TestBlockProposerPubkeyProof(t *testing.T) {
 			vals[tc.proposerIndex] = &types.Validator{Pubkey: tc.pubKey}
 
 			bs, err := mock.NewBeaconState(
-				tc.slot, vals, 0, common.ExecutionAddress{},
+				tc.forkVersion, tc.slot, vals, 0, common.ExecutionAddress{},
 			)
 			require.NoError(t, err)
 
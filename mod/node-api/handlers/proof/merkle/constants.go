@@ -68,4 +68,54 @@ const (
 	// in the Deneb fork. This is calculated by concatenating the
 	// (ExecutionFeeRecipientGIndexDenebState, StateGIndexDenebBlock) GIndices.
 	ExecutionFeeRecipientGIndexDenebBlock = 5889
+
+	// ZeroValidatorPubkeyGIndexDenebPlusState is the generalized index of the
+	// 0 validator's pubkey in the beacon state in the DenebPlus fork, which
+	// adds the historical summaries and deepens the tree of the state.
+	ZeroValidatorPubkeyGIndexDenebPlusState = 721279627821056
+
+	// ZeroValidatorPubkeyGIndexDenebPlusBlock is the generalized index of the
+	// 0 validator's pubkey in the beacon block in the DenebPlus fork. This is
+	// calculated by concatenating the (ZeroValidatorPubkeyGIndexDenebPlusState,
+	// StateGIndexDenebBlock) GIndices.
+	ZeroValidatorPubkeyGIndexDenebPlusBlock = 6350779162034176
+
+	// ExecutionNumberGIndexDenebPlusState is the generalized index of the
+	// latest execution payload header in the beacon state in the DenebPlus
+	// fork.
+	ExecutionNumberGIndexDenebPlusState = 1286
+
+	// ExecutionNumberGIndexDenebPlusBlock is the generalized index of the
+	// number in the latest execution payload header in the beacon block in the
+	// DenebPlus fork.
+	ExecutionNumberGIndexDenebPlusBlock = 11526
+
+	// ExecutionFeeRecipientGIndexDenebPlusState is the generalized index of
+	// the fee recipient in the latest execution payload header in the beacon
+	// state in the DenebPlus fork.
+	ExecutionFeeRecipientGIndexDenebPlusState = 1281
+
+	// ExecutionFeeRecipientGIndexDenebPlusBlock is the generalized index of
+	// the fee recipient in the latest execution payload header in the beacon
+	// block in the DenebPlus fork.
+	ExecutionFeeRecipientGIndexDenebPlusBlock = 11521
+
+	// ZeroHistoricalSummaryBlockRootGIndexDenebPlusState is the generalized
+	// index of the block summary root of the 0 historical summary in the
+	// beacon state in the DenebPlus fork. To get the GIndex of the block
+	// summary root of the historical summary at index n, the formula is:
+	// GIndex = ZeroHistoricalSummaryBlockRootGIndexDenebPlusState +
+	//          (HistoricalSummaryBlockRootGIndexOffset * n)
+	ZeroHistoricalSummaryBlockRootGIndexDenebPlusState = 3221225472
+
+	// ZeroHistoricalSummaryBlockRootGIndexDenebPlusBlock is the generalized
+	// index of the block summary root of the 0 historical summary in the
+	// beacon block in the DenebPlus fork. This is calculated by concatenating
+	// the (ZeroHistoricalSummaryBlockRootGIndexDenebPlusState,
+	// StateGIndexDenebBlock) GIndices.
+	ZeroHistoricalSummaryBlockRootGIndexDenebPlusBlock = 24696061952
+
+	// HistoricalSummaryBlockRootGIndexOffset is the offset of a historical
+	// summary block summary root GIndex.
+	HistoricalSummaryBlockRootGIndexOffset = 2
 )
@@ -31,9 +31,9 @@ import (
 )
 
 var (
-	// beaconStateSchema is the schema for the BeaconState struct defined in
-	// beacon-kit/mod/consensus-types/pkg/types/state.go.
-	beaconStateSchema = schema.DefineContainer(
+	// beaconStateFields are the fields of the BeaconState struct defined in
+	// beacon-kit/mod/consensus-types/pkg/types/state.go on the Deneb fork.
+	beaconStateFields = []*schema.Field[schema.SSZType]{
 		schema.NewField("GenesisValidatorsRoot", schema.B32()),
 		schema.NewField("Slot", schema.U64()),
 		schema.NewField("Fork", schema.DefineContainer(
@@ -95,7 +95,25 @@ var (
 			"Slashings", schema.DefineList(schema.U64(), types.MaxValidators),
 		),
 		schema.NewField("TotalSlashing", schema.U64()),
-	)
+	}
+
+	// beaconStateSchema is the schema for the BeaconState struct on the Deneb
+	// fork.
+	beaconStateSchema = schema.DefineContainer(beaconStateFields...)
+
+	// beaconStateSchemaDenebPlus is the schema for the BeaconState struct on
+	// the DenebPlus fork, which appends the historical summaries.
+	beaconStateSchemaDenebPlus = schema.DefineContainer(append(
+		beaconStateFields[:len(beaconStateFields):len(beaconStateFields)],
+		schema.NewField(
+			"HistoricalSummaries", schema.DefineList(
+				schema.DefineContainer(
+					schema.NewField("BlockSummaryRoot", schema.B32()),
+					schema.NewField("StateSummaryRoot", schema.B32()),
+				), types.HistoricalSummariesLimit,
+			),
+		),
+	)...)
 
 	// beaconHeaderSchema is the schema for the BeaconBlockHeader struct defined
 	// in beacon-kit/mod/consensus-types/pkg/types/header.go, with the SSZ
@@ -107,6 +125,17 @@ var (
 		schema.NewField("State", beaconStateSchema),
 		schema.NewField("BodyRoot", schema.B32()),
 	)
+
+	// beaconHeaderSchemaDenebPlus is the schema for the BeaconBlockHeader
+	// struct with the SSZ expansion of StateRoot to use the BeaconState on the
+	// DenebPlus fork.
+	beaconHeaderSchemaDenebPlus = schema.DefineContainer(
+		schema.NewField("Slot", schema.U64()),
+		schema.NewField("ProposerIndex", schema.U64()),
+		schema.NewField("ParentRoot", schema.B32()),
+		schema.NewField("State", beaconStateSchemaDenebPlus),
+		schema.NewField("BodyRoot", schema.B32()),
+	)
 )
 
 // TestGIndexProposerIndexDeneb tests the generalized index of the proposer
@@ -246,3 +275,90 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 		concatExecutionFeeRecipientStateToBlock,
 	)
 }
+
+// TestGIndicesDenebPlus tests the generalized indices used by beacon state
+// proofs on the DenebPlus fork, where the historical summaries deepen the
+// beacon state by one level.
+func TestGIndicesDenebPlus(t *testing.T) {
+	testCases := []struct {
+		path        string
+		stateGIndex int
+		blockGIndex int
+	}{
+		{
+			path:        "Validators/0/Pubkey",
+			stateGIndex: merkle.ZeroValidatorPubkeyGIndexDenebPlusState,
+			blockGIndex: merkle.ZeroValidatorPubkeyGIndexDenebPlusBlock,
+		},
+		{
+			path:        "LatestExecutionPayloadHeader/Number",
+			stateGIndex: merkle.ExecutionNumberGIndexDenebPlusState,
+			blockGIndex: merkle.ExecutionNumberGIndexDenebPlusBlock,
+		},
+		{
+			path:        "LatestExecutionPayloadHeader/FeeRecipient",
+			stateGIndex: merkle.ExecutionFeeRecipientGIndexDenebPlusState,
+			blockGIndex: merkle.ExecutionFeeRecipientGIndexDenebPlusBlock,
+		},
+		{
+			path: "HistoricalSummaries/0/BlockSummaryRoot",
+			stateGIndex: merkle.
+				ZeroHistoricalSummaryBlockRootGIndexDenebPlusState,
+			blockGIndex: merkle.
+				ZeroHistoricalSummaryBlockRootGIndexDenebPlusBlock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			// GIndex of the field in the state.
+			_, stateGIndex, _, err := mlib.ObjectPath[
+				mlib.GeneralizedIndex, [32]byte,
+			](tc.path).GetGeneralizedIndex(beaconStateSchemaDenebPlus)
+			require.NoError(t, err)
+			require.Equal(t, tc.stateGIndex, int(stateGIndex))
+
+			// GIndex of the field in the block.
+			_, blockGIndex, _, err := mlib.ObjectPath[
+				mlib.GeneralizedIndex, [32]byte,
+			]("State/" + tc.path).GetGeneralizedIndex(
+				beaconHeaderSchemaDenebPlus,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.blockGIndex, int(blockGIndex))
+
+			// Concatenation is consistent.
+			require.Equal(t,
+				blockGIndex,
+				mlib.GeneralizedIndices{
+					merkle.StateGIndexDenebBlock,
+					stateGIndex,
+				}.Concat(),
+			)
+		})
+	}
+
+	// GIndex offset of the next validator's pubkey.
+	_, oneValidatorPubkeyGIndexState, _, err := mlib.ObjectPath[
+		mlib.GeneralizedIndex, [32]byte,
+	]("Validators/1/Pubkey").GetGeneralizedIndex(beaconStateSchemaDenebPlus)
+	require.NoError(t, err)
+	require.Equal(t,
+		merkle.ValidatorPubkeyGIndexOffset,
+		int(oneValidatorPubkeyGIndexState)-
+			merkle.ZeroValidatorPubkeyGIndexDenebPlusState,
+	)
+
+	// GIndex offset of the next historical summary's block summary root.
+	_, oneSummaryBlockRootGIndexState, _, err := mlib.ObjectPath[
+		mlib.GeneralizedIndex, [32]byte,
+	]("HistoricalSummaries/1/BlockSummaryRoot").GetGeneralizedIndex(
+		beaconStateSchemaDenebPlus,
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		merkle.HistoricalSummaryBlockRootGIndexOffset,
+		int(oneSummaryBlockRootGIndexState)-
+			merkle.ZeroHistoricalSummaryBlockRootGIndexDenebPlusState,
+	)
+}
@@ -44,8 +44,15 @@ func ProveExecutionFeeRecipientInBlock[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 ) ([]common.Root, common.Root, error) {
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
 	// Get the proof of the execution fee recipient in the beacon state.
-	feeRecipientInStateProof, leaf, err := ProveExecutionFeeRecipientInState(bs)
+	feeRecipientInStateProof, leaf, err := proveExecutionFeeRecipientInState(
+		bsm,
+	)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -61,7 +68,10 @@ func ProveExecutionFeeRecipientInBlock[
 	//nolint:gocritic // ok.
 	combinedProof := append(feeRecipientInStateProof, stateInBlockProof...)
 	beaconRoot, err := verifyExecutionFeeRecipientInBlock(
-		bbh, combinedProof, leaf,
+		bbh,
+		gIndicesForVersion(bsm.Version()).executionFeeRecipientBlock,
+		combinedProof,
+		leaf,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -86,13 +96,22 @@ func ProveExecutionFeeRecipientInState[
 	if err != nil {
 		return nil, common.Root{}, err
 	}
+	return proveExecutionFeeRecipientInState(bsm)
+}
+
+// proveExecutionFeeRecipientInState generates a proof for the execution fee
+// recipient in the marshallable beacon state, at the generalized index of its
+// fork version.
+func proveExecutionFeeRecipientInState(
+	bsm types.BeaconStateMarshallable,
+) ([]common.Root, common.Root, error) {
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
 	feeRecipientInStateProof, err := stateProofTree.Prove(
-		ExecutionFeeRecipientGIndexDenebState,
+		gIndicesForVersion(bsm.Version()).executionFeeRecipientState,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -112,12 +131,13 @@ func ProveExecutionFeeRecipientInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyExecutionFeeRecipientInBlock(
 	bbh types.BeaconBlockHeader,
+	gIndex int,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		ExecutionFeeRecipientGIndexDenebBlock, leaf, proof, beaconRoot,
+		merkle.GeneralizedIndex(gIndex), leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
 	} else if !beaconRootVerified {
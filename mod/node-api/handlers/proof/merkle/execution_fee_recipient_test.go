@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle/mock"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,6 +39,7 @@ func TestExecutionFeeRecipientProof(t *testing.T) {
 
 	testCases := []struct {
 		name                  string
+		forkVersion           uint32
 		slot                  math.Slot
 		proposerIndex         math.ValidatorIndex
 		parentBlockRoot       common.Root
@@ -47,6 +49,7 @@ func TestExecutionFeeRecipientProof(t *testing.T) {
 	}{
 		{
 			name:                  "Empty Fee Recipient",
+			forkVersion:           version.Deneb,
 			slot:                  4,
 			proposerIndex:         0,
 			parentBlockRoot:       common.Root{1, 2, 3},
@@ -56,6 +59,7 @@ func TestExecutionFeeRecipientProof(t *testing.T) {
 		},
 		{
 			name:            "Non-empty Fee Recipient",
+			forkVersion:     version.Deneb,
 			slot:            5,
 			proposerIndex:   95,
 			parentBlockRoot: common.Root{1, 2, 3, 4, 5, 6},
@@ -65,12 +69,24 @@ func TestExecutionFeeRecipientProof(t *testing.T) {
 			),
 			expectedProofFile: "non_empty_fee_recipient_proof.json",
 		},
+		{
+			name:            "Non-empty Fee Recipient DenebPlus",
+			forkVersion:     version.DenebPlus,
+			slot:            5,
+			proposerIndex:   95,
+			parentBlockRoot: common.Root{1, 2, 3, 4, 5, 6},
+			bodyRoot:        common.Root{3, 2, 1, 9, 8, 7},
+			executionFeeRecipient: common.NewExecutionAddressFromHex(
+				"0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4",
+			),
+			expectedProofFile: "non_empty_fee_recipient_denebplus_proof.json",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			bs, err := mock.NewBeaconState(
-				tc.slot, nil, 0, tc.executionFeeRecipient,
+				tc.forkVersion, tc.slot, nil, 0, tc.executionFeeRecipient,
 			)
 			require.NoError(t, err)
 
@@ -44,8 +44,13 @@ func ProveExecutionNumberInBlock[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 ) ([]common.Root, common.Root, error) {
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
 	// Get the proof of the execution number in the beacon state.
-	numberInStateProof, leaf, err := ProveExecutionNumberInState(bs)
+	numberInStateProof, leaf, err := proveExecutionNumberInState(bsm)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -60,7 +65,12 @@ func ProveExecutionNumberInBlock[
 	//
 	//nolint:gocritic // ok.
 	combinedProof := append(numberInStateProof, stateInBlockProof...)
-	beaconRoot, err := verifyExecutionNumberInBlock(bbh, combinedProof, leaf)
+	beaconRoot, err := verifyExecutionNumberInBlock(
+		bbh,
+		gIndicesForVersion(bsm.Version()).executionNumberBlock,
+		combinedProof,
+		leaf,
+	)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -83,13 +93,22 @@ func ProveExecutionNumberInState[
 	if err != nil {
 		return nil, common.Root{}, err
 	}
+	return proveExecutionNumberInState(bsm)
+}
+
+// proveExecutionNumberInState generates a proof for the block number of the
+// execution payload in the marshallable beacon state, at the generalized index
+// of its fork version.
+func proveExecutionNumberInState(
+	bsm types.BeaconStateMarshallable,
+) ([]common.Root, common.Root, error) {
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
 	numberInStateProof, err := stateProofTree.Prove(
-		ExecutionNumberGIndexDenebState,
+		gIndicesForVersion(bsm.Version()).executionNumberState,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -108,12 +127,13 @@ func ProveExecutionNumberInState[
 // TODO: verifying the proof is not absolutely necessary.
 func verifyExecutionNumberInBlock(
 	bbh types.BeaconBlockHeader,
+	gIndex int,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
 	if beaconRootVerified, err := merkle.VerifyProof(
-		ExecutionNumberGIndexDenebBlock, leaf, proof, beaconRoot,
+		merkle.GeneralizedIndex(gIndex), leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
 	} else if !beaconRootVerified {
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle/mock"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,6 +39,7 @@ func TestExecutionNumberProof(t *testing.T) {
 
 	testCases := []struct {
 		name              string
+		forkVersion       uint32
 		slot              math.Slot
 		proposerIndex     math.ValidatorIndex
 		parentBlockRoot   common.Root
@@ -48,6 +50,7 @@ func TestExecutionNumberProof(t *testing.T) {
 	}{
 		{
 			name:              "Empty Execution Number",
+			forkVersion:       version.Deneb,
 			slot:              4,
 			proposerIndex:     0,
 			parentBlockRoot:   common.Root{1, 2, 3},
@@ -57,6 +60,7 @@ func TestExecutionNumberProof(t *testing.T) {
 		},
 		{
 			name:              "Non-empty Execution Number",
+			forkVersion:       version.Deneb,
 			slot:              5,
 			proposerIndex:     95,
 			parentBlockRoot:   common.Root{1, 2, 3, 4, 5, 6},
@@ -64,12 +68,23 @@ func TestExecutionNumberProof(t *testing.T) {
 			executionNumber:   69420,
 			expectedProofFile: "non_empty_execution_number_proof.json",
 		},
+		{
+			name:            "Non-empty Execution Number DenebPlus",
+			forkVersion:     version.DenebPlus,
+			slot:            5,
+			proposerIndex:   95,
+			parentBlockRoot: common.Root{1, 2, 3, 4, 5, 6},
+			bodyRoot:        common.Root{3, 2, 1, 9, 8, 7},
+			executionNumber: 69420,
+			expectedProofFile: "non_empty_execution_number_" +
+				"denebplus_proof.json",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			bs, err := mock.NewBeaconState(
-				tc.slot, nil, tc.executionNumber, common.ExecutionAddress{},
+				tc.forkVersion, tc.slot, nil, tc.executionNumber, common.ExecutionAddress{},
 			)
 			require.NoError(t, err)
 
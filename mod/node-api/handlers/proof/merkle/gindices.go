@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+
+// gIndices are the generalized indices of the fields proven in the beacon
+// state and in the beacon block, which move with the layout of the state.
+type gIndices struct {
+	zeroValidatorPubkeyState   int
+	zeroValidatorPubkeyBlock   int
+	executionNumberState       int
+	executionNumberBlock       int
+	executionFeeRecipientState int
+	executionFeeRecipientBlock int
+}
+
+// gIndicesForVersion returns the generalized indices of the fields of the
+// beacon state laid out for the given fork version.
+func gIndicesForVersion(forkVersion uint32) gIndices {
+	if forkVersion >= version.DenebPlus {
+		return gIndices{
+			zeroValidatorPubkeyState:   ZeroValidatorPubkeyGIndexDenebPlusState,
+			zeroValidatorPubkeyBlock:   ZeroValidatorPubkeyGIndexDenebPlusBlock,
+			executionNumberState:       ExecutionNumberGIndexDenebPlusState,
+			executionNumberBlock:       ExecutionNumberGIndexDenebPlusBlock,
+			executionFeeRecipientState: ExecutionFeeRecipientGIndexDenebPlusState,
+			executionFeeRecipientBlock: ExecutionFeeRecipientGIndexDenebPlusBlock,
+		}
+	}
+	return gIndices{
+		zeroValidatorPubkeyState:   ZeroValidatorPubkeyGIndexDenebState,
+		zeroValidatorPubkeyBlock:   ZeroValidatorPubkeyGIndexDenebBlock,
+		executionNumberState:       ExecutionNumberGIndexDenebState,
+		executionNumberBlock:       ExecutionNumberGIndexDenebBlock,
+		executionFeeRecipientState: ExecutionFeeRecipientGIndexDenebState,
+		executionFeeRecipientBlock: ExecutionFeeRecipientGIndexDenebBlock,
+	}
+}
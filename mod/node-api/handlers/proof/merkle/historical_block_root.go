@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	pmerkle "github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ProveHistoricalBlockRootInBlock generates a proof for the block root at
+// rootIndex of the block roots accumulated by the historical summary at
+// summaryIndex in the beacon block. The given block roots must be the full
+// vector of block roots that the historical summary was built from. The proof
+// is then verified against the beacon block root as a sanity check. Returns
+// the proof along with its generalized index and the beacon block root. The
+// historical summaries are only part of the beacon state from DenebPlus on.
+func ProveHistoricalBlockRootInBlock[
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
+	ValidatorT any,
+](
+	bbh BeaconBlockHeaderT,
+	bs types.BeaconState[
+		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
+	],
+	summaryIndex uint64,
+	blockRoots []common.Root,
+	rootIndex uint64,
+) ([]common.Root, math.U64, common.Root, error) {
+	// Get the proof of the historical block root in the beacon state.
+	rootInStateProof, err := ProveHistoricalBlockRootInState(
+		bs, summaryIndex, blockRoots, rootIndex,
+	)
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+
+	// Then get the proof of the beacon state in the beacon block.
+	stateInBlockProof, err := ProveBeaconStateInBlock(bbh, false)
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+
+	// Sanity check that the combined proof verifies against our beacon root.
+	//
+	//nolint:gocritic // ok.
+	combinedProof := append(rootInStateProof, stateInBlockProof...)
+	gIndex := HistoricalBlockRootGIndexDenebPlusBlock(
+		summaryIndex, uint64(len(blockRoots)), rootIndex,
+	)
+	beaconRoot, err := verifyHistoricalBlockRootInBlock(
+		bbh, gIndex, combinedProof, blockRoots[rootIndex],
+	)
+	if err != nil {
+		return nil, 0, common.Root{}, err
+	}
+
+	return combinedProof, gIndex, beaconRoot, nil
+}
+
+// ProveHistoricalBlockRootInState generates a proof for the block root at
+// rootIndex of the block roots accumulated by the historical summary at
+// summaryIndex in the beacon state. It uses the fastssz library to prove the
+// historical summary and a merkle tree over the given block roots to prove the
+// block root in the historical summary.
+func ProveHistoricalBlockRootInState[
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
+	ValidatorT any,
+](
+	bs types.BeaconState[
+		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
+	],
+	summaryIndex uint64,
+	blockRoots []common.Root,
+	rootIndex uint64,
+) ([]common.Root, error) {
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	if bsm.Version() < version.DenebPlus {
+		return nil, errors.Wrapf(
+			errors.New("beacon state holds no historical summaries"),
+			"fork version: %d", bsm.Version(),
+		)
+	}
+
+	summaries, err := bs.GetHistoricalSummaries()
+	if err != nil {
+		return nil, err
+	}
+	if summaryIndex >= uint64(len(summaries)) {
+		return nil, errors.Wrapf(
+			errors.New("historical summary index out of range"),
+			"index: %d, summaries: %d", summaryIndex, len(summaries),
+		)
+	}
+
+	// Ensure the given block roots are the ones the summary was built from.
+	blockRootsTree, err := pmerkle.NewTreeFromLeaves(blockRoots)
+	if err != nil {
+		return nil, err
+	}
+	if common.Root(blockRootsTree.Root()) !=
+		summaries[summaryIndex].BlockSummaryRoot {
+		return nil, errors.New(
+			"block roots do not match the historical summary block root",
+		)
+	}
+	rootInSummaryProof, err := blockRootsTree.MerkleProof(rootIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	stateProofTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	summaryInStateProof, err := stateProofTree.Prove(int(
+		ZeroHistoricalSummaryBlockRootGIndexDenebPlusState +
+			HistoricalSummaryBlockRootGIndexOffset*summaryIndex,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	proof := make(
+		[]common.Root, 0, len(rootInSummaryProof)+len(summaryInStateProof.Hashes),
+	)
+	proof = append(proof, rootInSummaryProof...)
+	for _, hash := range summaryInStateProof.Hashes {
+		proof = append(proof, common.NewRootFromBytes(hash))
+	}
+	return proof, nil
+}
+
+// HistoricalBlockRootGIndexDenebPlusBlock returns the generalized index of
+// the block root at rootIndex of the numRoots block roots accumulated by the
+// historical summary at summaryIndex in the beacon block in the DenebPlus
+// fork.
+func HistoricalBlockRootGIndexDenebPlusBlock(
+	summaryIndex, numRoots, rootIndex uint64,
+) math.U64 {
+	summaryGIndex := math.U64(
+		ZeroHistoricalSummaryBlockRootGIndexDenebPlusBlock +
+			HistoricalSummaryBlockRootGIndexOffset*summaryIndex,
+	)
+	depth := math.U64(numRoots).NextPowerOfTwo().ILog2Ceil()
+	return summaryGIndex<<depth | math.U64(rootIndex)
+}
+
+// verifyHistoricalBlockRootInBlock verifies the historical block root in the
+// beacon block, returning the beacon block root used to verify against.
+func verifyHistoricalBlockRootInBlock(
+	bbh types.BeaconBlockHeader,
+	gIndex math.U64,
+	proof []common.Root,
+	leaf common.Root,
+) (common.Root, error) {
+	beaconRoot := bbh.HashTreeRoot()
+	if beaconRootVerified, err := merkle.VerifyProof(
+		merkle.GeneralizedIndex(gIndex), leaf, proof, beaconRoot,
+	); err != nil {
+		return common.Root{}, err
+	} else if !beaconRootVerified {
+		return common.Root{}, errors.New(
+			"historical block root proof failed to verify against beacon root",
+		)
+	}
+	return beaconRoot, nil
+}
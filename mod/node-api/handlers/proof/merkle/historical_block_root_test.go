@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	mlib "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	pmerkle "github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHistoricalBlockRootProof tests the ProveHistoricalBlockRootInBlock
+// function and that the generated proof correctly verifies.
+func TestHistoricalBlockRootProof(t *testing.T) {
+	const numRoots = 8
+
+	// periodRoots returns the block roots of the given historical period.
+	periodRoots := func(period byte) []common.Root {
+		roots := make([]common.Root, numRoots)
+		for i := range roots {
+			roots[i] = common.Root{period, byte(i) + 1}
+		}
+		return roots
+	}
+
+	testCases := []struct {
+		name         string
+		forkVersion  uint32
+		numSummaries int
+		summaryIndex uint64
+		blockRoots   []common.Root
+		rootIndex    uint64
+		expectedErr  bool
+	}{
+		{
+			name:         "First Root Of Only Summary",
+			forkVersion:  version.DenebPlus,
+			numSummaries: 1,
+			summaryIndex: 0,
+			blockRoots:   periodRoots(0),
+			rootIndex:    0,
+		},
+		{
+			name:         "Last Root Of Last Summary",
+			forkVersion:  version.DenebPlus,
+			numSummaries: 3,
+			summaryIndex: 2,
+			blockRoots:   periodRoots(2),
+			rootIndex:    numRoots - 1,
+		},
+		{
+			name:         "Block Roots Of Another Summary",
+			forkVersion:  version.DenebPlus,
+			numSummaries: 3,
+			summaryIndex: 1,
+			blockRoots:   periodRoots(2),
+			rootIndex:    3,
+			expectedErr:  true,
+		},
+		{
+			name:         "Summary Index Out Of Range",
+			forkVersion:  version.DenebPlus,
+			numSummaries: 2,
+			summaryIndex: 2,
+			blockRoots:   periodRoots(2),
+			rootIndex:    3,
+			expectedErr:  true,
+		},
+		{
+			name:         "Before DenebPlus",
+			forkVersion:  version.Deneb,
+			numSummaries: 1,
+			summaryIndex: 0,
+			blockRoots:   periodRoots(0),
+			rootIndex:    0,
+			expectedErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bs, err := mock.NewBeaconState(
+				tc.forkVersion, 4, nil, 0, common.ExecutionAddress{},
+			)
+			require.NoError(t, err)
+
+			for i := range tc.numSummaries {
+				blockTree, treeErr := pmerkle.NewTreeFromLeaves(
+					periodRoots(byte(i)),
+				)
+				require.NoError(t, treeErr)
+				bs.HistoricalSummaries = append(
+					bs.HistoricalSummaries,
+					(&common.HistoricalSummary{}).New(
+						blockTree.Root(), common.Root{byte(i), 0xff},
+					),
+				)
+			}
+
+			bbh := (&types.BeaconBlockHeader{}).New(
+				4,
+				1,
+				common.Root{1, 2, 3},
+				bs.HashTreeRoot(),
+				common.Root{3, 2, 1},
+			)
+
+			proof, gIndex, beaconRoot, err := merkle.
+				ProveHistoricalBlockRootInBlock(
+					bbh, bs, tc.summaryIndex, tc.blockRoots, tc.rootIndex,
+				)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, bbh.HashTreeRoot(), beaconRoot)
+			require.Equal(t,
+				math.U64(
+					(merkle.ZeroHistoricalSummaryBlockRootGIndexDenebPlusBlock+
+						merkle.HistoricalSummaryBlockRootGIndexOffset*
+							tc.summaryIndex)*numRoots+tc.rootIndex,
+				),
+				gIndex,
+			)
+
+			verified, err := mlib.VerifyProof(
+				mlib.GeneralizedIndex(gIndex),
+				tc.blockRoots[tc.rootIndex],
+				proof,
+				beaconRoot,
+			)
+			require.NoError(t, err)
+			require.True(t, verified)
+		})
+	}
+}
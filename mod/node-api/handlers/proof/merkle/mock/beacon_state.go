@@ -58,9 +58,11 @@ type (
 	}
 )
 
-// NewBeaconState creates a new mock beacon state, with only the given slot,
-// validators, execution number, and execution fee recipient.
+// NewBeaconState creates a new mock beacon state laid out for the given fork
+// version, with only the given slot, validators, execution number, and
+// execution fee recipient.
 func NewBeaconState(
+	forkVersion uint32,
 	slot math.Slot,
 	vals types.Validators,
 	executionNumber math.U64,
@@ -82,7 +84,7 @@ func NewBeaconState(
 		err error
 	)
 	bsm, err = bsm.New(
-		0,
+		forkVersion,
 		common.Root{},
 		slot,
 		(&types.Fork{}).Empty(),
@@ -99,6 +101,7 @@ func NewBeaconState(
 		0,
 		[]math.Gwei{},
 		0,
+		[]*common.HistoricalSummary{},
 	)
 	return &BeaconState{BeaconStateMarshallable: bsm}, err
 }
@@ -110,6 +113,13 @@ func (m *BeaconState) GetLatestExecutionPayloadHeader() (
 	return m.BeaconStateMarshallable.LatestExecutionPayloadHeader, nil
 }
 
+// GetHistoricalSummaries implements proof BeaconState.
+func (m *BeaconState) GetHistoricalSummaries() (
+	[]*common.HistoricalSummary, error,
+) {
+	return m.BeaconStateMarshallable.HistoricalSummaries, nil
+}
+
 // GetMarshallable implements proof BeaconState.
 func (m *BeaconState) GetMarshallable() (
 	*BeaconStateMarshallable, error,
@@ -47,5 +47,10 @@ func (
 			Path:    "bkit/v1/proof/execution_fee_recipient/:timestamp_id",
 			Handler: h.GetExecutionFeeRecipient,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/historical_block_root/:timestamp_id",
+			Handler: h.GetHistoricalBlockRoot,
+		},
 	})
 }
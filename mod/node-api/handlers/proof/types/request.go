@@ -39,3 +39,10 @@ type ExecutionNumberRequest struct {
 type ExecutionFeeRecipientRequest struct {
 	types.TimestampIDRequest
 }
+
+// HistoricalBlockRootRequest is the request for the
+// `/proof/historical_block_root/{timestamp_id}` endpoint.
+type HistoricalBlockRootRequest struct {
+	types.TimestampIDRequest
+	Slot string `query:"slot" validate:"required,slot"`
+}
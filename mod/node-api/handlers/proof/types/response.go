@@ -42,7 +42,7 @@ type BlockProposerResponse[BeaconBlockHeaderT any] struct {
 	// ValidatorPubkeyProof can be verified against the beacon block root. Use
 	// a Generalized Index of `z + (8 * ValidatorIndex)`, where z is the
 	// Generalized Index of the 0 validator pubkey in the beacon block. In
-	// the Deneb fork, z is 3254554418216960.
+	// the Deneb fork, z is 6350779162034176.
 	ValidatorPubkeyProof []common.Root `json:"validator_pubkey_proof"`
 
 	// ProposerIndexProof can be verified against the beacon block root. Use
@@ -64,7 +64,7 @@ type ExecutionNumberResponse[BeaconBlockHeaderT any] struct {
 	ExecutionNumber math.U64 `json:"execution_number"`
 
 	// ExecutionNumberProof can be verified against the beacon block root using
-	// a Generalized Index of 5894 in the Deneb fork.
+	// a Generalized Index of 11526 in the Deneb fork.
 	ExecutionNumberProof []common.Root `json:"execution_number_proof"`
 }
 
@@ -84,6 +84,37 @@ type ExecutionFeeRecipientResponse[BeaconBlockHeaderT any] struct {
 	ExecutionFeeRecipient common.ExecutionAddress `json:"execution_fee_recipient"`
 
 	// ExecutionFeeRecipientProof can be verified against the beacon block root
-	// using a Generalized Index of 5894 in the Deneb fork.
+	// using a Generalized Index of 11521 in the Deneb fork.
 	ExecutionFeeRecipientProof []common.Root `json:"execution_fee_recipient_proof"`
 }
+
+// HistoricalBlockRootResponse is the response for the
+// `/proof/historical_block_root/{timestamp_id}` endpoint.
+type HistoricalBlockRootResponse[BeaconBlockHeaderT any] struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader BeaconBlockHeaderT `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// Slot is the slot of the historical block.
+	Slot math.Slot `json:"slot"`
+
+	// HistoricalBlockRoot is the block root of the historical block.
+	HistoricalBlockRoot common.Root `json:"historical_block_root"`
+
+	// HistoricalSummaryIndex is the index of the historical summary in the
+	// beacon state that accumulates the historical block root.
+	HistoricalSummaryIndex math.U64 `json:"historical_summary_index"`
+
+	// GeneralizedIndex is the Generalized Index of the historical block root
+	// in the beacon block.
+	GeneralizedIndex math.U64 `json:"generalized_index"`
+
+	// HistoricalBlockRootProof can be verified against the beacon block root
+	// using GeneralizedIndex. Without its last 3 hashes, which prove the
+	// beacon state in the beacon block, it can be verified against the state
+	// root of the beacon block header.
+	HistoricalBlockRootProof []common.Root `json:"historical_block_root_proof"`
+}
@@ -44,6 +44,8 @@ type BeaconState[
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
 	// header.
 	GetLatestExecutionPayloadHeader() (ExecutionPayloadHeaderT, error)
+	// GetHistoricalSummaries returns the historical summaries.
+	GetHistoricalSummaries() ([]*common.HistoricalSummary, error)
 	// GetMarshallable returns the marshallable version of the beacon state.
 	GetMarshallable() (BeaconStateMarshallableT, error)
 	// ValidatorByIndex retrieves the validator at the given index.
@@ -55,6 +57,8 @@ type BeaconState[
 type BeaconStateMarshallable interface {
 	// GetTree is kept for FastSSZ compatibility.
 	GetTree() (*fastssz.Node, error)
+	// Version returns the fork version the beacon state is laid out for.
+	Version() uint32
 }
 
 // ExecutionPayloadHeader is the interface for an execution payload header.
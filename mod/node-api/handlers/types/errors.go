@@ -26,4 +26,7 @@ var (
 	ErrNotFound       = errors.New("not found")
 	ErrNotImplemented = errors.New("not implemented")
 	ErrInvalidRequest = errors.New("invalid request")
+	// ErrServiceUnavailable is returned when the node cannot serve the
+	// request because one of its services is unhealthy.
+	ErrServiceUnavailable = errors.New("service unavailable")
 )
@@ -20,6 +20,8 @@
 
 package types
 
+import "io"
+
 type DataResponse struct {
 	Data any `json:"data"`
 }
@@ -29,3 +31,14 @@ func Wrap(data any) DataResponse {
 		Data: data,
 	}
 }
+
+// StreamResponse is a response whose body is written directly to the client
+// instead of being JSON encoded, e.g. an SSZ encoded beacon state.
+type StreamResponse struct {
+	// ContentType is the MIME type of the body.
+	ContentType string
+	// Headers are additional headers to set on the response.
+	Headers map[string]string
+	// Write streams the body into the given writer.
+	Write func(w io.Writer) error
+}
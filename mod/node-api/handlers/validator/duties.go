@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+)
+
+// GetProposerDuties returns the proposers of the slots of the requested
+// epoch. Proposers beyond the latest block are best-effort predictions and
+// are flagged as such.
+func (h *Handler[ContextT]) GetProposerDuties(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.GetProposerDutiesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := utils.U64FromString(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	dependentRoot, duties, err := h.backend.ProposerDutiesAtEpoch(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return types.ProposerDutiesResponse{
+		DependentRoot:       dependentRoot,
+		ExecutionOptimistic: false, // stubbed
+		Data:                duties,
+	}, nil
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// PrepareBeaconProposer sets the fee recipient of the blocks proposed by
+// each of the given validators. The mapping is persisted by the node and
+// takes precedence over the configured suggested fee recipient.
+func (h *Handler[ContextT]) PrepareBeaconProposer(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[validatortypes.PrepareProposerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make(
+		map[math.ValidatorIndex]common.ExecutionAddress, len(req),
+	)
+	for _, preparation := range req {
+		index, err := utils.U64FromString(preparation.ValidatorIndex)
+		if err != nil {
+			return nil, types.ErrInvalidRequest
+		}
+		recipients[index] = preparation.FeeRecipient
+	}
+	return nil, h.backend.PrepareBeaconProposers(recipients)
+}
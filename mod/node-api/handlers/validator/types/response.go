@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// ProposerDutiesResponse is the response of the proposer duties endpoint.
+type ProposerDutiesResponse struct {
+	DependentRoot       common.Root `json:"dependent_root"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+	Data                any         `json:"data"`
+}
+
+// ProposerDutyData is the proposer of a slot.
+type ProposerDutyData struct {
+	Pubkey         crypto.BLSPubkey `json:"pubkey"`
+	ValidatorIndex uint64           `json:"validator_index,string"`
+	Slot           uint64           `json:"slot,string"`
+	// Predicted is set for slots beyond the latest block, whose proposer is
+	// a best-effort prediction rather than a fact.
+	Predicted bool `json:"predicted"`
+}
@@ -22,6 +22,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
@@ -29,6 +30,10 @@ import (
 	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
+// shutdownTimeout is the time given to in-flight requests to complete when
+// the server is stopped.
+const shutdownTimeout = 5 * time.Second
+
 // Server is the API Server service.
 type Server[
 	ContextT apicontext.Context,
@@ -74,7 +79,7 @@ func (s *Server[_]) Start(ctx context.Context) error {
 }
 
 func (s *Server[_]) start(ctx context.Context) {
-	errCh := make(chan error)
+	errCh := make(chan error, 1)
 	go func() {
 		errCh <- s.engine.Run(s.config.Address)
 	}()
@@ -83,6 +88,14 @@ func (s *Server[_]) start(ctx context.Context) {
 		case err := <-errCh:
 			s.logger.Error(err.Error())
 		case <-ctx.Done():
+			// release the listener so the address can be reused.
+			shutdownCtx, cancel := context.WithTimeout(
+				context.Background(), shutdownTimeout,
+			)
+			if err := s.engine.Shutdown(shutdownCtx); err != nil {
+				s.logger.Error("Failed to shutdown API server", "error", err)
+			}
+			cancel()
 			return
 		}
 	}
@@ -21,6 +21,8 @@
 package server
 
 import (
+	stdctx "context"
+
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
@@ -29,5 +31,6 @@ import (
 // Engine is a generic interface for an API engine.
 type Engine[ContextT context.Context] interface {
 	Run(addr string) error
+	Shutdown(ctx stdctx.Context) error
 	RegisterRoutes(*handlers.RouteSet[ContextT], log.Logger)
 }
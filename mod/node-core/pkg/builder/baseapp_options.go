@@ -27,11 +27,14 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	server "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
@@ -59,6 +62,11 @@ func DefaultServiceOptions[
 		panic(err)
 	}
 
+	snapshotStore, err := getSnapshotStore(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	// get chainID, possibly falling back to genesis if flag is not set
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	if chainID == "" {
@@ -82,7 +90,39 @@ func DefaultServiceOptions[
 			true,
 		),
 		cometbft.SetChainID[LoggerT](chainID),
+		cometbft.SetSnapshot[LoggerT](
+			snapshotStore,
+			snapshottypes.NewSnapshotOptions(
+				cast.ToUint64(
+					appOpts.Get(server.FlagStateSyncSnapshotInterval),
+				),
+				cast.ToUint32(
+					appOpts.Get(server.FlagStateSyncSnapshotKeepRecent),
+				),
+			),
+		),
+	}
+}
+
+// getSnapshotStore opens the store of the state sync snapshots in the data
+// directory of the node.
+func getSnapshotStore(appOpts config.AppOptions) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(
+		cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots",
+	)
+	if err := os.MkdirAll(snapshotDir, 0o744); err != nil {
+		return nil, fmt.Errorf(
+			"failed to create snapshots directory: %w", err,
+		)
+	}
+
+	snapshotDB, err := dbm.NewDB(
+		"metadata", dbm.PebbleDBBackend, snapshotDir,
+	)
+	if err != nil {
+		return nil, err
 	}
+	return snapshots.NewStore(snapshotDB, snapshotDir)
 }
 
 func loadChainIDFromGenesis(appOpts config.AppOptions) (string, error) {
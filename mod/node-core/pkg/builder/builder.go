@@ -28,6 +28,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
+	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
+	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
@@ -83,9 +85,13 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 		apiBackend interface {
 			AttachQueryBackend(*cometbft.Service[LoggerT])
 		}
+		nodeAPIHandler interface {
+			AttachHealthReporter(nodeapi.HealthReporter)
+		}
 		beaconNode NodeT
 		cmtService *cometbft.Service[LoggerT]
 		config     *config.Config
+		registry   *service.Registry
 	)
 
 	// build all node components using depinject
@@ -102,21 +108,24 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 			),
 		),
 		&apiBackend,
+		&nodeAPIHandler,
 		&beaconNode,
 		&cmtService,
 		&config,
+		&registry,
 	); err != nil {
 		panic(err)
 	}
 	if config == nil {
 		panic("config is nil")
 	}
-	if apiBackend == nil {
+	if apiBackend == nil || nodeAPIHandler == nil {
 		panic("node or api backend is nil")
 	}
 
 	// TODO: so hood
 	logger.WithConfig(any(config.GetLogger()).(LoggerConfigT))
 	apiBackend.AttachQueryBackend(cmtService)
+	nodeAPIHandler.AttachHealthReporter(registry)
 	return beaconNode
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -40,13 +41,16 @@ func ProvideNodeAPIEngine() *echo.Engine {
 type NodeAPIBackendInput[
 	BeaconBlockT any,
 	BeaconStateT any,
+	BlobSidecarsT any,
 	DepositT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	StorageBackendT any,
 ] struct {
 	depinject.In
 
+	BlobArchive    BlobArchive[BlobSidecarsT]
 	ChainSpec      common.ChainSpec
+	FeeRecipients  *FeeRecipients
 	StateProcessor StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context,
 		DepositT, ExecutionPayloadHeaderT,
@@ -66,13 +70,25 @@ func ProvideNodeAPIBackend[
 		*Validator, Validators, WithdrawalT,
 	],
 	BeaconStateMarshallableT any,
-	BlobSidecarsT any,
+	BlobSidecarT any,
+	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeT interface {
+		AppHash(height int64) ([]byte, error)
 		CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+		ProposerCandidates(
+			height int64,
+		) ([]*transition.ProposerCandidate, error)
+		ProposerSchedule(
+			height int64,
+		) (transition.ProposerSchedule, error)
+		LightBlock(height int64) (*transition.LightBlock, error)
+		ProveBlockRoot(
+			height int64, index uint64,
+		) (*transition.StoreProof, error)
 	},
 	StorageBackendT StorageBackend[
 		AvailabilityStoreT, BeaconStateT, BeaconBlockStoreT, DepositStoreT,
@@ -80,8 +96,8 @@ func ProvideNodeAPIBackend[
 	WithdrawalT Withdrawal[WithdrawalT],
 ](
 	in NodeAPIBackendInput[
-		BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
-		StorageBackendT,
+		BeaconBlockT, BeaconStateT, BlobSidecarsT, DepositT,
+		ExecutionPayloadHeaderT, StorageBackendT,
 	],
 ) *backend.Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
@@ -116,6 +132,8 @@ func ProvideNodeAPIBackend[
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.FeeRecipients,
+		in.BlobArchive,
 	)
 }
 
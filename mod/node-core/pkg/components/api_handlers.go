@@ -30,6 +30,7 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	validatorapi "github.com/berachain/beacon-kit/mod/node-api/handlers/validator"
 )
 
 type NodeAPIHandlersInput[
@@ -54,13 +55,16 @@ type NodeAPIHandlersInput[
 	]
 	BuilderAPIHandler *builderapi.Handler[NodeAPIContextT]
 	ConfigAPIHandler  *configapi.Handler[NodeAPIContextT]
-	DebugAPIHandler   *debugapi.Handler[NodeAPIContextT]
-	EventsAPIHandler  *eventsapi.Handler[NodeAPIContextT]
-	NodeAPIHandler    *nodeapi.Handler[NodeAPIContextT]
-	ProofAPIHandler   *proofapi.Handler[
+	DebugAPIHandler   *debugapi.Handler[
+		BeaconStateT, BeaconStateMarshallableT, NodeAPIContextT,
+	]
+	EventsAPIHandler *eventsapi.Handler[NodeAPIContextT]
+	NodeAPIHandler   *nodeapi.Handler[NodeAPIContextT]
+	ProofAPIHandler  *proofapi.Handler[
 		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
 		NodeAPIContextT, ExecutionPayloadHeaderT, *Validator,
 	]
+	ValidatorAPIHandler *validatorapi.Handler[NodeAPIContextT]
 }
 
 func ProvideNodeAPIHandlers[
@@ -93,6 +97,7 @@ func ProvideNodeAPIHandlers[
 		in.EventsAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.ValidatorAPIHandler,
 	}
 }
 
@@ -131,9 +136,35 @@ func ProvideNodeAPIConfigHandler[
 }
 
 func ProvideNodeAPIDebugHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
+		*Validator, Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT BeaconStateMarshallable[
+		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
+		ExecutionPayloadHeaderT, *Fork, *Validator,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	KVStoreT any,
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *debugapi.Handler[NodeAPIContextT] {
-	return debugapi.NewHandler[NodeAPIContextT]()
+	WithdrawalT Withdrawal[WithdrawalT],
+](b NodeAPIBackend[
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *debugapi.Handler[
+	BeaconStateT, BeaconStateMarshallableT, NodeAPIContextT,
+] {
+	return debugapi.NewHandler[
+		BeaconStateT,
+		BeaconStateMarshallableT,
+		NodeAPIContextT,
+	](b)
 }
 
 func ProvideNodeAPIEventsHandler[
@@ -183,3 +214,18 @@ func ProvideNodeAPIProofHandler[
 		*Validator,
 	](b)
 }
+
+func ProvideNodeAPIValidatorHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT any,
+	NodeT any,
+	NodeAPIContextT NodeAPIContext,
+](b NodeAPIBackend[
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *validatorapi.Handler[NodeAPIContextT] {
+	return validatorapi.NewHandler[NodeAPIContextT](b)
+}
@@ -27,14 +27,17 @@ import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
 type AttributesFactoryInput[LoggerT any] struct {
 	depinject.In
 
-	ChainSpec common.ChainSpec
-	Config    *config.Config
-	Logger    LoggerT
+	ChainSpec     common.ChainSpec
+	Config        *config.Config
+	FeeRecipients *FeeRecipients
+	Logger        LoggerT
+	Signer        crypto.BLSSigner
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -62,6 +65,7 @@ func ProvideAttributesFactory[
 	](
 		in.ChainSpec,
 		in.Logger,
-		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.Signer.PublicKey(),
+		in.FeeRecipients,
 	), nil
 }
@@ -21,15 +21,16 @@
 package components
 
 import (
-	"os"
-
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -43,6 +44,7 @@ type AvailabilityStoreInput[LoggerT any] struct {
 	depinject.In
 	AppOpts   config.AppOptions
 	ChainSpec common.ChainSpec
+	Config    *config.Config
 	Logger    LoggerT
 }
 
@@ -55,19 +57,24 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput[LoggerT],
 ) (*dastore.Store[BeaconBlockBodyT], error) {
+	backend, err := storagedb.BackendFromString(
+		in.Config.Storage.AvailabilityBackend,
+	)
+	if err != nil {
+		return nil, err
+	}
+	db, err := storage.OpenDB(
+		backend,
+		cast.ToString(in.AppOpts.Get(flags.FlagHome))+"/data",
+		"blobs",
+		in.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return dastore.New[BeaconBlockBodyT](
-		filedb.NewRangeDB(
-			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
-				filedb.WithFileExtension("ssz"),
-				filedb.WithDirectoryPermissions(os.ModePerm),
-				filedb.WithLogger(in.Logger),
-			),
-		),
+		filedb.NewRangeDB(db),
 		in.Logger.With("service", "da-store"),
 		in.ChainSpec,
 	), nil
@@ -81,6 +88,7 @@ type AvailabilityPrunerInput[
 	LoggerT any,
 ] struct {
 	depinject.In
+	Archive           *archive.Archive
 	AvailabilityStore AvailabilityStoreT
 	ChainSpec         common.ChainSpec
 	Dispatcher        Dispatcher
@@ -88,7 +96,8 @@ type AvailabilityPrunerInput[
 }
 
 // ProvideAvailabilityPruner provides a availability pruner for the depinject
-// framework.
+// framework. While blobs are archived, the sidecars that are not archived yet
+// are not pruned.
 func ProvideAvailabilityPruner[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
@@ -115,12 +124,17 @@ func ProvideAvailabilityPruner[
 		return nil, err
 	}
 
+	pruneRangeFn := dastore.BuildPruneRangeFn[BeaconBlockT](in.ChainSpec)
+	if in.Archive != nil {
+		pruneRangeFn = archive.HoldBackPruneRange(in.Archive, pruneRangeFn)
+	}
+
 	// build the availability pruner if IndexDB is available.
 	return pruner.NewPruner[BeaconBlockT, AvailabilityStoreT](
 		in.Logger.With("service", manager.AvailabilityPrunerName),
 		in.AvailabilityStore,
 		manager.AvailabilityPrunerName,
 		subFinalizedBlocks,
-		dastore.BuildPruneRangeFn[BeaconBlockT](in.ChainSpec),
+		pruneRangeFn,
 	), nil
 }
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// BlobArchiveInput is the input for the ProvideBlobArchive function for the
+// depinject framework.
+type BlobArchiveInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+}
+
+// ProvideBlobArchive provides the long-term archive of the blob sidecars, or
+// nil if archiving is disabled.
+func ProvideBlobArchive(in BlobArchiveInput) (*archive.Archive, error) {
+	if !in.Config.BlobArchive.Enabled {
+		//nolint:nilnil // archiving is disabled.
+		return nil, nil
+	}
+	backend, err := archive.NewBackend(
+		in.Config.BlobArchive,
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return archive.New(backend), nil
+}
+
+// BlobArchiveServiceInput is the input for the ProvideBlobArchiveService
+// function for the depinject framework.
+type BlobArchiveServiceInput[
+	AvailabilityStoreT any,
+	LoggerT any,
+] struct {
+	depinject.In
+	Archive           *archive.Archive
+	AvailabilityStore AvailabilityStoreT
+	Config            *config.Config
+	Dispatcher        Dispatcher
+	Logger            LoggerT
+}
+
+// ProvideBlobArchiveService provides the service archiving the blob sidecars
+// of finalized blocks.
+func ProvideBlobArchiveService[
+	AvailabilityStoreT archive.AvailabilityStore,
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in BlobArchiveServiceInput[AvailabilityStoreT, LoggerT],
+) *archive.Service[BeaconBlockT] {
+	return archive.NewService[BeaconBlockT](
+		in.Config.BlobArchive,
+		in.Archive,
+		in.AvailabilityStore,
+		in.Dispatcher,
+		in.Logger.With("service", "blob-archive"),
+	)
+}
@@ -40,18 +40,29 @@ import (
 type BlobProofVerifierInput struct {
 	depinject.In
 	AppOpts          config.AppOptions
+	Config           *config.Config
 	JSONTrustedSetup *gokzg4844.JSONTrustedSetup
 }
 
 // ProvideBlobProofVerifier is a function that provides the module to the
-// application.
+// application. The verifier caches verified blobs as configured.
 func ProvideBlobProofVerifier(
 	in BlobProofVerifierInput,
 ) (kzg.BlobProofVerifier, error) {
-	return kzg.NewBlobProofVerifier(
+	verifier, err := kzg.NewBlobProofVerifier(
 		cast.ToString(in.AppOpts.Get(flags.KZGImplementation)),
 		in.JSONTrustedSetup,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Config.KZG.CacheSize > 0 {
+		verifier, err = kzg.NewCachedVerifier(
+			verifier, in.Config.KZG.CacheSize,
+		)
+	}
+	return verifier, err
 }
 
 // BlobVerifierInput is the input for the BlobVerifier.
@@ -84,14 +95,17 @@ type BlobProcessorIn[
 ] struct {
 	depinject.In
 
-	BlobVerifier  BlobVerifier[BlobSidecarsT]
-	ChainSpec     common.ChainSpec
-	Logger        LoggerT
-	TelemetrySink *metrics.TelemetrySink
+	BlobProofVerifier kzg.BlobProofVerifier
+	BlobVerifier      BlobVerifier[BlobSidecarsT]
+	ChainSpec         common.ChainSpec
+	Config            *config.Config
+	Logger            LoggerT
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideBlobProcessor is a function that provides the BlobProcessor to the
-// depinject framework.
+// depinject framework. The processor verifies the sidecars it processes in
+// deferred batches if a batch window is configured.
 func ProvideBlobProcessor[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
 	BeaconBlockBodyT any,
@@ -105,6 +119,14 @@ func ProvideBlobProcessor[
 	AvailabilityStoreT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BlobSidecarT, BlobSidecarsT,
 ] {
+	var deferredVerifier dablob.DeferredVerifier
+	if in.Config.KZG.BatchWindow > 0 {
+		deferredVerifier = kzg.NewBatchVerifier(
+			in.BlobProofVerifier,
+			in.Config.KZG.BatchWindow,
+			in.Config.KZG.BatchMaxBlobs,
+		)
+	}
 	return dablob.NewProcessor[
 		AvailabilityStoreT,
 		BeaconBlockBodyT,
@@ -115,6 +137,7 @@ func ProvideBlobProcessor[
 		in.Logger.With("service", "blob-processor"),
 		in.ChainSpec,
 		in.BlobVerifier,
+		deferredVerifier,
 		types.BlockBodyKZGOffset,
 		in.TelemetrySink,
 	)
@@ -123,6 +146,7 @@ func ProvideBlobProcessor[
 // DAServiceIn is the input for the BlobService.
 type DAServiceIn[
 	AvailabilityStoreT any,
+	BeaconBlockT any,
 	BeaconBlockBodyT any,
 	BlobSidecarsT any,
 	LoggerT any,
@@ -130,32 +154,42 @@ type DAServiceIn[
 	depinject.In
 
 	AvailabilityStore AvailabilityStoreT
+	BlobFetcher       da.BlobFetcher
 	BlobProcessor     BlobProcessor[
 		AvailabilityStoreT, BeaconBlockBodyT, BlobSidecarsT,
 	]
-	Dispatcher Dispatcher
-	Logger     LoggerT
+	Dispatcher     Dispatcher
+	Logger         LoggerT
+	SidecarFactory SidecarFactory[BeaconBlockT, BlobSidecarsT]
 }
 
 // ProvideDAService is a function that provides the BlobService to the
 // depinject framework.
 func ProvideDAService[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
-	BeaconBlockBodyT any,
+	BeaconBlockT da.BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT da.BeaconBlockBody,
 	BlobSidecarT any,
 	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	in DAServiceIn[
-		AvailabilityStoreT, BeaconBlockBodyT, BlobSidecarsT, LoggerT,
+		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
+		LoggerT,
 	],
-) *da.Service[AvailabilityStoreT, BlobSidecarsT] {
+) *da.Service[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
+] {
 	return da.NewService[
 		AvailabilityStoreT,
+		BeaconBlockT,
+		BeaconBlockBodyT,
 		BlobSidecarsT,
 	](
 		in.AvailabilityStore,
 		in.BlobProcessor,
+		in.BlobFetcher,
+		in.SidecarFactory,
 		in.Dispatcher,
 		in.Logger.With("service", "da"),
 	)
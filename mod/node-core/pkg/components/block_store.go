@@ -23,42 +23,64 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 )
 
 // BlockStoreInput is the input for the dep inject framework.
 type BlockStoreInput[
-	BeaconBlockT BeaconBlock[
-		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	],
-	BeaconBlockBodyT any,
-	BeaconBlockHeaderT any,
+	PayloadBodyT any,
 	LoggerT log.AdvancedLogger[LoggerT],
 ] struct {
 	depinject.In
 
-	Config *config.Config
-	Logger LoggerT
+	AppOpts            config.AppOptions
+	ChainSpec          common.ChainSpec
+	Config             *config.Config
+	Logger             LoggerT
+	PayloadBodyFetcher block.PayloadBodyFetcher[PayloadBodyT]
 }
 
 // ProvideBlockStore is a function that provides the module to the
-// application.
+// application. The blocks are kept in a PebbleDB database, since the keys
+// indexing them are binary.
 func ProvideBlockStore[
-	BeaconBlockT BeaconBlock[
-		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconBlockT block.BeaconBlock[BlindedBeaconBlockT],
+	BlindedBeaconBlockT block.BlindedBeaconBlock[
+		BlindedBeaconBlockT, BeaconBlockT, PayloadBodyT,
 	],
-	BeaconBlockBodyT any,
-	BeaconBlockHeaderT any,
+	PayloadBodyT any,
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
-	in BlockStoreInput[
-		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT, LoggerT,
-	],
-) (*block.KVStore[BeaconBlockT], error) {
-	return block.NewStore[BeaconBlockT](
-		in.Logger.With("service", manager.BlockStoreName),
+	in BlockStoreInput[PayloadBodyT, LoggerT],
+) (*block.KVStore[BeaconBlockT, BlindedBeaconBlockT, PayloadBodyT], error) {
+	logger := in.Logger.With("service", manager.BlockStoreName)
+	db, err := storage.OpenDB(
+		storagedb.BackendPebbleDB,
+		cast.ToString(in.AppOpts.Get(flags.FlagHome))+"/data",
+		"blocks",
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := block.NewStore[BeaconBlockT](
+		logger,
+		db,
 		in.Config.BlockStoreService.AvailabilityWindow,
-	), nil
+		in.ChainSpec.DepositEth1ChainID(),
+		in.PayloadBodyFetcher,
+	)
+	if err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+	return store, nil
 }
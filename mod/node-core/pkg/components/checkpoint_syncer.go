@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"net/http"
+	"time"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/checkpoint"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
+	"github.com/berachain/beacon-kit/mod/config"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/spf13/cast"
+)
+
+// checkpointSyncTimeout bounds each request to the trusted node.
+const checkpointSyncTimeout = 30 * time.Second
+
+// CheckpointSyncerInput is the input for the checkpoint syncer provider.
+type CheckpointSyncerInput[
+	LoggerT any,
+	StorageBackendT any,
+] struct {
+	depinject.In
+	AppOpts        config.AppOptions
+	Logger         LoggerT
+	StorageBackend StorageBackendT
+}
+
+// ProvideCheckpointSyncer provides the syncer verifying the state a fresh
+// node restores from a snapshot against a trusted checkpoint. It returns nil
+// if checkpoint sync is disabled.
+func ProvideCheckpointSyncer[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT checkpoint.BeaconState[BeaconBlockHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT checkpoint.StorageBackend[BeaconStateT],
+](
+	in CheckpointSyncerInput[LoggerT, StorageBackendT],
+) cometbft.CheckpointSyncer {
+	url := cast.ToString(in.AppOpts.Get(server.FlagCheckpointSyncURL))
+	if url == "" {
+		return nil
+	}
+	return checkpoint.NewSyncer[BeaconBlockHeaderT, BeaconStateT](
+		in.Logger.With("service", "checkpoint-sync"),
+		url,
+		&http.Client{Timeout: checkpointSyncTimeout},
+		in.StorageBackend,
+	)
+}
@@ -42,7 +42,18 @@ func ProvideCometBFTService[
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
 	chainSpec common.ChainSpec,
+	checkpointSyncer cometbft.CheckpointSyncer,
+	validatorResolver cometbft.ValidatorResolver,
 ) *cometbft.Service[LoggerT] {
+	opts := builder.DefaultServiceOptions[LoggerT](appOpts)
+	opts = append(opts, cometbft.SetValidatorResolver[LoggerT](
+		validatorResolver,
+	))
+	if checkpointSyncer != nil {
+		opts = append(opts, cometbft.SetCheckpointSyncer[LoggerT](
+			checkpointSyncer,
+		))
+	}
 	return cometbft.NewService(
 		storeKey,
 		logger,
@@ -50,6 +61,6 @@ func ProvideCometBFTService[
 		abciMiddleware,
 		cmtCfg,
 		chainSpec,
-		builder.DefaultServiceOptions[LoggerT](appOpts)...,
+		opts...,
 	)
 }
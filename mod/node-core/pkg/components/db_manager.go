@@ -21,6 +21,8 @@
 package components
 
 import (
+	"io"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -30,26 +32,43 @@ import (
 // DBManagerInput is the input for the dep inject framework.
 type DBManagerInput[
 	AvailabilityStoreT pruner.Prunable,
+	BlockStoreT any,
 	DepositStoreT pruner.Prunable,
 	LoggerT any,
 ] struct {
 	depinject.In
 	AvailabilityPruner pruner.Pruner[AvailabilityStoreT]
+	BlockStore         BlockStoreT
 	DepositPruner      pruner.Pruner[DepositStoreT]
+	DepositStore       DepositStoreT
 	Logger             LoggerT
 }
 
 // ProvideDBManager provides a DBManager for the depinject framework.
 func ProvideDBManager[
 	AvailabilityStoreT pruner.Prunable,
-	DepositStoreT pruner.Prunable,
+	BlockStoreT io.Closer,
+	DepositStoreT interface {
+		pruner.Prunable
+		io.Closer
+	},
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
-	in DBManagerInput[AvailabilityStoreT, DepositStoreT, LoggerT],
+	in DBManagerInput[
+		AvailabilityStoreT, BlockStoreT, DepositStoreT, LoggerT,
+	],
 ) (*manager.DBManager, error) {
-	return manager.NewDBManager(
+	m, err := manager.NewDBManager(
 		in.Logger.With("service", "db-manager"),
 		in.DepositPruner,
 		in.AvailabilityPruner,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The deposit store owns its own database, which is closed once the
+	// deposit pruner is done with it. So does the block store.
+	m.RegisterClosers(in.DepositStore, in.BlockStore)
+	return m, nil
 }
@@ -65,7 +65,7 @@ func ProvideDepositService[
 	],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT, *AttestationData, DepositT,
-		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT, *SlashingInfo,
 	],
 	BeaconBlockHeaderT any,
 	DepositT Deposit[
@@ -22,13 +22,13 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
-	storev2 "cosmossdk.io/store/v2/db"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -37,9 +37,11 @@ import (
 )
 
 // DepositStoreInput is the input for the dep inject framework.
-type DepositStoreInput struct {
+type DepositStoreInput[LoggerT any] struct {
 	depinject.In
 	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  LoggerT
 }
 
 // ProvideDepositStore is a function that provides the module to the
@@ -48,17 +50,27 @@ func ProvideDepositStore[
 	DepositT Deposit[
 		DepositT, *ForkData, WithdrawalCredentials,
 	],
+	LoggerT log.AdvancedLogger[LoggerT],
 ](
-	in DepositStoreInput,
+	in DepositStoreInput[LoggerT],
 ) (*depositstore.KVStore[DepositT], error) {
-	name := "deposits"
-	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
-	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	backend, err := storagedb.DepositBackendFromString(
+		in.Config.Storage.DepositBackend,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return depositstore.NewStore[DepositT](storage.NewKVStoreProvider(kvp)), nil
+	db, err := storage.OpenDB(
+		backend,
+		cast.ToString(in.AppOpts.Get(flags.FlagHome))+"/data",
+		"deposits",
+		in.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return depositstore.NewStore[DepositT](db), nil
 }
 
 // DepositPrunerInput is the input for the deposit pruner.
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
@@ -45,7 +46,8 @@ type EngineClientInputs[LoggerT any] struct {
 	TelemetrySink *metrics.TelemetrySink
 }
 
-// ProvideEngineClient creates a new EngineClient.
+// ProvideEngineClient creates a new EngineClient, recording its traffic with
+// the execution client if configured to.
 func ProvideEngineClient[
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
@@ -56,20 +58,34 @@ func ProvideEngineClient[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ](
 	in EngineClientInputs[LoggerT],
-) *client.EngineClient[
+) (*client.EngineClient[
 	ExecutionPayloadT,
 	*engineprimitives.PayloadAttributes[WithdrawalT],
-] {
+], error) {
+	var (
+		cfg      = in.Config.GetEngine()
+		recorder *ethclientrpc.Recorder
+		err      error
+	)
+	if cfg.RecordPath != "" {
+		if recorder, err = ethclientrpc.NewRecorder(
+			cfg.RecordPath, cfg.RecordMaxSize, cfg.RecordMaxFiles,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	return client.New[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	](
-		in.Config.GetEngine(),
+		cfg,
 		in.Logger.With("service", "engine.client"),
 		in.JWTSecret,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
-	)
+		recorder,
+	), nil
 }
 
 // EngineClientInputs is the input for the EngineClient.
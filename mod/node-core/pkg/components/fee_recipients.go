@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/recipient"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// FeeRecipientsInput is the input for the fee recipients provider.
+type FeeRecipientsInput[LoggerT any] struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  LoggerT
+}
+
+// ProvideFeeRecipients provides the registry resolving the fee recipient of
+// each local proposer.
+func ProvideFeeRecipients[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in FeeRecipientsInput[LoggerT],
+) (*FeeRecipients, error) {
+	path := in.Config.PayloadBuilder.FeeRecipientsPath
+	if path != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)), path,
+		)
+	}
+	return recipient.NewRegistry(
+		in.Logger.With("service", "fee-recipients"),
+		path,
+		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+	)
+}
@@ -24,11 +24,14 @@ import (
 	stdbytes "bytes"
 	"context"
 	"encoding/json"
+	"io"
+	"net/http"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -78,6 +81,9 @@ type (
 		// Persist makes sure that the sidecar remains accessible for data
 		// availability checks throughout the beacon node's operation.
 		Persist(math.Slot, BlobSidecarsT) error
+		// GetBlobSidecars returns the sidecars stored for the block at the
+		// given slot.
+		GetBlobSidecars(math.Slot) (BlobSidecarsT, error)
 	}
 
 	// BeaconBlock represents a generic interface for a beacon block.
@@ -92,6 +98,11 @@ type (
 		constraints.SSZMarshallableRootable
 
 		NewFromSSZ([]byte, uint32) (T, error)
+		// NewFromSignedSSZ creates a new beacon block from the SSZ encoding
+		// of a signed beacon block.
+		NewFromSignedSSZ([]byte, uint32) (T, error)
+		// MarshalSignedSSZ marshals the block along with its signature.
+		MarshalSignedSSZ() ([]byte, error)
 		// NewWithVersion creates a new beacon block with the given parameters.
 		NewWithVersion(
 			slot math.Slot,
@@ -116,6 +127,10 @@ type (
 		// GetTimestamp returns the timestamp of the block from the execution
 		// payload.
 		GetTimestamp() math.U64
+		// GetSignature returns the proposer signature of the block.
+		GetSignature() crypto.BLSSignature
+		// SetSignature sets the proposer signature of the block.
+		SetSignature(crypto.BLSSignature)
 	}
 
 	// BeaconBlockBody represents a generic interface for the body of a beacon
@@ -126,6 +141,7 @@ type (
 		DepositT any,
 		Eth1DataT any,
 		ExecutionPayloadT any,
+		ExecutionPayloadHeaderT any,
 		SlashingInfoT any,
 	] interface {
 		constraints.Nillable
@@ -133,6 +149,9 @@ type (
 		constraints.SSZMarshallableRootable
 		Length() uint64
 		GetTopLevelRoots() []common.Root
+		// BlindedHashTreeRoot returns the hash tree root of the body with its
+		// execution payload replaced by the given header.
+		BlindedHashTreeRoot(ExecutionPayloadHeaderT) common.Root
 		// GetRandaoReveal returns the RANDAO reveal signature.
 		GetRandaoReveal() crypto.BLSSignature
 		// GetExecutionPayload returns the execution payload.
@@ -192,6 +211,10 @@ type (
 	] interface {
 		constraints.SSZMarshallableRootable
 		GetTree() (*fastssz.Node, error)
+		// Version returns the fork version the state is laid out for.
+		Version() uint32
+		// MarshalSSZToWriter streams the SSZ encoding into the given writer.
+		MarshalSSZToWriter(w io.Writer) error
 		// New returns a new instance of the BeaconStateMarshallable.
 		New(
 			forkVersion uint32,
@@ -210,9 +233,18 @@ type (
 			nextWithdrawalIndex uint64,
 			nextWithdrawalValidatorIndex math.U64,
 			slashings []math.U64, totalSlashing math.U64,
+			historicalSummaries []*common.HistoricalSummary,
 		) (T, error)
 	}
 
+	// BlobArchive is the interface for the long-term archive of the blob
+	// sidecars.
+	BlobArchive[BlobSidecarsT any] interface {
+		// GetBlobSidecars returns the archived sidecars of the block at the
+		// given slot.
+		GetBlobSidecars(context.Context, math.Slot) (BlobSidecarsT, error)
+	}
+
 	// BlobProcessor is the interface for the blobs processor.
 	BlobProcessor[
 		AvailabilityStoreT any,
@@ -228,6 +260,7 @@ type (
 		// VerifySidecars verifies the blobs and ensures they match the local
 		// state.
 		VerifySidecars(
+			ctx context.Context,
 			sidecars BlobSidecarsT,
 		) error
 	}
@@ -247,14 +280,17 @@ type (
 		Len() int
 		Get(index int) BlobSidecarT
 		GetSidecars() []BlobSidecarT
+		Filter(indices []uint64) T
 		ValidateBlockRoots() error
 		VerifyInclusionProofs(kzgOffset uint64) error
 	}
 
 	BlobVerifier[BlobSidecarsT any] interface {
 		VerifyInclusionProofs(scs BlobSidecarsT, kzgOffset uint64) error
-		VerifyKZGProofs(scs BlobSidecarsT) error
-		VerifySidecars(sidecars BlobSidecarsT, kzgOffset uint64) error
+		VerifyKZGProofs(ctx context.Context, scs BlobSidecarsT) error
+		VerifySidecars(
+			ctx context.Context, sidecars BlobSidecarsT, kzgOffset uint64,
+		) error
 	}
 
 	// 	// BlockchainService defines the interface for interacting with the
@@ -298,6 +334,17 @@ type (
 		// GetParentSlotByTimestamp retrieves the parent slot by a given
 		// timestamp from the store.
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+		// GetSignatureBySlot retrieves the proposer signature of the block
+		// at the given slot from the store.
+		GetSignatureBySlot(slot math.Slot) (crypto.BLSSignature, error)
+		// GetParentBlockRootBySlot retrieves the parent root of the block at
+		// the given slot from the store.
+		GetParentBlockRootBySlot(slot math.Slot) (common.Root, error)
+		// GetBlockBySlot retrieves the full block at the given slot,
+		// rebuilding its execution payload from the execution client.
+		GetBlockBySlot(
+			ctx context.Context, slot math.Slot,
+		) (BeaconBlockT, error)
 	}
 
 	ConsensusEngine interface {
@@ -497,9 +544,14 @@ type (
 	// ExecutionPayloadHeader is the interface for the execution payload
 	// header.
 	ExecutionPayloadHeader[T any] interface {
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 		constraints.Versionable
 		NewFromSSZ([]byte, uint32) (T, error)
+		// GetPrevRandao returns the prev randao of the ExecutionPayloadHeader.
+		GetPrevRandao() common.Bytes32
+		// GetWithdrawalsRoot returns the withdrawals root of the
+		// ExecutionPayloadHeader.
+		GetWithdrawalsRoot() common.Root
 		// GetNumber returns the block number of the ExecutionPayloadHeader.
 		GetNumber() math.U64
 		// GetFeeRecipient returns the fee recipient address of the
@@ -530,6 +582,41 @@ type (
 	// 		) common.Root
 	// 	}
 
+	// ExternalBuilder is the interface for the service sourcing payloads
+	// from a block builder outside of the node.
+	ExternalBuilder[
+		BeaconBlockT any,
+		ExecutionPayloadT any,
+		ExecutionPayloadHeaderT any,
+	] interface {
+		// Start starts the service.
+		Start(ctx context.Context) error
+		// Name returns the name of the service.
+		Name() string
+		// Enabled returns true if the external builder is enabled.
+		Enabled() bool
+		// GetHeader returns the header of the best payload offered for the
+		// given slot, the commitments to its blobs and the value of the offer.
+		GetHeader(
+			ctx context.Context,
+			slot math.Slot,
+			parentHash common.ExecutionHash,
+		) (
+			ExecutionPayloadHeaderT,
+			eip4844.KZGCommitments[common.ExecutionHash],
+			*math.U256,
+			error,
+		)
+		// SubmitBlindedBlock signs the block blinded over the given header
+		// and returns the payload and blobs revealed in exchange.
+		SubmitBlindedBlock(
+			ctx context.Context,
+			blk BeaconBlockT,
+			header ExecutionPayloadHeaderT,
+			genesisValidatorsRoot common.Root,
+		) (ExecutionPayloadT, engineprimitives.BlobsBundle, error)
+	}
+
 	// Genesis is the interface for the genesis.
 	Genesis[DepositT any, ExecutionPayloadHeaderT any] interface {
 		json.Unmarshaler
@@ -543,6 +630,7 @@ type (
 
 	// IndexDB is the interface for the range DB.
 	IndexDB interface {
+		Get(index uint64, key []byte) ([]byte, error)
 		Has(index uint64, key []byte) (bool, error)
 		Set(index uint64, key []byte, value []byte) error
 		Prune(start uint64, end uint64) error
@@ -910,6 +998,21 @@ type (
 		UpdateBlockRootAtIndex(index uint64, root common.Root) error
 		// UpdateStateRootAtIndex updates the state root at the given index.
 		UpdateStateRootAtIndex(index uint64, root common.Root) error
+		// AddHistoricalSummary appends a historical summary of the period
+		// starting at the given slot.
+		AddHistoricalSummary(
+			summary *common.HistoricalSummary, periodStart math.Slot,
+		) error
+		// GetHistoricalSummaries retrieves all historical summaries.
+		GetHistoricalSummaries() ([]*common.HistoricalSummary, error)
+		// GetHistoricalSummariesStartSlot retrieves the first slot of the
+		// period of the first historical summary.
+		GetHistoricalSummariesStartSlot() (math.Slot, error)
+		// GetHistoricalSummaryAtIndex retrieves the historical summary at the
+		// given index.
+		GetHistoricalSummaryAtIndex(
+			index uint64,
+		) (*common.HistoricalSummary, error)
 		// UpdateRandaoMixAtIndex updates the randao mix at the given index.
 		UpdateRandaoMixAtIndex(index uint64, mix common.Bytes32) error
 		// UpdateValidatorAtIndex updates the validator at the given index.
@@ -942,6 +1045,7 @@ type (
 		ValidatorT, ValidatorsT, WithdrawalT any,
 	] interface {
 		ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT]
+		ReadOnlyHistoricalSummaries
 		ReadOnlyRandaoMixes
 		ReadOnlyStateRoots
 		ReadOnlyValidators[ValidatorT]
@@ -974,6 +1078,7 @@ type (
 		ForkT, ValidatorT any,
 	] interface {
 		WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT]
+		WriteOnlyHistoricalSummaries
 		WriteOnlyRandaoMixes
 		WriteOnlyStateRoots
 		WriteOnlyValidators[ValidatorT]
@@ -991,6 +1096,20 @@ type (
 		SetTotalSlashing(math.Gwei) error
 	}
 
+	// WriteOnlyHistoricalSummaries defines a struct which only has write
+	// access to historical summaries methods.
+	WriteOnlyHistoricalSummaries interface {
+		AddHistoricalSummary(*common.HistoricalSummary, math.Slot) error
+	}
+
+	// ReadOnlyHistoricalSummaries defines a struct which only has read access
+	// to historical summaries methods.
+	ReadOnlyHistoricalSummaries interface {
+		GetHistoricalSummaries() ([]*common.HistoricalSummary, error)
+		GetHistoricalSummaryAtIndex(uint64) (*common.HistoricalSummary, error)
+		GetHistoricalSummariesStartSlot() (math.Slot, error)
+	}
+
 	// WriteOnlyStateRoots defines a struct which only has write access to state
 	// roots methods.
 	WriteOnlyStateRoots interface {
@@ -1072,11 +1191,13 @@ type (
 	NodeAPIContext interface {
 		Bind(any) error
 		Validate(any) error
+		Request() *http.Request
 	}
 
 	// Engine is a generic interface for an API engine.
 	NodeAPIEngine[ContextT NodeAPIContext] interface {
 		Run(addr string) error
+		Shutdown(ctx context.Context) error
 		RegisterRoutes(*handlers.RouteSet[ContextT], log.Logger)
 	}
 
@@ -1099,6 +1220,7 @@ type (
 		NodeAPIProofBackend[
 			BeaconBlockHeaderT, BeaconStateT, ForkT, ValidatorT,
 		]
+		NodeAPIValidatorBackend
 	}
 
 	// NodeAPIBackend is the interface for backend of the beacon API.
@@ -1107,10 +1229,13 @@ type (
 	] interface {
 		GenesisBackend
 		BlockBackend[BeaconBlockHeaderT]
+		BlobBackend
 		RandaoBackend
 		StateBackend[BeaconStateT, ForkT]
+		CommitteeBackend
 		ValidatorBackend[ValidatorT]
 		HistoricalBackend[ForkT]
+		LightClientBackend[BeaconBlockHeaderT]
 		// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 		// GetSlotByStateRoot retrieves the slot by a given root from the store.
@@ -1124,6 +1249,33 @@ type (
 		BlockBackend[BeaconBlockHeaderT]
 		StateBackend[BeaconStateT, ForkT]
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+		HistoricalBlockRootsAtSlot(
+			slot math.Slot,
+		) ([]common.Root, uint64, error)
+	}
+
+	// NodeAPIValidatorBackend is the interface for backend of the validator
+	// API.
+	NodeAPIValidatorBackend interface {
+		ProposerDutiesAtEpoch(
+			epoch math.Epoch,
+		) (common.Root, []*validatortypes.ProposerDutyData, error)
+		PrepareBeaconProposers(
+			recipients map[math.ValidatorIndex]common.ExecutionAddress,
+		) error
+	}
+
+	CommitteeBackend interface {
+		FinalityCheckpointsAtSlot(
+			slot math.Slot,
+		) (*types.FinalityCheckpointsData, error)
+		CommitteesAtSlot(
+			slot math.Slot, epoch math.Epoch,
+		) ([]*types.CommitteeData, error)
+		SyncCommitteesAtSlot(
+			slot math.Slot, epoch math.Epoch,
+		) (*types.SyncCommitteeData, error)
+		ValidatorSetAtSlot(slot math.Slot) (*types.ValidatorSetData, error)
 	}
 
 	GenesisBackend interface {
@@ -1135,14 +1287,36 @@ type (
 		StateForkAtSlot(slot math.Slot) (ForkT, error)
 	}
 
+	LightClientBackend[BeaconBlockHeaderT any] interface {
+		LightClientBootstrap(
+			slot math.Slot,
+		) (*types.LightClientResponse[BeaconBlockHeaderT], error)
+		LightClientUpdates(
+			startPeriod, count uint64,
+		) ([]*types.LightClientResponse[BeaconBlockHeaderT], error)
+		LightClientLatestUpdate() (
+			*types.LightClientResponse[BeaconBlockHeaderT], error,
+		)
+	}
+
 	RandaoBackend interface {
 		RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 	}
 
+	BlobBackend interface {
+		BlobSidecarsAtSlot(
+			ctx context.Context, slot math.Slot, indices []uint64,
+		) (*types.ValidatorResponse, error)
+	}
+
 	BlockBackend[BeaconBlockHeaderT any] interface {
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+		BlockSignatureAtSlot(slot math.Slot) (crypto.BLSSignature, error)
+		BlockAtSlot(
+			ctx context.Context, slot math.Slot,
+		) (*types.BlockResponse, error)
 	}
 
 	StateBackend[BeaconStateT, ForkT any] interface {
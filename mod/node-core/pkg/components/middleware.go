@@ -22,6 +22,8 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
@@ -36,6 +38,7 @@ type ABCIMiddlewareInput[
 ] struct {
 	depinject.In
 	ChainSpec     common.ChainSpec
+	Config        *config.Config
 	Dispatcher    Dispatcher
 	Logger        LoggerT
 	TelemetrySink *metrics.TelemetrySink
@@ -58,6 +61,12 @@ func ProvideABCIMiddleware[
 ) (*middleware.ABCIMiddleware[
 	BeaconBlockT, BlobSidecarsT, GenesisT, *SlotData,
 ], error) {
+	compression, err := encoding.CompressionFromString(
+		in.Config.Validator.ProposalCompression,
+	)
+	if err != nil {
+		return nil, err
+	}
 	return middleware.NewABCIMiddleware[
 		BeaconBlockT,
 		BlobSidecarsT,
@@ -65,6 +74,7 @@ func ProvideABCIMiddleware[
 		*SlotData,
 	](
 		in.ChainSpec,
+		compression,
 		in.Dispatcher,
 		in.Logger,
 		in.TelemetrySink,
@@ -21,6 +21,7 @@
 package components
 
 import (
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/node"
 	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
@@ -31,6 +32,7 @@ import (
 func ProvideNode(
 	registry *service.Registry,
 	logger *phuslu.Logger,
+	cmtService *cometbft.Service[*phuslu.Logger],
 ) types.Node {
-	return node.New[types.Node](registry, logger)
+	return node.New[types.Node](registry, logger, cmtService)
 }
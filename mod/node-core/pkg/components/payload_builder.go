@@ -55,7 +55,8 @@ type LocalBuilderInput[
 		PayloadID,
 		WithdrawalsT,
 	]
-	Logger LoggerT
+	FeeRecipients *FeeRecipients
+	Logger        LoggerT
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
@@ -98,5 +99,6 @@ func ProvideLocalBuilder[
 			[32]byte, math.Slot,
 		](),
 		in.AttributesFactory,
+		in.FeeRecipients,
 	)
 }
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// RelayInput is the input for the relay provider.
+type RelayInput[LoggerT any] struct {
+	depinject.In
+	Cfg           *config.Config
+	ChainSpec     common.ChainSpec
+	FeeRecipients *FeeRecipients
+	Logger        LoggerT
+	Signer        crypto.BLSSigner
+}
+
+// ProvideRelay provides the client of the external block builder relay.
+func ProvideRelay[
+	BeaconBlockT relay.BeaconBlock[
+		BlindedBeaconBlockT, ExecutionPayloadHeaderT,
+	],
+	BlindedBeaconBlockT relay.BlindedBeaconBlock[BlindedBeaconBlockBodyT],
+	BlindedBeaconBlockBodyT relay.BlindedBeaconBlockBody[ExecutionPayloadHeaderT],
+	BuilderBidT relay.BuilderBid[ExecutionPayloadHeaderT],
+	ExecutionPayloadT relay.ExecutionPayload[ExecutionPayloadHeaderT],
+	ExecutionPayloadAndBlobsBundleT relay.ExecutionPayloadAndBlobsBundle[ExecutionPayloadT],
+	ExecutionPayloadHeaderT relay.ExecutionPayloadHeader,
+	LoggerT log.AdvancedLogger[LoggerT],
+	SignedBlindedBeaconBlockT relay.SignedBlindedBeaconBlock[
+		SignedBlindedBeaconBlockT, BlindedBeaconBlockT, *ForkData,
+	],
+	SignedBuilderBidT relay.SignedBuilderBid[BuilderBidT, *ForkData],
+	SignedValidatorRegistrationT relay.SignedValidatorRegistration[
+		SignedValidatorRegistrationT, *ForkData,
+	],
+](
+	in RelayInput[LoggerT],
+) (*relay.Builder[
+	BeaconBlockT, BlindedBeaconBlockT, BlindedBeaconBlockBodyT, BuilderBidT,
+	ExecutionPayloadT, ExecutionPayloadAndBlobsBundleT,
+	ExecutionPayloadHeaderT, *ForkData, SignedBlindedBeaconBlockT,
+	SignedBuilderBidT, SignedValidatorRegistrationT,
+], error) {
+	return relay.NewBuilder[
+		BeaconBlockT, BlindedBeaconBlockT, BlindedBeaconBlockBodyT,
+		BuilderBidT, ExecutionPayloadT, ExecutionPayloadAndBlobsBundleT,
+		ExecutionPayloadHeaderT, *ForkData, SignedBlindedBeaconBlockT,
+		SignedBuilderBidT, SignedValidatorRegistrationT,
+	](
+		&in.Cfg.Relay,
+		in.Logger.With("service", "relay"),
+		in.ChainSpec,
+		in.Signer,
+		in.FeeRecipients,
+	)
+}
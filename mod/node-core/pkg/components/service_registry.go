@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
 	"github.com/berachain/beacon-kit/mod/da/pkg/da"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
@@ -34,8 +35,10 @@ import (
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/slashing"
 	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
 	"github.com/berachain/beacon-kit/mod/observability/pkg/telemetry"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/tracing"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
@@ -44,7 +47,7 @@ type ServiceRegistryInput[
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT, *AttestationData, DepositT,
-		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT, *SlashingInfo,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconBlockStoreT BlockStore[BeaconBlockT],
@@ -73,7 +76,8 @@ type ServiceRegistryInput[
 	ABCIService *middleware.ABCIMiddleware[
 		BeaconBlockT, BlobSidecarsT, GenesisT, *SlotData,
 	]
-	BlockStoreService *blockstore.Service[
+	BlobArchiveService *archive.Service[BeaconBlockT]
+	BlockStoreService  *blockstore.Service[
 		BeaconBlockT, BeaconBlockStoreT,
 	]
 	ChainService *blockchain.Service[
@@ -82,7 +86,9 @@ type ServiceRegistryInput[
 		ExecutionPayloadHeaderT, GenesisT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
-	DAService      *da.Service[AvailabilityStoreT, BlobSidecarsT]
+	DAService *da.Service[
+		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
+	]
 	DBManager      *DBManager
 	DepositService *deposit.Service[
 		BeaconBlockT, BeaconBlockBodyT, DepositT,
@@ -93,13 +99,19 @@ type ServiceRegistryInput[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
+	ExternalBuilder ExternalBuilder[
+		BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	]
+	FeeRecipients    *FeeRecipients
 	Logger           LoggerT
 	NodeAPIServer    *server.Server[NodeAPIContextT]
 	ReportingService *ReportingService
+	SlashingService  *slashing.Service[BeaconBlockT]
 	TelemetrySink    *metrics.TelemetrySink
 	TelemetryService *telemetry.Service
+	TracingService   *tracing.Service
 	ValidatorService *validator.Service[
-		*AttestationData, BeaconBlockT, BeaconBlockBodyT,
+		*AttestationData, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT,
 		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
 		*ForkData, *SlashingInfo, *SlotData,
@@ -113,7 +125,7 @@ func ProvideServiceRegistry[
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT, *AttestationData, DepositT,
-		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT, *SlashingInfo,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconBlockStoreT BlockStore[BeaconBlockT],
@@ -147,17 +159,26 @@ func ProvideServiceRegistry[
 ) *service.Registry {
 	return service.NewRegistry(
 		service.WithLogger(in.Logger),
+		// The tracing service is started first so that it is stopped last,
+		// after the spans of the other services have ended.
+		service.WithService(in.TracingService),
+		// The DB manager closes the databases it prunes on stop, so it is
+		// stopped after every service that reads from or writes to them.
+		service.WithService(in.DBManager),
 		service.WithService(in.ABCIService),
 		service.WithService(in.Dispatcher),
+		service.WithService(in.SlashingService),
 		service.WithService(in.ValidatorService),
 		service.WithService(in.BlockStoreService),
 		service.WithService(in.ChainService),
 		service.WithService(in.DAService),
+		service.WithService(in.BlobArchiveService),
 		service.WithService(in.DepositService),
 		service.WithService(in.NodeAPIServer),
 		service.WithService(in.ReportingService),
-		service.WithService(in.DBManager),
 		service.WithService(in.EngineClient),
+		service.WithService(in.FeeRecipients),
+		service.WithService(in.ExternalBuilder),
 		service.WithService(in.TelemetryService),
 		service.WithService(in.CometBFTService),
 	)
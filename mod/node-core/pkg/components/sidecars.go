@@ -40,7 +40,7 @@ func ProvideSidecarFactory[
 	],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT, *AttestationData, DepositT,
-		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT, *SlashingInfo,
 	],
 	BeaconBlockHeaderT any,
 	DepositT any,
@@ -25,7 +25,9 @@ import (
 
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/slashing"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -37,10 +39,22 @@ type BlsSignerInput struct {
 	depinject.In
 	AppOpts config.AppOptions
 	PrivKey LegacyKey `optional:"true"`
+	// SlashingProtection guards the signer when set.
+	SlashingProtection *slashing.DB `optional:"true"`
 }
 
 // ProvideBlsSigner is a function that provides the module to the application.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	blsSigner, err := provideBlsSigner(in)
+	if err != nil || in.SlashingProtection == nil {
+		return blsSigner, err
+	}
+	return slashing.NewSigner(blsSigner, in.SlashingProtection), nil
+}
+
+// provideBlsSigner returns the signer of the node key, or of the legacy key
+// if one is given.
+func provideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
 	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
 		// if no private key is provided, use privval signer
 		homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
@@ -62,3 +76,53 @@ func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
 	}
 	return signer.NewLegacySigner(in.PrivKey)
 }
+
+// SlashingProtectionInput is the input for the slashing protection database
+// provider.
+type SlashingProtectionInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+}
+
+// ProvideSlashingProtection provides the slashing protection database, or nil
+// if slashing protection is disabled.
+func ProvideSlashingProtection(
+	in SlashingProtectionInput,
+) (*slashing.DB, error) {
+	path := in.Config.Validator.SlashingProtectionPath
+	if path == "" {
+		//nolint:nilnil // slashing protection is disabled.
+		return nil, nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)), path,
+		)
+	}
+	return slashing.NewDB(path)
+}
+
+// SlashingProtectionServiceInput is the input for the slashing protection
+// service provider.
+type SlashingProtectionServiceInput[LoggerT any] struct {
+	depinject.In
+	Dispatcher         Dispatcher
+	Logger             LoggerT
+	SlashingProtection *slashing.DB `optional:"true"`
+}
+
+// ProvideSlashingProtectionService provides the service that marks committed
+// slots in the slashing protection database.
+func ProvideSlashingProtectionService[
+	BeaconBlockT slashing.BeaconBlock,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in SlashingProtectionServiceInput[LoggerT],
+) *slashing.Service[BeaconBlockT] {
+	return slashing.NewService[BeaconBlockT](
+		in.SlashingProtection,
+		in.Dispatcher,
+		in.Logger.With("service", "slashing-protection"),
+	)
+}
@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// SignedMessage is the message signed at the highest slot of a domain type.
+type SignedMessage struct {
+	// Slot is the slot of the message.
+	Slot math.Slot `json:"slot"`
+	// SigningRoot is the signing root of the message, zero when unknown.
+	SigningRoot common.Root `json:"signing_root"`
+	// Committed is set once the chain committed a block at the slot, after
+	// which no other message may be signed for it.
+	Committed bool `json:"committed,omitempty"`
+}
+
+// AttestationWatermark is the highest source and target epochs attested to.
+type AttestationWatermark struct {
+	// SourceEpoch is the highest source epoch attested to.
+	SourceEpoch math.Epoch `json:"source_epoch"`
+	// TargetEpoch is the highest target epoch attested to.
+	TargetEpoch math.Epoch `json:"target_epoch"`
+}
+
+// history is the signing history of a single validator.
+type history struct {
+	// Messages holds the highest-slot message of each domain type.
+	Messages map[common.DomainType]*SignedMessage `json:"messages"`
+	// Attestations holds the attestation watermark, only ever imported.
+	Attestations *AttestationWatermark `json:"attestations,omitempty"`
+}
+
+// state is the persisted content of the database.
+type state struct {
+	// GenesisValidatorsRoot identifies the chain the history belongs to.
+	GenesisValidatorsRoot *common.Root `json:"genesis_validators_root,omitempty"`
+	// Validators holds the signing history of each validator.
+	Validators map[crypto.BLSPubkey]*history `json:"validators"`
+}
+
+// DB is a slashing protection database. It keeps, for every validator and
+// domain type, the message signed at the highest slot and refuses to sign
+// anything below that slot. A different message at that slot is only signed
+// as long as the chain has not committed a block at the slot: CometBFT asks
+// the proposer of every round of a height for a new block, and refusing it
+// would leave the height without a proposal in every later round it leads.
+// Once a block is committed, the slot only accepts the very same message.
+// The database is a JSON file that is rewritten atomically before every new
+// signature is released.
+type DB struct {
+	mu    sync.Mutex
+	path  string
+	state state
+}
+
+// NewDB opens the database at the given path, creating it if needed.
+func NewDB(path string) (*DB, error) {
+	db := &DB{
+		path: path,
+		state: state{
+			Validators: make(map[crypto.BLSPubkey]*history),
+		},
+	}
+	//#nosec:G304 // the path is configured by the operator.
+	bz, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return db, nil
+	case err != nil:
+		return nil, err
+	}
+	if err = json.Unmarshal(bz, &db.state); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s", path)
+	}
+	if db.state.Validators == nil {
+		db.state.Validators = make(map[crypto.BLSPubkey]*history)
+	}
+	return db, nil
+}
+
+// CheckAndRecord checks that the validator may sign the message with the
+// given signing root for the domain type at the given slot, and records it
+// if so. The record is persisted before returning, so the signature may only
+// be released once CheckAndRecord succeeded.
+func (db *DB) CheckAndRecord(
+	pubkey crypto.BLSPubkey,
+	domainType common.DomainType,
+	slot math.Slot,
+	signingRoot common.Root,
+) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	h := db.history(pubkey)
+	if last, ok := h.Messages[domainType]; ok {
+		switch {
+		case slot < last.Slot:
+			return errors.Wrapf(
+				ErrSlotBelowWatermark, "slot %d, highest signed slot %d",
+				slot, last.Slot,
+			)
+		case slot == last.Slot && signingRoot == last.SigningRoot:
+			// Signing the same message again is harmless.
+			return nil
+		case slot == last.Slot && last.Committed:
+			return errors.Wrapf(ErrConflictingMessage, "slot %d", slot)
+		}
+	}
+
+	prev, hadPrev := h.Messages[domainType]
+	h.Messages[domainType] = &SignedMessage{
+		Slot:        slot,
+		SigningRoot: signingRoot,
+	}
+	if err := db.save(); err != nil {
+		// Keep the memory in line with the disk.
+		if hadPrev {
+			h.Messages[domainType] = prev
+		} else {
+			delete(h.Messages, domainType)
+		}
+		return err
+	}
+	return nil
+}
+
+// MarkCommitted records that the chain committed a block at the given slot,
+// so that no other message is signed at or below it.
+func (db *DB) MarkCommitted(slot math.Slot) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var marked []*SignedMessage
+	for _, h := range db.state.Validators {
+		for _, msg := range h.Messages {
+			if msg.Slot <= slot && !msg.Committed {
+				msg.Committed = true
+				marked = append(marked, msg)
+			}
+		}
+	}
+	if len(marked) == 0 {
+		return nil
+	}
+	if err := db.save(); err != nil {
+		// Keep the memory in line with the disk.
+		for _, msg := range marked {
+			msg.Committed = false
+		}
+		return err
+	}
+	return nil
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the chain the
+// database belongs to, if known.
+func (db *DB) GenesisValidatorsRoot() (common.Root, bool) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.state.GenesisValidatorsRoot == nil {
+		return common.Root{}, false
+	}
+	return *db.state.GenesisValidatorsRoot, true
+}
+
+// history returns the history of the validator, creating it if needed. The
+// caller must hold the lock.
+func (db *DB) history(pubkey crypto.BLSPubkey) *history {
+	h, ok := db.state.Validators[pubkey]
+	if !ok {
+		h = &history{
+			Messages: make(map[common.DomainType]*SignedMessage),
+		}
+		db.state.Validators[pubkey] = h
+	}
+	if h.Messages == nil {
+		h.Messages = make(map[common.DomainType]*SignedMessage)
+	}
+	return h
+}
+
+// save atomically writes the database to disk. The caller must hold the
+// lock.
+func (db *DB) save() error {
+	bz, err := json.MarshalIndent(db.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	//#nosec:G301 // the directory holds no secrets.
+	if err = os.MkdirAll(filepath.Dir(db.path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := db.path + ".tmp"
+	//#nosec:G304 // the path is configured by the operator.
+	file, err := os.OpenFile(
+		tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600,
+	)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(bz); err != nil {
+		return errors.Join(err, file.Close())
+	}
+	if err = file.Sync(); err != nil {
+		return errors.Join(err, file.Close())
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, db.path)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrSlotBelowWatermark is returned when asked to sign a message for a
+	// slot lower than the highest slot already signed for its domain type.
+	ErrSlotBelowWatermark = errors.New(
+		"slot is lower than the highest signed slot",
+	)
+
+	// ErrConflictingMessage is returned when asked to sign a message for a
+	// committed slot that already has a different message signed for its
+	// domain type.
+	ErrConflictingMessage = errors.New(
+		"a different message was already signed for this slot",
+	)
+
+	// ErrInvalidSigningRoot is returned when asked to sign a message for a
+	// slot that is not a signing root.
+	ErrInvalidSigningRoot = errors.New("message is not a signing root")
+
+	// ErrUnsupportedInterchangeVersion is returned when importing an
+	// interchange file of an unsupported format version.
+	ErrUnsupportedInterchangeVersion = errors.New(
+		"unsupported interchange format version",
+	)
+
+	// ErrGenesisValidatorsRootMismatch is returned when importing an
+	// interchange file made for another chain.
+	ErrGenesisValidatorsRootMismatch = errors.New(
+		"genesis validators root does not match the database",
+	)
+
+	// ErrUnknownGenesisValidatorsRoot is returned when exporting a database
+	// that does not know the genesis validators root of its chain.
+	ErrUnknownGenesisValidatorsRoot = errors.New(
+		"genesis validators root is unknown",
+	)
+)
@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// InterchangeFormatVersion is the supported EIP-3076 interchange format
+// version.
+const InterchangeFormatVersion = "5"
+
+// Interchange is an EIP-3076 slashing protection interchange file, see
+// https://eips.ethereum.org/EIPS/eip-3076.
+type Interchange struct {
+	// Metadata identifies the format and the chain.
+	Metadata InterchangeMetadata `json:"metadata"`
+	// Data holds the signing history of each validator.
+	Data []*InterchangeData `json:"data"`
+}
+
+// InterchangeMetadata is the metadata of an interchange file.
+type InterchangeMetadata struct {
+	// InterchangeFormatVersion is the version of the format.
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	// GenesisValidatorsRoot identifies the chain.
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+}
+
+// InterchangeData is the signing history of a validator.
+type InterchangeData struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// SignedBlocks are the blocks signed by the validator.
+	SignedBlocks []*SignedBlock `json:"signed_blocks"`
+	// SignedAttestations are the attestations signed by the validator.
+	SignedAttestations []*SignedAttestation `json:"signed_attestations"`
+}
+
+// SignedBlock is a block signed by a validator.
+type SignedBlock struct {
+	// Slot is the slot of the block.
+	Slot Decimal `json:"slot"`
+	// SigningRoot is the signing root of the block, if known.
+	SigningRoot *common.Root `json:"signing_root,omitempty"`
+}
+
+// SignedAttestation is an attestation signed by a validator.
+type SignedAttestation struct {
+	// SourceEpoch is the source epoch of the attestation.
+	SourceEpoch Decimal `json:"source_epoch"`
+	// TargetEpoch is the target epoch of the attestation.
+	TargetEpoch Decimal `json:"target_epoch"`
+	// SigningRoot is the signing root of the attestation, if known.
+	SigningRoot *common.Root `json:"signing_root,omitempty"`
+}
+
+// Decimal is an unsigned integer encoded as a decimal string, as used by the
+// interchange format.
+type Decimal uint64
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(d), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	u, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	*d = Decimal(u)
+	return nil
+}
+
+// Import merges the interchange into the database. Signed blocks are recorded
+// under the proposer domain type, keeping only the highest slot of each
+// validator, which is all EIP-3076 requires to stay safe.
+func (db *DB) Import(
+	interchange *Interchange, proposerDomain common.DomainType,
+) error {
+	if v := interchange.Metadata.InterchangeFormatVersion; v !=
+		InterchangeFormatVersion {
+		return errors.Wrapf(ErrUnsupportedInterchangeVersion, "version %s", v)
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	root := interchange.Metadata.GenesisValidatorsRoot
+	if known := db.state.GenesisValidatorsRoot; known != nil && *known != root {
+		return errors.Wrapf(
+			ErrGenesisValidatorsRootMismatch, "got %s, expected %s",
+			root, *known,
+		)
+	}
+	db.state.GenesisValidatorsRoot = &root
+
+	for _, data := range interchange.Data {
+		h := db.history(data.Pubkey)
+		for _, blk := range data.SignedBlocks {
+			importBlock(h, proposerDomain, blk)
+		}
+		for _, att := range data.SignedAttestations {
+			importAttestation(h, att)
+		}
+	}
+	return db.save()
+}
+
+// Export returns the database as an interchange. Only the proposer domain
+// type has an interchange representation; the genesis validators root is
+// used if the database does not know its own.
+func (db *DB) Export(
+	proposerDomain common.DomainType, genesisValidatorsRoot *common.Root,
+) (*Interchange, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	root := db.state.GenesisValidatorsRoot
+	if root == nil {
+		root = genesisValidatorsRoot
+	}
+	if root == nil {
+		return nil, ErrUnknownGenesisValidatorsRoot
+	}
+
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    *root,
+		},
+		Data: make([]*InterchangeData, 0, len(db.state.Validators)),
+	}
+	for pubkey, h := range db.state.Validators {
+		data := &InterchangeData{
+			Pubkey:             pubkey,
+			SignedBlocks:       make([]*SignedBlock, 0, 1),
+			SignedAttestations: make([]*SignedAttestation, 0, 1),
+		}
+		if msg, ok := h.Messages[proposerDomain]; ok {
+			blk := &SignedBlock{Slot: Decimal(msg.Slot)}
+			if msg.SigningRoot != (common.Root{}) {
+				blk.SigningRoot = &msg.SigningRoot
+			}
+			data.SignedBlocks = append(data.SignedBlocks, blk)
+		}
+		if att := h.Attestations; att != nil {
+			data.SignedAttestations = append(
+				data.SignedAttestations, &SignedAttestation{
+					SourceEpoch: Decimal(att.SourceEpoch),
+					TargetEpoch: Decimal(att.TargetEpoch),
+				},
+			)
+		}
+		interchange.Data = append(interchange.Data, data)
+	}
+	return interchange, nil
+}
+
+// importBlock raises the proposer watermark of the history to the block. The
+// imported blocks are taken as committed, and two different blocks at the
+// watermark leave its signing root unknown, so that neither may be signed
+// again.
+func importBlock(
+	h *history, proposerDomain common.DomainType, blk *SignedBlock,
+) {
+	var signingRoot common.Root
+	if blk.SigningRoot != nil {
+		signingRoot = *blk.SigningRoot
+	}
+	slot := math.Slot(blk.Slot)
+
+	last, ok := h.Messages[proposerDomain]
+	switch {
+	case !ok || slot > last.Slot:
+		h.Messages[proposerDomain] = &SignedMessage{
+			Slot:        slot,
+			SigningRoot: signingRoot,
+			Committed:   true,
+		}
+	case slot == last.Slot:
+		if signingRoot != last.SigningRoot {
+			last.SigningRoot = common.Root{}
+		}
+		last.Committed = true
+	}
+}
+
+// importAttestation raises the attestation watermark of the history to the
+// attestation.
+func importAttestation(h *history, att *SignedAttestation) {
+	if h.Attestations == nil {
+		h.Attestations = new(AttestationWatermark)
+	}
+	h.Attestations.SourceEpoch = max(
+		h.Attestations.SourceEpoch, math.Epoch(att.SourceEpoch),
+	)
+	h.Attestations.TargetEpoch = max(
+		h.Attestations.TargetEpoch, math.Epoch(att.TargetEpoch),
+	)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the block the service learns committed slots from.
+type BeaconBlock interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+}
+
+// Service marks the slots of finalized blocks as committed in the slashing
+// protection database, which closes them to re-proposals.
+type Service[BeaconBlockT BeaconBlock] struct {
+	// db is the slashing protection database, nil if it is disabled.
+	db *DB
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// subFinalizedBlocks is a channel holding BeaconBlockFinalized events.
+	subFinalizedBlocks chan async.Event[BeaconBlockT]
+}
+
+// NewService creates a new slashing protection service. The database may be
+// nil if slashing protection is disabled.
+func NewService[BeaconBlockT BeaconBlock](
+	db *DB,
+	dispatcher asynctypes.EventDispatcher,
+	logger log.Logger,
+) *Service[BeaconBlockT] {
+	return &Service[BeaconBlockT]{
+		db:                 db,
+		dispatcher:         dispatcher,
+		logger:             logger,
+		subFinalizedBlocks: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_]) Name() string {
+	return "slashing-protection"
+}
+
+// Start subscribes the service to the finalized block events.
+func (s *Service[_]) Start(ctx context.Context) error {
+	if s.db == nil {
+		return nil
+	}
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlocks,
+	); err != nil {
+		return err
+	}
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop marks the slot of every finalized block as committed.
+func (s *Service[_]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-s.subFinalizedBlocks:
+			if !ok {
+				return
+			}
+			slot := event.Data().GetSlot()
+			if err := s.db.MarkCommitted(slot); err != nil {
+				s.logger.Error(
+					"Failed to mark slot as committed",
+					"slot", slot.Base10(), "error", err,
+				)
+			}
+		}
+	}
+}
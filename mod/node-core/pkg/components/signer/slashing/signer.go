@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Signer is a BLS signer guarded by a slashing protection database. Messages
+// signed through SignAt are checked against and recorded in the database
+// before they are signed; messages that are not tied to a slot, such as
+// deposits and builder registrations, are signed through Sign unguarded.
+type Signer struct {
+	crypto.BLSSigner
+	db *DB
+}
+
+// NewSigner wraps the signer with the slashing protection database.
+func NewSigner(signer crypto.BLSSigner, db *DB) *Signer {
+	return &Signer{
+		BLSSigner: signer,
+		db:        db,
+	}
+}
+
+// SignAt signs the message for the domain type at the given slot, refusing
+// it if it conflicts with the signing history.
+func (s *Signer) SignAt(
+	domainType bytes.B4, slot math.Slot, msg []byte,
+) (crypto.BLSSignature, error) {
+	if len(msg) != len(common.Root{}) {
+		return crypto.BLSSignature{}, ErrInvalidSigningRoot
+	}
+	if err := s.db.CheckAndRecord(
+		s.PublicKey(), domainType, slot, common.Root(msg),
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return s.Sign(msg)
+}
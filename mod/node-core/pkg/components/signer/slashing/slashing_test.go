@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/slashing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	proposer = common.DomainType{0x00, 0x00, 0x00, 0x00}
+	randao   = common.DomainType{0x02, 0x00, 0x00, 0x00}
+	pubkey   = crypto.BLSPubkey{0x01}
+)
+
+func TestDB_CheckAndRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slashing_protection.json")
+	db, err := slashing.NewDB(path)
+	require.NoError(t, err)
+
+	require.NoError(t, db.CheckAndRecord(pubkey, proposer, 10, common.Root{1}))
+	// The same message may be signed again.
+	require.NoError(t, db.CheckAndRecord(pubkey, proposer, 10, common.Root{1}))
+	require.NoError(t, db.MarkCommitted(10))
+	require.ErrorIs(t,
+		db.CheckAndRecord(pubkey, proposer, 10, common.Root{2}),
+		slashing.ErrConflictingMessage,
+	)
+	require.ErrorIs(t,
+		db.CheckAndRecord(pubkey, proposer, 9, common.Root{1}),
+		slashing.ErrSlotBelowWatermark,
+	)
+	// Domain types and validators are independent.
+	require.NoError(t, db.CheckAndRecord(pubkey, randao, 9, common.Root{2}))
+	require.NoError(t,
+		db.CheckAndRecord(crypto.BLSPubkey{2}, proposer, 9, common.Root{2}),
+	)
+
+	// The history survives a restart.
+	db, err = slashing.NewDB(path)
+	require.NoError(t, err)
+	require.ErrorIs(t,
+		db.CheckAndRecord(pubkey, proposer, 10, common.Root{2}),
+		slashing.ErrConflictingMessage,
+	)
+	require.NoError(t, db.CheckAndRecord(pubkey, proposer, 11, common.Root{2}))
+}
+
+func TestDB_CheckAndRecord_RoundChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slashing_protection.json")
+	db, err := slashing.NewDB(path)
+	require.NoError(t, err)
+
+	// The proposal of the first round is not committed, the proposer of a
+	// later round at the same height builds a new block for the same slot.
+	require.NoError(t, db.CheckAndRecord(pubkey, proposer, 10, common.Root{1}))
+	require.NoError(t, db.CheckAndRecord(pubkey, proposer, 10, common.Root{2}))
+
+	// Committing a block closes the slot to every other block, across
+	// restarts.
+	require.NoError(t, db.MarkCommitted(10))
+	db, err = slashing.NewDB(path)
+	require.NoError(t, err)
+	require.NoError(t, db.CheckAndRecord(pubkey, proposer, 10, common.Root{2}))
+	require.ErrorIs(t,
+		db.CheckAndRecord(pubkey, proposer, 10, common.Root{1}),
+		slashing.ErrConflictingMessage,
+	)
+
+	// Committed slots only close the messages signed at or below them.
+	require.NoError(t, db.CheckAndRecord(pubkey, proposer, 11, common.Root{1}))
+	require.NoError(t, db.MarkCommitted(10))
+	require.NoError(t, db.CheckAndRecord(pubkey, proposer, 11, common.Root{3}))
+}
+
+func TestDB_Interchange(t *testing.T) {
+	db, err := slashing.NewDB(filepath.Join(t.TempDir(), "db.json"))
+	require.NoError(t, err)
+	require.NoError(t, db.CheckAndRecord(pubkey, proposer, 5, common.Root{1}))
+
+	_, err = db.Export(proposer, nil)
+	require.ErrorIs(t, err, slashing.ErrUnknownGenesisValidatorsRoot)
+
+	root := common.Root{9}
+	conflicting := common.Root{3}
+	require.NoError(t, db.Import(&slashing.Interchange{
+		Metadata: slashing.InterchangeMetadata{
+			InterchangeFormatVersion: slashing.InterchangeFormatVersion,
+			GenesisValidatorsRoot:    root,
+		},
+		Data: []*slashing.InterchangeData{{
+			Pubkey: pubkey,
+			SignedBlocks: []*slashing.SignedBlock{
+				{Slot: 7, SigningRoot: &common.Root{2}},
+				{Slot: 7, SigningRoot: &conflicting},
+				{Slot: 6},
+			},
+			SignedAttestations: []*slashing.SignedAttestation{
+				{SourceEpoch: 1, TargetEpoch: 2},
+			},
+		}},
+	}, proposer))
+
+	// Two blocks at the watermark leave neither signable.
+	require.ErrorIs(t,
+		db.CheckAndRecord(pubkey, proposer, 7, common.Root{2}),
+		slashing.ErrConflictingMessage,
+	)
+	require.ErrorIs(t,
+		db.CheckAndRecord(pubkey, proposer, 6, common.Root{4}),
+		slashing.ErrSlotBelowWatermark,
+	)
+
+	interchange, err := db.Export(proposer, nil)
+	require.NoError(t, err)
+	require.Equal(t, root, interchange.Metadata.GenesisValidatorsRoot)
+	require.Len(t, interchange.Data, 1)
+	require.Equal(t, []*slashing.SignedBlock{{Slot: 7}},
+		interchange.Data[0].SignedBlocks,
+	)
+	require.Equal(t,
+		[]*slashing.SignedAttestation{{SourceEpoch: 1, TargetEpoch: 2}},
+		interchange.Data[0].SignedAttestations,
+	)
+
+	interchange.Metadata.GenesisValidatorsRoot = common.Root{8}
+	require.ErrorIs(t,
+		db.Import(interchange, proposer),
+		slashing.ErrGenesisValidatorsRootMismatch,
+	)
+	interchange.Metadata.InterchangeFormatVersion = "4"
+	require.ErrorIs(t,
+		db.Import(interchange, proposer),
+		slashing.ErrUnsupportedInterchangeVersion,
+	)
+}
+
+func TestSigner_SignAt(t *testing.T) {
+	msg, other := common.Root{1}, common.Root{2}
+	blsSigner := mocks.NewBLSSigner(t)
+	blsSigner.EXPECT().PublicKey().Return(pubkey)
+	blsSigner.EXPECT().Sign(msg[:]).Return(crypto.BLSSignature{1}, nil).Once()
+
+	db, err := slashing.NewDB(filepath.Join(t.TempDir(), "db.json"))
+	require.NoError(t, err)
+	s := slashing.NewSigner(blsSigner, db)
+
+	sig, err := crypto.SignAt(s, randao, 1, msg[:])
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSSignature{1}, sig)
+	require.NoError(t, db.MarkCommitted(1))
+
+	// Refused messages never reach the key.
+	_, err = crypto.SignAt(s, randao, 1, other[:])
+	require.ErrorIs(t, err, slashing.ErrConflictingMessage)
+	_, err = s.SignAt(randao, 2, []byte("not a root"))
+	require.ErrorIs(t, err, slashing.ErrInvalidSigningRoot)
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/segmentdb"
+)
+
+// OpenDB opens the database with the given name in dir on the backend. Every
+// backend keeps its data at its own path, so switching backends never reads
+// the data of another one:
+//   - file: dir/name, with a file per key;
+//   - segment: dir/name.segments, with a file per segment;
+//   - pebbledb and goleveldb: dir/name.db.
+func OpenDB(
+	backend storagedb.Backend,
+	dir string,
+	name string,
+	logger log.Logger,
+) (storagedb.DB, error) {
+	switch backend {
+	case storagedb.BackendFile:
+		return filedb.NewDB(
+			filedb.WithRootDirectory(filepath.Join(dir, name)),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(logger),
+		), nil
+	case storagedb.BackendSegment:
+		return segmentdb.NewDB(
+			segmentdb.WithRootDirectory(
+				filepath.Join(dir, name+".segments"),
+			),
+			segmentdb.WithDirectoryPermissions(os.ModePerm),
+		), nil
+	case storagedb.BackendPebbleDB:
+		return openKVDB(storev2.DBTypePebbleDB, dir, name)
+	case storagedb.BackendGoLevelDB:
+		return openKVDB(storev2.DBTypeGoLevelDB, dir, name)
+	default:
+		return nil, errors.Wrapf(
+			storagedb.ErrUnknownBackend, "%q", backend,
+		)
+	}
+}
+
+// DBPath returns the path OpenDB keeps the data of the database with the
+// given name in dir at on the backend.
+func DBPath(
+	backend storagedb.Backend,
+	dir string,
+	name string,
+) (string, error) {
+	switch backend {
+	case storagedb.BackendFile:
+		return filepath.Join(dir, name), nil
+	case storagedb.BackendSegment:
+		return filepath.Join(dir, name+".segments"), nil
+	case storagedb.BackendPebbleDB, storagedb.BackendGoLevelDB:
+		return filepath.Join(dir, name+".db"), nil
+	default:
+		return "", errors.Wrapf(
+			storagedb.ErrUnknownBackend, "%q", backend,
+		)
+	}
+}
+
+// openKVDB opens a key-value database of the given type.
+func openKVDB(
+	dbType storev2.DBType,
+	dir string,
+	name string,
+) (storagedb.DB, error) {
+	kvsb, err := storev2.NewDB(dbType, name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	return storagedb.NewKVDB(kvsb), nil
+}
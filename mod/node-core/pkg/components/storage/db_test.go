@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage_test
+
+import (
+	"crypto/rand"
+	"strconv"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// blobSize is the size of an SSZ encoded blob sidecar.
+	blobSize = 131928
+	// blobsPerSlot is the maximum number of blobs of a block.
+	blobsPerSlot = 6
+)
+
+var backends = []storagedb.Backend{
+	storagedb.BackendFile,
+	storagedb.BackendSegment,
+	storagedb.BackendPebbleDB,
+	storagedb.BackendGoLevelDB,
+}
+
+func openRangeDB(tb testing.TB, backend storagedb.Backend) *filedb.RangeDB {
+	tb.Helper()
+	db, err := storage.OpenDB(
+		backend, tb.TempDir(), "blobs", noop.NewLogger[any](),
+	)
+	require.NoError(tb, err)
+	tb.Cleanup(func() { require.NoError(tb, db.Close()) })
+	return filedb.NewRangeDB(db)
+}
+
+func TestOpenDB(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(string(backend), func(t *testing.T) {
+			db := openRangeDB(t, backend)
+			for slot := range uint64(4) {
+				require.NoError(t, db.Set(slot, []byte{0x01}, []byte{byte(slot)}))
+			}
+
+			require.NoError(t, db.Prune(0, 2))
+			for slot := range uint64(4) {
+				has, err := db.Has(slot, []byte{0x01})
+				require.NoError(t, err)
+				require.Equal(t, slot >= 2, has)
+			}
+			value, err := db.Get(3, []byte{0x01})
+			require.NoError(t, err)
+			require.Equal(t, []byte{0x03}, value)
+		})
+	}
+
+	_, err := storage.OpenDB("rocksdb", t.TempDir(), "blobs", nil)
+	require.ErrorIs(t, err, storagedb.ErrUnknownBackend)
+}
+
+func TestDBPath(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(string(backend), func(t *testing.T) {
+			dir := t.TempDir()
+			db, err := storage.OpenDB(
+				backend, dir, "blobs", noop.NewLogger[any](),
+			)
+			require.NoError(t, err)
+			require.NoError(t, db.Set([]byte("0/01"), []byte{0x01}))
+			require.NoError(t, db.Close())
+
+			path, err := storage.DBPath(backend, dir, "blobs")
+			require.NoError(t, err)
+			require.DirExists(t, path)
+		})
+	}
+
+	_, err := storage.DBPath("rocksdb", t.TempDir(), "blobs")
+	require.ErrorIs(t, err, storagedb.ErrUnknownBackend)
+}
+
+// BenchmarkSet measures storing the blobs of a slot.
+func BenchmarkSet(b *testing.B) {
+	blob := make([]byte, blobSize)
+	_, _ = rand.Read(blob)
+	for _, backend := range backends {
+		b.Run(string(backend), func(b *testing.B) {
+			db := openRangeDB(b, backend)
+			b.SetBytes(blobSize * blobsPerSlot)
+			b.ResetTimer()
+			for slot := range uint64(b.N) {
+				for i := range byte(blobsPerSlot) {
+					require.NoError(b, db.Set(slot, []byte{i}, blob))
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkGet measures reading a blob.
+func BenchmarkGet(b *testing.B) {
+	const slots = 64
+	blob := make([]byte, blobSize)
+	_, _ = rand.Read(blob)
+	for _, backend := range backends {
+		b.Run(string(backend), func(b *testing.B) {
+			db := openRangeDB(b, backend)
+			for slot := range uint64(slots) {
+				for i := range byte(blobsPerSlot) {
+					require.NoError(b, db.Set(slot, []byte{i}, blob))
+				}
+			}
+			b.SetBytes(blobSize)
+			b.ResetTimer()
+			for n := range b.N {
+				_, err := db.Get(
+					uint64(n%slots), []byte{byte(n % blobsPerSlot)},
+				)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// BenchmarkPrune measures pruning the blobs of a slot.
+func BenchmarkPrune(b *testing.B) {
+	blob := make([]byte, blobSize/16)
+	_, _ = rand.Read(blob)
+	for _, backend := range backends {
+		b.Run(string(backend), func(b *testing.B) {
+			db := openRangeDB(b, backend)
+			for slot := range uint64(b.N) {
+				for i := range byte(blobsPerSlot) {
+					require.NoError(b, db.Set(slot, []byte{i}, blob))
+				}
+			}
+			b.ResetTimer()
+			for slot := range uint64(b.N) {
+				require.NoError(b, db.Prune(slot, slot+1))
+			}
+		})
+	}
+}
+
+// BenchmarkOpen measures reopening a store holding many slots, which the
+// segment backend indexes lazily.
+func BenchmarkOpen(b *testing.B) {
+	const slots = 256
+	for _, backend := range []storagedb.Backend{
+		storagedb.BackendFile, storagedb.BackendSegment,
+	} {
+		b.Run(string(backend), func(b *testing.B) {
+			dir := b.TempDir()
+			db, err := storage.OpenDB(
+				backend, dir, "blobs", noop.NewLogger[any](),
+			)
+			require.NoError(b, err)
+			for slot := range slots {
+				key := []byte(strconv.Itoa(slot) + "/01")
+				require.NoError(b, db.Set(key, []byte{0x01}))
+			}
+			require.NoError(b, db.Close())
+
+			b.ResetTimer()
+			for n := range b.N {
+				db, err = storage.OpenDB(
+					backend, dir, "blobs", noop.NewLogger[any](),
+				)
+				require.NoError(b, err)
+				_, err = db.Has([]byte(strconv.Itoa(n%slots) + "/01"))
+				require.NoError(b, err)
+				require.NoError(b, db.Close())
+			}
+		})
+	}
+}
@@ -18,27 +18,17 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package storage
+package components
 
 import (
-	"context"
-
-	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/tracing"
 )
 
-// KVStoreProvider is a provider for a KV store.
-type KVStoreProvider struct {
-	store.KVStoreWithBatch
-}
-
-// NewKVStoreProvider creates a new KV store provider.
-func NewKVStoreProvider(kvsb store.KVStoreWithBatch) *KVStoreProvider {
-	return &KVStoreProvider{
-		KVStoreWithBatch: kvsb,
-	}
-}
-
-// OpenKVStore opens a new KV store.
-func (p *KVStoreProvider) OpenKVStore(context.Context) store.KVStore {
-	return p.KVStoreWithBatch
+// ProvideTracingService provides the service exporting the OpenTelemetry
+// spans of the node.
+func ProvideTracingService(
+	cfg *config.Config,
+) (*tracing.Service, error) {
+	return tracing.NewService(&cfg.Tracing)
 }
@@ -28,6 +28,7 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/recipient"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -41,6 +42,9 @@ type (
 	// DBManager is a type alias for the database manager.
 	DBManager = manager.DBManager
 
+	// FeeRecipients is a type alias for the fee recipient registry.
+	FeeRecipients = recipient.Registry
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 )
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+)
+
+// ValidatorResolverInput is the input for the validator resolver provider.
+type ValidatorResolverInput[
+	StorageBackendT any,
+] struct {
+	depinject.In
+	StorageBackend StorageBackendT
+}
+
+// ProvideValidatorResolver provides the resolver used by the CometBFT service
+// to map votes and misbehaviors to beacon chain validators.
+func ProvideValidatorResolver[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT cometbft.BeaconState[BeaconBlockHeaderT],
+	StorageBackendT cometbft.StorageBackend[BeaconStateT],
+](
+	in ValidatorResolverInput[StorageBackendT],
+) cometbft.ValidatorResolver {
+	return cometbft.NewValidatorResolver[BeaconBlockHeaderT, BeaconStateT](
+		in.StorageBackend,
+	)
+}
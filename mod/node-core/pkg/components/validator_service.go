@@ -47,9 +47,12 @@ type ValidatorServiceInput[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
 	depinject.In
-	Cfg            *config.Config
-	ChainSpec      common.ChainSpec
-	Dispatcher     Dispatcher
+	Cfg             *config.Config
+	ChainSpec       common.ChainSpec
+	Dispatcher      Dispatcher
+	ExternalBuilder ExternalBuilder[
+		BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	]
 	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
 	Logger         LoggerT
 	StateProcessor StateProcessor[
@@ -69,9 +72,9 @@ func ProvideValidatorService[
 	],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT, *AttestationData, DepositT,
-		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT, *SlashingInfo,
 	],
-	BeaconBlockHeaderT any,
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
 		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
@@ -100,7 +103,7 @@ func ProvideValidatorService[
 		LoggerT, StorageBackendT, WithdrawalT, WithdrawalsT,
 	],
 ) (*validator.Service[
-	*AttestationData, BeaconBlockT, BeaconBlockBodyT,
+	*AttestationData, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT,
 	*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	*ForkData, *SlashingInfo, *SlotData,
@@ -110,6 +113,7 @@ func ProvideValidatorService[
 		*AttestationData,
 		BeaconBlockT,
 		BeaconBlockBodyT,
+		BeaconBlockHeaderT,
 		BeaconStateT,
 		BlobSidecarsT,
 		DepositT,
@@ -132,6 +136,7 @@ func ProvideValidatorService[
 		[]validator.PayloadBuilder[BeaconStateT, ExecutionPayloadT]{
 			in.LocalBuilder,
 		},
+		in.ExternalBuilder,
 		in.TelemetrySink,
 		in.Dispatcher,
 	), nil
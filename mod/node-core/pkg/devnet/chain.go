@@ -0,0 +1,477 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// genesisGasLimit is the gas limit of every block.
+	genesisGasLimit = 30_000_000
+	// genesisBaseFee is the base fee of every block, 1 gwei.
+	genesisBaseFee = 1_000_000_000
+	// blobGasPerBlob is the blob gas used by a single blob.
+	blobGasPerBlob = 1 << 17
+)
+
+// pendingTx is a transaction submitted to the engine. It is included in the
+// next block built on top of a chain that does not include it yet.
+type pendingTx struct {
+	tx *gethtypes.Transaction
+	// sidecar holds the blob of a blob transaction, nil for deposits.
+	sidecar *gethtypes.BlobTxSidecar
+}
+
+// genesisBlock returns the genesis block of the mock chain.
+func genesisBlock(timestamp uint64) *gethtypes.Block {
+	var zero uint64
+	return gethtypes.NewBlock(
+		&gethtypes.Header{
+			Root:             gethtypes.EmptyRootHash,
+			Difficulty:       new(big.Int),
+			Number:           new(big.Int),
+			GasLimit:         genesisGasLimit,
+			Time:             timestamp,
+			BaseFee:          big.NewInt(genesisBaseFee),
+			BlobGasUsed:      &zero,
+			ExcessBlobGas:    &zero,
+			ParentBeaconRoot: &gethcommon.Hash{},
+		},
+		&gethtypes.Body{Withdrawals: gethtypes.Withdrawals{}},
+		nil,
+		trie.NewStackTrie(nil),
+	)
+}
+
+// AddDeposit submits a deposit to the deposit contract. It is included in
+// the next block built, and its log served once that block is canonical.
+func (e *Engine) AddDeposit(deposit *types.Deposit) error {
+	data, err := e.depositEvent.Inputs.Pack(
+		deposit.Pubkey[:],
+		deposit.Credentials[:],
+		deposit.Amount.Unwrap(),
+		deposit.Signature[:],
+		deposit.Index,
+	)
+	if err != nil {
+		return err
+	}
+
+	contract := gethcommon.Address(e.cfg.DepositContract)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pending = append(e.pending, &pendingTx{
+		tx: gethtypes.NewTx(&gethtypes.LegacyTx{
+			Nonce:    deposit.Index,
+			GasPrice: new(big.Int),
+			To:       &contract,
+			Value:    new(big.Int),
+			Data:     data,
+		}),
+	})
+	return nil
+}
+
+// AddBlob submits a blob carrying transaction and returns the versioned hash
+// of the blob. At most MaxBlobsPerBlock blobs are included per block.
+func (e *Engine) AddBlob(blob *gokzg4844.Blob) (gethcommon.Hash, error) {
+	commitment, err := e.cfg.KZG.BlobToKZGCommitment(blob, 0)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	proof, err := e.cfg.KZG.ComputeBlobKZGProof(blob, commitment, 0)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	versionedHash := gethcommon.Hash(kzg4844.CalcBlobHashV1(
+		sha256.New(), (*kzg4844.Commitment)(&commitment),
+	))
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pending = append(e.pending, &pendingTx{
+		tx: gethtypes.NewTx(&gethtypes.BlobTx{
+			ChainID:    uint256.NewInt(e.cfg.ChainID),
+			Nonce:      e.blobNonce,
+			GasTipCap:  new(uint256.Int),
+			GasFeeCap:  new(uint256.Int),
+			Value:      new(uint256.Int),
+			BlobFeeCap: new(uint256.Int),
+			BlobHashes: []gethcommon.Hash{versionedHash},
+			V:          new(uint256.Int),
+			R:          new(uint256.Int),
+			S:          new(uint256.Int),
+		}),
+		sidecar: &gethtypes.BlobTxSidecar{
+			Blobs:       []kzg4844.Blob{kzg4844.Blob(*blob)},
+			Commitments: []kzg4844.Commitment{kzg4844.Commitment(commitment)},
+			Proofs:      []kzg4844.Proof{kzg4844.Proof(proof)},
+		},
+	})
+	e.blobNonce++
+	return versionedHash, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Engine API                                 */
+/* -------------------------------------------------------------------------- */
+
+// forkchoiceUpdated serves engine_forkchoiceUpdatedV3. Payloads are built
+// synchronously, so that they are ready by the time they are requested.
+func (e *Engine) forkchoiceUpdated(params []json.RawMessage) (any, error) {
+	var (
+		state engine.ForkchoiceStateV1
+		attrs *engine.PayloadAttributes
+	)
+	if err := param(params, 0, &state); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &attrs); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	head, ok := e.blocks[state.HeadBlockHash]
+	if !ok {
+		return engine.ForkChoiceResponse{
+			PayloadStatus: engine.PayloadStatusV1{Status: engine.SYNCING},
+		}, nil
+	}
+	e.setHead(head)
+
+	headHash := head.Hash()
+	resp := engine.ForkChoiceResponse{
+		PayloadStatus: engine.PayloadStatusV1{
+			Status:          engine.VALID,
+			LatestValidHash: &headHash,
+		},
+	}
+	if attrs != nil {
+		id, err := payloadID(headHash, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok = e.payloads[id]; !ok {
+			e.payloads[id] = e.buildPayload(head, attrs)
+		}
+		resp.PayloadID = &id
+	}
+	return resp, nil
+}
+
+// getPayload serves engine_getPayloadV3.
+func (e *Engine) getPayload(params []json.RawMessage) (any, error) {
+	var id engine.PayloadID
+	if err := param(params, 0, &id); err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	envelope, ok := e.payloads[id]
+	if !ok {
+		return nil, &rpcError{
+			code: errCodeUnknownPayload,
+			err:  errors.New("unknown payload"),
+		}
+	}
+	return envelope, nil
+}
+
+// newPayload serves engine_newPayloadV3. A payload is valid if its block
+// hash and versioned hashes are consistent with its contents.
+func (e *Engine) newPayload(params []json.RawMessage) (any, error) {
+	var (
+		data            engine.ExecutableData
+		versionedHashes []gethcommon.Hash
+		beaconRoot      *gethcommon.Hash
+	)
+	if len(params) == 0 {
+		return nil, &rpcError{
+			code: errCodeInvalidParams,
+			err:  errors.New("missing execution payload"),
+		}
+	}
+	if err := param(params, 0, &data); err != nil {
+		return nil, err
+	}
+	if err := param(params, 1, &versionedHashes); err != nil {
+		return nil, err
+	}
+	if err := param(params, 2, &beaconRoot); err != nil {
+		return nil, err
+	}
+
+	block, err := engine.ExecutableDataToBlock(
+		data, versionedHashes, beaconRoot,
+	)
+	if err != nil {
+		validationErr := err.Error()
+		return engine.PayloadStatusV1{
+			Status:          engine.INVALID,
+			ValidationError: &validationErr,
+		}, nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	hash := block.Hash()
+	e.blocks[hash] = block
+	if _, ok := e.blocks[block.ParentHash()]; !ok {
+		return engine.PayloadStatusV1{Status: engine.SYNCING}, nil
+	}
+	return engine.PayloadStatusV1{
+		Status:          engine.VALID,
+		LatestValidHash: &hash,
+	}, nil
+}
+
+// exchangeCapabilities serves engine_exchangeCapabilities, supporting all
+// the capabilities requested.
+func (e *Engine) exchangeCapabilities(params []json.RawMessage) (any, error) {
+	capabilities := make([]string, 0)
+	if err := param(params, 0, &capabilities); err != nil {
+		return nil, err
+	}
+	return capabilities, nil
+}
+
+// getClientVersion serves engine_getClientVersionV1.
+func (e *Engine) getClientVersion([]json.RawMessage) (any, error) {
+	return []engine.ClientVersionV1{{
+		Code:    "BK",
+		Name:    "devnet-engine",
+		Version: "v0.0.0",
+		Commit:  "0x00000000",
+	}}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Eth API                                  */
+/* -------------------------------------------------------------------------- */
+
+// chainID serves eth_chainId.
+func (e *Engine) chainID([]json.RawMessage) (any, error) {
+	return hexutil.Uint64(e.cfg.ChainID), nil
+}
+
+// getLogs serves eth_getLogs for the deposit logs of the canonical chain.
+func (e *Engine) getLogs(params []json.RawMessage) (any, error) {
+	var query struct {
+		FromBlock *rpc.BlockNumber `json:"fromBlock"`
+		ToBlock   *rpc.BlockNumber `json:"toBlock"`
+		BlockHash *gethcommon.Hash `json:"blockHash"`
+	}
+	if err := param(params, 0, &query); err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	logs := make([]*gethtypes.Log, 0)
+	if query.BlockHash != nil {
+		if block, ok := e.blocks[*query.BlockHash]; ok {
+			logs = append(logs, e.depositLogs(block)...)
+		}
+		return logs, nil
+	}
+
+	head := e.blocks[e.head].NumberU64()
+	from, to := e.resolve(query.FromBlock, 0), e.resolve(query.ToBlock, head)
+	for number := from; number <= min(to, head); number++ {
+		if hash, ok := e.canonical[number]; ok {
+			logs = append(logs, e.depositLogs(e.blocks[hash])...)
+		}
+	}
+	return logs, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    Chain                                   */
+/* -------------------------------------------------------------------------- */
+
+// setHead sets the forkchoice head and reorgs the canonical chain onto it.
+// It must be called with the lock held.
+func (e *Engine) setHead(head *gethtypes.Block) {
+	if e.head == head.Hash() {
+		return
+	}
+	e.head = head.Hash()
+	// Payloads built on top of the previous head are stale.
+	clear(e.payloads)
+
+	for number := head.NumberU64() + 1; ; number++ {
+		if _, ok := e.canonical[number]; !ok {
+			break
+		}
+		delete(e.canonical, number)
+	}
+	for block := head; block != nil; block = e.blocks[block.ParentHash()] {
+		if e.canonical[block.NumberU64()] == block.Hash() {
+			break
+		}
+		e.canonical[block.NumberU64()] = block.Hash()
+	}
+}
+
+// buildPayload builds a payload on top of the given parent, including the
+// pending transactions not yet included in its chain. It must be called with
+// the lock held.
+func (e *Engine) buildPayload(
+	parent *gethtypes.Block,
+	attrs *engine.PayloadAttributes,
+) *engine.ExecutionPayloadEnvelope {
+	var (
+		included    = e.includedTxs(parent)
+		txs         = make([]*gethtypes.Transaction, 0)
+		sidecars    = make([]*gethtypes.BlobTxSidecar, 0)
+		blobGasUsed uint64
+		zero        uint64
+	)
+	for _, ptx := range e.pending {
+		if _, ok := included[ptx.tx.Hash()]; ok {
+			continue
+		}
+		if ptx.sidecar != nil {
+			if uint64(len(sidecars)) >= e.cfg.MaxBlobsPerBlock {
+				continue
+			}
+			sidecars = append(sidecars, ptx.sidecar)
+			blobGasUsed += blobGasPerBlob
+		}
+		txs = append(txs, ptx.tx)
+	}
+
+	withdrawals := attrs.Withdrawals
+	if withdrawals == nil {
+		withdrawals = gethtypes.Withdrawals{}
+	}
+	block := gethtypes.NewBlock(
+		&gethtypes.Header{
+			ParentHash:       parent.Hash(),
+			Coinbase:         attrs.SuggestedFeeRecipient,
+			Root:             parent.Root(),
+			Difficulty:       new(big.Int),
+			Number:           new(big.Int).Add(parent.Number(), big.NewInt(1)),
+			GasLimit:         parent.GasLimit(),
+			Time:             attrs.Timestamp,
+			BaseFee:          parent.BaseFee(),
+			MixDigest:        attrs.Random,
+			BlobGasUsed:      &blobGasUsed,
+			ExcessBlobGas:    &zero,
+			ParentBeaconRoot: attrs.BeaconRoot,
+		},
+		&gethtypes.Body{Transactions: txs, Withdrawals: withdrawals},
+		nil,
+		trie.NewStackTrie(nil),
+	)
+	return engine.BlockToExecutableData(block, new(big.Int), sidecars)
+}
+
+// includedTxs returns the hashes of the transactions included in the chain
+// ending at the given block. It must be called with the lock held.
+func (e *Engine) includedTxs(
+	block *gethtypes.Block,
+) map[gethcommon.Hash]struct{} {
+	included := make(map[gethcommon.Hash]struct{})
+	for ; block != nil; block = e.blocks[block.ParentHash()] {
+		for _, tx := range block.Transactions() {
+			included[tx.Hash()] = struct{}{}
+		}
+	}
+	return included
+}
+
+// depositLogs returns the deposit logs emitted by the given block. It must
+// be called with the lock held.
+func (e *Engine) depositLogs(block *gethtypes.Block) []*gethtypes.Log {
+	var (
+		contract = gethcommon.Address(e.cfg.DepositContract)
+		logs     = make([]*gethtypes.Log, 0)
+	)
+	for i, tx := range block.Transactions() {
+		if tx.Type() != gethtypes.LegacyTxType || tx.To() == nil ||
+			*tx.To() != contract {
+			continue
+		}
+		logs = append(logs, &gethtypes.Log{
+			Address:     contract,
+			Topics:      []gethcommon.Hash{e.depositEvent.ID},
+			Data:        tx.Data(),
+			BlockNumber: block.NumberU64(),
+			TxHash:      tx.Hash(),
+			TxIndex:     uint(i),
+			BlockHash:   block.Hash(),
+			Index:       uint(len(logs)),
+		})
+	}
+	return logs
+}
+
+// resolve resolves a block number, defaulting to def if unset. Tags resolve
+// to the head. It must be called with the lock held.
+func (e *Engine) resolve(number *rpc.BlockNumber, def uint64) uint64 {
+	switch {
+	case number == nil:
+		return def
+	case *number == rpc.EarliestBlockNumber:
+		return 0
+	case *number < 0:
+		return e.blocks[e.head].NumberU64()
+	default:
+		//#nosec:G115 // non-negative.
+		return uint64(*number)
+	}
+}
+
+// payloadID deterministically derives the ID of the payload built on top of
+// the given parent with the given attributes.
+func payloadID(
+	parent gethcommon.Hash,
+	attrs *engine.PayloadAttributes,
+) (engine.PayloadID, error) {
+	bz, err := json.Marshal(attrs)
+	if err != nil {
+		return engine.PayloadID{}, err
+	}
+	hasher := sha256.New()
+	hasher.Write(parent[:])
+	hasher.Write(bz)
+	var id engine.PayloadID
+	copy(id[:], hasher.Sum(nil))
+	return id, nil
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"os"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// defaultNumNodes is the default number of nodes in the network.
+	defaultNumNodes = 4
+	// defaultChainID is the default CometBFT chain ID of the network.
+	defaultChainID = "devnet-80087"
+	// defaultTimeoutPropose is the default CometBFT propose timeout.
+	defaultTimeoutPropose = 500 * time.Millisecond
+	// defaultTimeoutVote is the default CometBFT prevote and precommit
+	// timeout.
+	defaultTimeoutVote = 250 * time.Millisecond
+	// defaultTimeoutCommit is the default CometBFT commit timeout, which
+	// bounds the time between two slots.
+	defaultTimeoutCommit = 250 * time.Millisecond
+	// defaultSnapshotInterval is the default interval, in blocks, at which
+	// the nodes take state sync snapshots.
+	defaultSnapshotInterval = 5
+)
+
+// Config is the configuration of an in-process devnet.
+type Config[LoggerT any] struct {
+	// NumNodes is the number of validator nodes in the network.
+	NumNodes int
+	// ChainID is the CometBFT chain ID of the network.
+	ChainID string
+	// ChainSpec is the chain spec of the network. It must match the chain
+	// spec provided to the nodes.
+	ChainSpec common.ChainSpec
+	// KZGTrustedSetupPath is the path to the KZG trusted setup, used by both
+	// the nodes and the mock execution clients.
+	KZGTrustedSetupPath string
+	// DepositAmount is the amount deposited by each genesis validator.
+	DepositAmount math.Gwei
+	// NewLogger returns the logger of the node with the given moniker.
+	NewLogger func(moniker string) LoggerT
+	// TimeoutPropose is the CometBFT propose timeout.
+	TimeoutPropose time.Duration
+	// TimeoutVote is the CometBFT prevote and precommit timeout.
+	TimeoutVote time.Duration
+	// TimeoutCommit is the CometBFT commit timeout.
+	TimeoutCommit time.Duration
+	// SnapshotInterval is the interval, in blocks, at which the nodes take
+	// the state sync snapshots served to nodes joining from a checkpoint.
+	SnapshotInterval uint64
+	// Dir is the directory holding the home directories of the nodes. A
+	// temporary directory is used if empty.
+	Dir string
+}
+
+// DefaultConfig returns the default configuration of a devnet, using the
+// chain spec the nodes are built with. The KZG trusted setup path and the
+// logger constructor must be set by the caller.
+func DefaultConfig[LoggerT any]() Config[LoggerT] {
+	cs := components.ProvideChainSpec()
+	return Config[LoggerT]{
+		NumNodes:       defaultNumNodes,
+		ChainID:        defaultChainID,
+		ChainSpec:      cs,
+		DepositAmount:  math.Gwei(cs.MaxEffectiveBalance()),
+		TimeoutPropose: defaultTimeoutPropose,
+		TimeoutVote:    defaultTimeoutVote,
+		TimeoutCommit:  defaultTimeoutCommit,
+
+		SnapshotInterval: defaultSnapshotInterval,
+	}
+}
+
+// validate validates the configuration, creating its directory if unset.
+func (c *Config[LoggerT]) validate() error {
+	switch {
+	case c.NumNodes <= 0:
+		return ErrNoNodes
+	case c.ChainSpec == nil:
+		return ErrNilChainSpec
+	case c.KZGTrustedSetupPath == "":
+		return ErrNoTrustedSetup
+	case c.NewLogger == nil:
+		return ErrNilLogger
+	}
+	if c.Dir != "" {
+		return os.MkdirAll(c.Dir, os.ModePerm)
+	}
+	var err error
+	c.Dir, err = os.MkdirTemp("", "devnet")
+	return err
+}
@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gjwt "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// JSON-RPC error codes returned by the mock engine.
+	errCodeInvalidParams  = -32602
+	errCodeMethodNotFound = -32601
+	errCodeUnknownPayload = -38001
+
+	// maxRequestSize bounds the size of a JSON-RPC request body.
+	maxRequestSize = 16 << 20
+	// readHeaderTimeout bounds the time taken to read a request's headers.
+	readHeaderTimeout = 5 * time.Second
+)
+
+// EngineConfig configures a mock execution client.
+type EngineConfig struct {
+	// ChainID is the chain ID returned by eth_chainId.
+	ChainID uint64
+	// DepositContract is the address deposit logs are emitted from.
+	DepositContract common.ExecutionAddress
+	// MaxBlobsPerBlock is the maximum number of blobs included in a block.
+	MaxBlobsPerBlock uint64
+	// GenesisTime is the timestamp of the genesis block.
+	GenesisTime uint64
+	// JWTSecret, if set, is used to authenticate requests.
+	JWTSecret *jwt.Secret
+	// KZG computes the commitments and proofs of submitted blobs.
+	KZG *gokzg4844.Context
+}
+
+// Engine is a deterministic, in-memory execution client serving the subset
+// of the engine and eth JSON-RPC APIs used by a beacon node. It has no state
+// or EVM: blocks only carry the deposits and blobs submitted to it, encoded
+// as transactions so that any engine importing a block can serve its logs.
+type Engine struct {
+	cfg     EngineConfig
+	genesis *types.Block
+	methods map[string]func([]json.RawMessage) (any, error)
+	// depositEvent is the event emitted by the deposit contract.
+	depositEvent abi.Event
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// blocks holds every block built or imported, keyed by hash.
+	blocks map[gethcommon.Hash]*types.Block
+	// canonical maps block numbers to the hashes of the canonical chain.
+	canonical map[uint64]gethcommon.Hash
+	// head is the hash of the latest forkchoice head.
+	head gethcommon.Hash
+	// payloads holds the payloads built by forkchoice updates.
+	payloads map[engine.PayloadID]*engine.ExecutionPayloadEnvelope
+	// pending holds the submitted transactions, in submission order.
+	pending []*pendingTx
+	// blobNonce is the nonce of the next blob transaction.
+	blobNonce uint64
+
+	listener net.Listener
+	server   *http.Server
+}
+
+// NewEngine creates a new mock execution client, whose chain starts at a
+// genesis block derived from the given configuration.
+func NewEngine(cfg EngineConfig) (*Engine, error) {
+	contractABI, err := deposit.BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	depositEvent, ok := contractABI.Events["Deposit"]
+	if !ok {
+		return nil, errors.New("deposit contract has no Deposit event")
+	}
+
+	genesis := genesisBlock(cfg.GenesisTime)
+	e := &Engine{
+		cfg:          cfg,
+		genesis:      genesis,
+		depositEvent: depositEvent,
+		blocks:       map[gethcommon.Hash]*types.Block{genesis.Hash(): genesis},
+		canonical:    map[uint64]gethcommon.Hash{0: genesis.Hash()},
+		head:         genesis.Hash(),
+		payloads: make(
+			map[engine.PayloadID]*engine.ExecutionPayloadEnvelope,
+		),
+	}
+	e.methods = map[string]func([]json.RawMessage) (any, error){
+		"eth_chainId":                 e.chainID,
+		"eth_getLogs":                 e.getLogs,
+		"engine_exchangeCapabilities": e.exchangeCapabilities,
+		"engine_getClientVersionV1":   e.getClientVersion,
+		"engine_forkchoiceUpdatedV3":  e.forkchoiceUpdated,
+		"engine_getPayloadV3":         e.getPayload,
+		"engine_newPayloadV3":         e.newPayload,
+	}
+	return e, nil
+}
+
+// Start starts serving the JSON-RPC API on the given listener.
+func (e *Engine) Start(listener net.Listener) {
+	e.listener = listener
+	e.server = &http.Server{
+		Handler:           e,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		//#nosec:G104 // returns http.ErrServerClosed once stopped.
+		_ = e.server.Serve(listener)
+	}()
+}
+
+// Stop stops serving the JSON-RPC API.
+func (e *Engine) Stop(ctx context.Context) error {
+	if e.server == nil {
+		return nil
+	}
+	return e.server.Shutdown(ctx)
+}
+
+// URL returns the URL the JSON-RPC API is served on.
+func (e *Engine) URL() string {
+	return "http://" + e.listener.Addr().String()
+}
+
+// GenesisBlock returns the genesis block of the chain.
+func (e *Engine) GenesisBlock() *types.Block {
+	return e.genesis
+}
+
+// Head returns the latest forkchoice head.
+func (e *Engine) Head() *types.Block {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.blocks[e.head]
+}
+
+// rpcRequest is a JSON-RPC request, with its params left undecoded.
+type rpcRequest struct {
+	ID     int               `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// rpcError is returned by handlers to set the JSON-RPC error code.
+type rpcError struct {
+	code int
+	err  error
+}
+
+func (e *rpcError) Error() string {
+	return e.err.Error()
+}
+
+// ServeHTTP implements http.Handler.
+func (e *Engine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := e.authenticate(r); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req rpcRequest
+	if err = json.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := ethclientrpc.Response{ID: req.ID, JSONRPC: "2.0"}
+	if result, callErr := e.call(req.Method, req.Params); callErr != nil {
+		code := errCodeInvalidParams
+		var rpcErr *rpcError
+		if errors.As(callErr, &rpcErr) {
+			code = rpcErr.code
+		}
+		resp.Error = &ethclientrpc.Error{Code: code, Message: callErr.Error()}
+	} else if resp.Result, err = json.Marshal(result); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	bz, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	//#nosec:G104 // the client is gone if this fails.
+	_, _ = w.Write(bz)
+}
+
+// call dispatches a JSON-RPC call to its handler.
+func (e *Engine) call(method string, params []json.RawMessage) (any, error) {
+	handler, ok := e.methods[method]
+	if !ok {
+		return nil, &rpcError{
+			code: errCodeMethodNotFound,
+			err:  errors.New("method not found: " + method),
+		}
+	}
+	return handler(params)
+}
+
+// authenticate verifies the JWT bearer token of the request, if a secret
+// is configured.
+func (e *Engine) authenticate(r *http.Request) error {
+	if e.cfg.JWTSecret == nil {
+		return nil
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return errors.New("missing bearer token")
+	}
+	_, err := gjwt.Parse(
+		token,
+		func(*gjwt.Token) (any, error) { return e.cfg.JWTSecret.Bytes(), nil },
+		gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+		gjwt.WithIssuedAt(),
+	)
+	return err
+}
+
+// param decodes the i-th param into out. A missing or null param leaves out
+// untouched.
+func param(params []json.RawMessage, i int, out any) error {
+	if i >= len(params) || string(params[i]) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(params[i], out); err != nil {
+		return &rpcError{code: errCodeInvalidParams, err: err}
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet_test
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/devnet"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChainID       = 80087
+	testGenesisTime   = 1_700_000_000
+	trustedSetupPath  = "../../../../testing/files/kzg-trusted-setup.json"
+	testDepositAmount = 32e9
+)
+
+var testDepositContract = common.NewExecutionAddressFromHex(
+	"0x4242424242424242424242424242424242424242",
+)
+
+// startEngine starts a mock execution client and returns a client of it.
+func startEngine(
+	t *testing.T,
+	kzg *gokzg4844.Context,
+) (*devnet.Engine, *ethclient.Client[*types.ExecutionPayload]) {
+	t.Helper()
+	engine, err := devnet.NewEngine(devnet.EngineConfig{
+		ChainID:          testChainID,
+		DepositContract:  testDepositContract,
+		MaxBlobsPerBlock: 6,
+		GenesisTime:      testGenesisTime,
+		KZG:              kzg,
+	})
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	engine.Start(listener)
+	t.Cleanup(func() {
+		require.NoError(t, engine.Stop(context.Background()))
+	})
+
+	return engine, ethclient.New[*types.ExecutionPayload](
+		rpc.NewClient(engine.URL()),
+	)
+}
+
+func TestEngine_BuildAndImport(t *testing.T) {
+	ctx := context.Background()
+	trustedSetup, err := components.ReadTrustedSetup(trustedSetupPath)
+	require.NoError(t, err)
+	kzg, err := gokzg4844.NewContext4096(trustedSetup)
+	require.NoError(t, err)
+
+	builder, builderClient := startEngine(t, kzg)
+	importer, importerClient := startEngine(t, kzg)
+	genesis := builder.GenesisBlock()
+	require.Equal(t, genesis.Hash(), importer.GenesisBlock().Hash())
+
+	chainID, err := builderClient.ChainID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.U64(testChainID), chainID)
+
+	// Submit a deposit and a blob to the builder only.
+	deposit := &types.Deposit{
+		Pubkey: [48]byte{1},
+		Amount: testDepositAmount,
+		Index:  4,
+	}
+	require.NoError(t, builder.AddDeposit(deposit))
+	blob, err := devnet.BlobFromData([]byte("devnet"))
+	require.NoError(t, err)
+	_, err = builder.AddBlob(blob)
+	require.NoError(t, err)
+
+	// Build a payload on top of genesis.
+	parentRoot := common.Root{0xaa}
+	resp, err := builderClient.ForkchoiceUpdatedV3(
+		ctx,
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash: common.ExecutionHash(genesis.Hash()),
+		},
+		map[string]any{
+			"timestamp":             "0x6553f101",
+			"prevRandao":            common.Bytes32{0x01},
+			"suggestedFeeRecipient": common.ExecutionAddress{0x02},
+			"withdrawals":           []any{},
+			"parentBeaconBlockRoot": parentRoot,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, engineprimitives.PayloadStatusValid, resp.PayloadStatus.Status,
+	)
+	require.NotNil(t, resp.PayloadID)
+
+	envelope, err := builderClient.GetPayloadV3(ctx, *resp.PayloadID)
+	require.NoError(t, err)
+	payload := envelope.GetExecutionPayload()
+	require.Equal(t, math.U64(1), payload.GetNumber())
+	require.Len(t, payload.GetTransactions(), 2)
+	commitments := envelope.GetBlobsBundle().GetCommitments()
+	require.Len(t, commitments, 1)
+
+	// The importer rejects the payload with the wrong versioned hashes.
+	status, err := importerClient.NewPayloadV3(
+		ctx, payload, nil, &parentRoot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusInvalid, status.Status)
+
+	// The importer accepts the payload with its versioned hashes.
+	status, err = importerClient.NewPayloadV3(
+		ctx,
+		payload,
+		[]common.ExecutionHash{commitments[0].ToVersionedHash()},
+		&parentRoot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadStatusValid, status.Status)
+
+	// Once canonical, the importer serves the deposit log of the payload.
+	resp, err = importerClient.ForkchoiceUpdatedV3(
+		ctx,
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash: payload.GetBlockHash(),
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, engineprimitives.PayloadStatusValid, resp.PayloadStatus.Status,
+	)
+	require.Nil(t, resp.PayloadID)
+	require.Equal(t, payload.GetBlockHash(), common.ExecutionHash(
+		importer.Head().Hash(),
+	))
+
+	logs, err := importerClient.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(1),
+		ToBlock:   big.NewInt(1),
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(
+		t, testDepositContract, common.ExecutionAddress(logs[0].Address),
+	)
+	require.Equal(t, uint64(1), logs[0].BlockNumber)
+
+	// The deposit is not included again on top of the payload.
+	resp, err = importerClient.ForkchoiceUpdatedV3(
+		ctx,
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash: payload.GetBlockHash(),
+		},
+		map[string]any{
+			"timestamp":             "0x6553f102",
+			"prevRandao":            common.Bytes32{},
+			"suggestedFeeRecipient": common.ExecutionAddress{},
+			"withdrawals":           []any{},
+			"parentBeaconBlockRoot": common.Root{},
+		},
+	)
+	require.NoError(t, err)
+	envelope, err = importerClient.GetPayloadV3(ctx, *resp.PayloadID)
+	require.NoError(t, err)
+	require.Empty(t, envelope.GetExecutionPayload().GetTransactions())
+}
+
+func TestEngine_UnknownHead(t *testing.T) {
+	_, client := startEngine(t, nil)
+	resp, err := client.ForkchoiceUpdatedV3(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash: common.ExecutionHash{0x01},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, engineprimitives.PayloadStatusSyncing, resp.PayloadStatus.Status,
+	)
+}
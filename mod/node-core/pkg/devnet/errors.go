@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNoNodes is returned when a devnet is configured without nodes.
+	ErrNoNodes = errors.New("devnet must have at least one node")
+	// ErrNilChainSpec is returned when a devnet has no chain spec.
+	ErrNilChainSpec = errors.New("chain spec must be set")
+	// ErrNoTrustedSetup is returned when a devnet has no KZG trusted setup.
+	ErrNoTrustedSetup = errors.New("kzg trusted setup path must be set")
+	// ErrNilLogger is returned when a devnet has no logger constructor.
+	ErrNilLogger = errors.New("logger constructor must be set")
+	// ErrNodeIndex is returned when a node index is out of range.
+	ErrNodeIndex = errors.New("node index out of range")
+	// ErrNodeRunning is returned when restarting a running node.
+	ErrNodeRunning = errors.New("node is already running")
+	// ErrNodeStopped is returned when querying or killing a stopped node.
+	ErrNodeStopped = errors.New("node is not running")
+	// ErrBuildFailed is returned when a node fails to build.
+	ErrBuildFailed = errors.New("failed to build node")
+	// ErrUnexpectedStatus is returned when the node API replies with an
+	// unexpected status code.
+	ErrUnexpectedStatus = errors.New("unexpected node api status")
+)
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// NewDeposit creates a deposit of the given amount, signed by the validator
+// whose CometBFT key files are configured in cmtCfg. Deposits are signed over
+// the given genesis validators root, which is zero for genesis deposits.
+func NewDeposit(
+	cs common.ChainSpec,
+	cmtCfg *cmtcfg.Config,
+	genesisValidatorsRoot common.Root,
+	credentials types.WithdrawalCredentials,
+	amount math.Gwei,
+	index uint64,
+) (*types.Deposit, error) {
+	blsSigner := signer.NewBLSSigner(
+		cmtCfg.PrivValidatorKeyFile(), cmtCfg.PrivValidatorStateFile(),
+	)
+	msg, signature, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(
+			version.FromUint32[common.Version](version.Deneb),
+			genesisValidatorsRoot,
+		),
+		cs.DomainTypeDeposit(),
+		blsSigner,
+		credentials,
+		amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Deposit{
+		Pubkey:      msg.Pubkey,
+		Credentials: msg.Credentials,
+		Amount:      msg.Amount,
+		Signature:   signature,
+		Index:       index,
+	}, nil
+}
+
+// writeGenesis writes the genesis file of the network, containing the given
+// genesis deposits and the genesis block of the mock execution clients, to
+// the home directory of every node.
+func writeGenesis(
+	cs common.ChainSpec,
+	chainID string,
+	genesisTime time.Time,
+	genesisBlock *gethtypes.Block,
+	deposits []*types.Deposit,
+	cmtCfgs []*cmtcfg.Config,
+) error {
+	header, err := executionPayloadHeader(cs, genesisBlock)
+	if err != nil {
+		return err
+	}
+
+	genesis := types.DefaultGenesisDeneb()
+	genesis.Deposits = deposits
+	genesis.ExecutionPayloadHeader = header
+	beaconGenesis, err := json.Marshal(genesis)
+	if err != nil {
+		return err
+	}
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		"beacon": beaconGenesis,
+	})
+	if err != nil {
+		return err
+	}
+
+	cmtParams := cs.GetCometBFTConfigForSlot(0)
+	consensusParams, ok := cmtParams.(*cmttypes.ConsensusParams)
+	if !ok {
+		return errors.New("chain spec has no CometBFT consensus params")
+	}
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(chainID, appState)
+	appGenesis.GenesisTime = genesisTime
+	appGenesis.InitialHeight = 1
+	appGenesis.Consensus = &genutiltypes.ConsensusGenesis{
+		Params: consensusParams,
+	}
+
+	for _, cmtCfg := range cmtCfgs {
+		if err = genutil.ExportGenesisFile(
+			appGenesis, cmtCfg.GenesisFile(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executionPayloadHeader returns the execution payload header of the given
+// genesis block.
+func executionPayloadHeader(
+	cs common.ChainSpec,
+	block *gethtypes.Block,
+) (*types.ExecutionPayloadHeader, error) {
+	bz, err := json.Marshal(
+		engine.BlockToExecutableData(block, nil, nil).ExecutionPayload,
+	)
+	if err != nil {
+		return nil, err
+	}
+	payload := new(types.ExecutionPayload)
+	if err = json.Unmarshal(bz, payload); err != nil {
+		return nil, err
+	}
+	return payload.ToHeader(
+		cs.MaxWithdrawalsPerPayload(), cs.DepositEth1ChainID(),
+	)
+}
+
+// withdrawalCredentials returns the withdrawal credentials of the validator
+// of the i-th node, which withdraws to the node's fee recipient.
+func withdrawalCredentials(i int) types.WithdrawalCredentials {
+	return types.NewCredentialsFromExecutionAddress(feeRecipient(i))
+}
+
+// feeRecipient returns the fee recipient of the i-th node.
+func feeRecipient(i int) common.ExecutionAddress {
+	var address common.ExecutionAddress
+	//#nosec:G115 // the number of nodes is small.
+	address[len(address)-1] = byte(i + 1)
+	return address
+}
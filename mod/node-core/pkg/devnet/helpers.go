@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	prooftypes "github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// pollInterval is the interval at which the chain is polled while
+	// waiting for it to progress.
+	pollInterval = 100 * time.Millisecond
+	// bytesPerFieldElement is the number of bytes of data packed in a blob
+	// field element, keeping every element below the BLS modulus.
+	bytesPerFieldElement = 31
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                 Operations                                 */
+/* -------------------------------------------------------------------------- */
+
+// SubmitDeposit submits the given deposit to the deposit contract of every
+// mock execution client, assigning it the next deposit index.
+func (n *Network[_, _, _]) SubmitDeposit(deposit *types.Deposit) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	deposit.Index = n.numDeposits
+	for _, node := range n.nodes {
+		if err := node.Engine.AddDeposit(deposit); err != nil {
+			return err
+		}
+	}
+	n.numDeposits++
+	return nil
+}
+
+// TopUp submits a deposit of the given amount to the validator of the i-th
+// node. The signature of a top-up is not verified, so it is signed over the
+// zero genesis validators root.
+func (n *Network[_, _, _]) TopUp(i int, amount math.Gwei) error {
+	node, err := n.Node(i)
+	if err != nil {
+		return err
+	}
+	deposit, err := NewDeposit(
+		n.cfg.ChainSpec, node.cmtCfg, common.Root{},
+		withdrawalCredentials(i), amount, 0,
+	)
+	if err != nil {
+		return err
+	}
+	return n.SubmitDeposit(deposit)
+}
+
+// SubmitBlob submits a blob carrying the given data to every mock execution
+// client, returning its versioned hash.
+func (n *Network[_, _, _]) SubmitBlob(data []byte) (common.ExecutionHash, error) {
+	blob, err := BlobFromData(data)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var versionedHash gethcommon.Hash
+	for _, node := range n.nodes {
+		if versionedHash, err = node.Engine.AddBlob(blob); err != nil {
+			return common.ExecutionHash{}, err
+		}
+	}
+	return common.ExecutionHash(versionedHash), nil
+}
+
+// BlobFromData packs the given data into a blob, 31 bytes per field element.
+func BlobFromData(data []byte) (*gokzg4844.Blob, error) {
+	var blob gokzg4844.Blob
+	if len(data) > len(blob)/gokzg4844.SerializedScalarSize*
+		bytesPerFieldElement {
+		return nil, errors.New("data does not fit in a blob")
+	}
+	for i := 0; len(data) > 0; i += gokzg4844.SerializedScalarSize {
+		// The leading byte of every field element is left zero.
+		copied := copy(blob[i+1:i+gokzg4844.SerializedScalarSize], data)
+		data = data[copied:]
+	}
+	return &blob, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Progress                                  */
+/* -------------------------------------------------------------------------- */
+
+// Height returns the latest block height of the i-th node.
+func (n *Network[_, _, _]) Height(ctx context.Context, i int) (int64, error) {
+	node, err := n.Node(i)
+	if err != nil {
+		return 0, err
+	}
+	if !node.Running() {
+		return 0, ErrNodeStopped
+	}
+	client, err := rpchttp.New("tcp://" + node.RPCAddress)
+	if err != nil {
+		return 0, err
+	}
+	status, err := client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// WaitForHeight waits until the i-th node reaches the given height.
+func (n *Network[_, _, _]) WaitForHeight(
+	ctx context.Context,
+	i int,
+	height int64,
+) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		// The node may not serve its RPC yet, e.g. right after starting.
+		if current, err := n.Height(ctx, i); err == nil && current >= height {
+			return nil
+		} else if errors.Is(err, ErrNodeIndex) ||
+			errors.Is(err, ErrNodeStopped) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// AdvanceSlots waits until the i-th node has finalized the given number of
+// slots past its current height.
+func (n *Network[_, _, _]) AdvanceSlots(
+	ctx context.Context,
+	i int,
+	slots int64,
+) error {
+	height, err := n.Height(ctx, i)
+	if err != nil {
+		return err
+	}
+	return n.WaitForHeight(ctx, i, height+slots)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    State                                   */
+/* -------------------------------------------------------------------------- */
+
+// GetJSON queries the node API of the i-th node at the given path, decoding
+// the data of the response into out.
+func (n *Network[_, _, _]) GetJSON(
+	ctx context.Context,
+	i int,
+	path string,
+	out any,
+) error {
+	body, err := n.get(ctx, i, path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, &struct {
+		Data any `json:"data"`
+	}{Data: out})
+}
+
+// get queries the node API of the i-th node at the given path, returning the
+// body of the response.
+func (n *Network[_, _, _]) get(
+	ctx context.Context,
+	i int,
+	path string,
+) ([]byte, error) {
+	node, err := n.Node(i)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, "http://"+node.APIAddress+path, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(
+			ErrUnexpectedStatus, "%s: %d: %s", path, resp.StatusCode, body,
+		)
+	}
+	return body, nil
+}
+
+// StateRoot returns the root of the given state of the i-th node.
+func (n *Network[_, _, _]) StateRoot(
+	ctx context.Context,
+	i int,
+	stateID string,
+) (common.Root, error) {
+	var data beacontypes.RootData
+	err := n.GetJSON(ctx, i, "/eth/v1/beacon/states/"+stateID+"/root", &data)
+	return data.Root, err
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the i-th node.
+func (n *Network[_, _, _]) GenesisValidatorsRoot(
+	ctx context.Context,
+	i int,
+) (common.Root, error) {
+	var data beacontypes.GenesisData
+	err := n.GetJSON(ctx, i, "/eth/v1/beacon/genesis", &data)
+	return data.GenesisValidatorsRoot, err
+}
+
+// FinalityCheckpoints returns the finality checkpoints of the given state of
+// the i-th node.
+func (n *Network[_, _, _]) FinalityCheckpoints(
+	ctx context.Context,
+	i int,
+	stateID string,
+) (*beacontypes.FinalityCheckpointsData, error) {
+	data := new(beacontypes.FinalityCheckpointsData)
+	err := n.GetJSON(
+		ctx, i, "/eth/v1/beacon/states/"+stateID+"/finality_checkpoints", data,
+	)
+	return data, err
+}
+
+// ValidatorSet returns the CometBFT validator set of the given state of the
+// i-th node.
+func (n *Network[_, _, _]) ValidatorSet(
+	ctx context.Context,
+	i int,
+	stateID string,
+) (*beacontypes.ValidatorSetData, error) {
+	data := new(beacontypes.ValidatorSetData)
+	err := n.GetJSON(
+		ctx, i, "/bkit/v1/beacon/states/"+stateID+"/validator_set", data,
+	)
+	return data, err
+}
+
+// LightClientFinalityUpdate returns the latest light client finality update
+// of the i-th node.
+func (n *Network[_, _, _]) LightClientFinalityUpdate(
+	ctx context.Context,
+	i int,
+) (*beacontypes.LightClientData[*types.BeaconBlockHeader], error) {
+	data := new(beacontypes.LightClientData[*types.BeaconBlockHeader])
+	err := n.GetJSON(
+		ctx, i, "/eth/v1/beacon/light_client/finality_update", data,
+	)
+	return data, err
+}
+
+// HistoricalBlockRootProof returns the proof of the block root at the given
+// historical slot against the block of the given timestamp id of the i-th
+// node.
+func (n *Network[_, _, _]) HistoricalBlockRootProof(
+	ctx context.Context,
+	i int,
+	timestampID string,
+	slot uint64,
+) (*prooftypes.HistoricalBlockRootResponse[*types.BeaconBlockHeader], error) {
+	// Proof responses are not wrapped in a data field.
+	body, err := n.get(
+		ctx, i,
+		"/bkit/v1/proof/historical_block_root/"+timestampID+"?slot="+Slot(slot),
+	)
+	if err != nil {
+		return nil, err
+	}
+	data := new(prooftypes.HistoricalBlockRootResponse[*types.BeaconBlockHeader])
+	return data, json.Unmarshal(body, data)
+}
+
+// Balances returns the balances of the given validators in the given state
+// of the i-th node.
+func (n *Network[_, _, _]) Balances(
+	ctx context.Context,
+	i int,
+	stateID string,
+	indices ...math.ValidatorIndex,
+) (map[math.ValidatorIndex]math.Gwei, error) {
+	query := make(url.Values)
+	for _, index := range indices {
+		query.Add("id", strconv.FormatUint(index.Unwrap(), 10))
+	}
+	var data []*beacontypes.ValidatorBalanceData
+	if err := n.GetJSON(
+		ctx, i,
+		"/eth/v1/beacon/states/"+stateID+"/validator_balances?"+query.Encode(),
+		&data,
+	); err != nil {
+		return nil, err
+	}
+	balances := make(map[math.ValidatorIndex]math.Gwei, len(data))
+	for _, balance := range data {
+		balances[math.ValidatorIndex(balance.Index)] = math.Gwei(
+			balance.Balance,
+		)
+	}
+	return balances, nil
+}
+
+// Slot formats a slot as a state or block ID of the node API.
+func Slot(slot uint64) string {
+	return strconv.FormatUint(slot, 10)
+}
@@ -0,0 +1,562 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package devnet
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	clibuilder "github.com/berachain/beacon-kit/mod/cli/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
+	beaconflags "github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	serverconfig "github.com/berachain/beacon-kit/mod/config/pkg/config"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/spf13/viper"
+)
+
+// stopTimeout bounds the time taken to stop a mock execution client.
+const stopTimeout = 5 * time.Second
+
+// maj23SleepDuration effectively disables the CometBFT maj23 query routine.
+const maj23SleepDuration = 24 * time.Hour
+
+// stateSyncDiscoveryTime is the minimum time CometBFT spends discovering the
+// snapshots of its peers.
+const stateSyncDiscoveryTime = 5 * time.Second
+
+// Node is a beacon node of a devnet, along with its mock execution client.
+type Node[NodeT nodetypes.Node] struct {
+	// Moniker is the name of the node.
+	Moniker string
+	// Home is the home directory of the node.
+	Home string
+	// Engine is the mock execution client of the node.
+	Engine *Engine
+	// RPCAddress is the address the CometBFT RPC is served on.
+	RPCAddress string
+	// APIAddress is the address the node API is served on.
+	APIAddress string
+
+	// peer is the address other nodes dial the node at.
+	peer           string
+	cmtCfg         *cmtcfg.Config
+	appOpts        *viper.Viper
+	engineListener net.Listener
+
+	// node is the running beacon node, if any.
+	node NodeT
+	// cancel stops the running beacon node.
+	cancel context.CancelFunc
+	// done receives the error the running beacon node stopped with.
+	done chan error
+}
+
+// Running returns whether the beacon node is running.
+func (n *Node[_]) Running() bool {
+	return n.cancel != nil
+}
+
+// Network is an in-process network of beacon nodes, connected to each other
+// over the loopback interface, each driving its own mock execution client.
+// Every node is a validator from genesis.
+type Network[
+	NodeT nodetypes.Node,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		log.Configurable[LoggerT, LoggerConfigT]
+	},
+	LoggerConfigT any,
+] struct {
+	cfg     Config[LoggerT]
+	builder *builder.NodeBuilder[NodeT, LoggerT, LoggerConfigT]
+	kzg     *gokzg4844.Context
+
+	// genesisTime is the genesis time of the network.
+	genesisTime time.Time
+
+	// mu protects the fields below.
+	mu    sync.Mutex
+	nodes []*Node[NodeT]
+	// numDeposits is the number of deposits made so far, including the
+	// genesis deposits.
+	numDeposits uint64
+}
+
+// New creates the home directories, keys and genesis of a network of nodes
+// built by the given builder. The network is started by Start.
+func New[
+	NodeT nodetypes.Node,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		log.Configurable[LoggerT, LoggerConfigT]
+	},
+	LoggerConfigT any,
+](
+	cfg Config[LoggerT],
+	nb *builder.NodeBuilder[NodeT, LoggerT, LoggerConfigT],
+) (*Network[NodeT, LoggerT, LoggerConfigT], error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	trustedSetup, err := components.ReadTrustedSetup(cfg.KZGTrustedSetupPath)
+	if err != nil {
+		return nil, err
+	}
+	kzg, err := gokzg4844.NewContext4096(trustedSetup)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Network[NodeT, LoggerT, LoggerConfigT]{
+		cfg:         cfg,
+		builder:     nb,
+		kzg:         kzg,
+		genesisTime: time.Now().Truncate(time.Second),
+		nodes:       make([]*Node[NodeT], cfg.NumNodes),
+	}
+	if err = n.init(); err != nil {
+		return nil, errors.Join(err, n.closeListeners())
+	}
+	return n, nil
+}
+
+// init initializes every node of the network, along with the genesis.
+func (n *Network[_, _, _]) init() error {
+	var (
+		deposits = make([]*types.Deposit, len(n.nodes))
+		cmtCfgs  = make([]*cmtcfg.Config, len(n.nodes))
+		err      error
+	)
+	for i := range n.nodes {
+		if n.nodes[i], err = n.initNode(i); err != nil {
+			return err
+		}
+		cmtCfgs[i] = n.nodes[i].cmtCfg
+
+		//#nosec:G115 // the number of nodes is small.
+		if deposits[i], err = NewDeposit(
+			n.cfg.ChainSpec, cmtCfgs[i], common.Root{},
+			withdrawalCredentials(i), n.cfg.DepositAmount, uint64(i),
+		); err != nil {
+			return err
+		}
+	}
+	n.numDeposits = uint64(len(deposits))
+
+	// Every node dials every other node.
+	for i, node := range n.nodes {
+		n.writeConfig(node, n.peers(i))
+	}
+
+	return writeGenesis(
+		n.cfg.ChainSpec, n.cfg.ChainID, n.genesisTime,
+		n.nodes[0].Engine.GenesisBlock(), deposits, cmtCfgs,
+	)
+}
+
+// peers returns the peer addresses of every node but the i-th one.
+func (n *Network[_, _, _]) peers(i int) []string {
+	peers := make([]string, 0, len(n.nodes))
+	for j, node := range n.nodes {
+		if j != i {
+			peers = append(peers, node.peer)
+		}
+	}
+	return peers
+}
+
+// writeConfig writes the CometBFT config of the given node, dialing the
+// given peers.
+func (n *Network[NodeT, _, _]) writeConfig(
+	node *Node[NodeT],
+	peers []string,
+) {
+	node.cmtCfg.P2P.PersistentPeers = strings.Join(peers, ",")
+	cmtcfg.WriteConfigFile(
+		filepath.Join(node.Home, "config", "config.toml"), node.cmtCfg,
+	)
+}
+
+// initNode initializes the home directory, keys and mock execution client of
+// the i-th node.
+func (n *Network[NodeT, _, _]) initNode(i int) (*Node[NodeT], error) {
+	node := &Node[NodeT]{
+		Moniker: fmt.Sprintf("node-%d", i),
+		Home:    filepath.Join(n.cfg.Dir, fmt.Sprintf("node%d", i)),
+	}
+	var err error
+	if node.engineListener, err = net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		return nil, err
+	}
+	p2pAddress, err := freeAddress()
+	if err != nil {
+		return node, err
+	}
+	if node.RPCAddress, err = freeAddress(); err != nil {
+		return node, err
+	}
+	if node.APIAddress, err = freeAddress(); err != nil {
+		return node, err
+	}
+
+	// Configure CometBFT to run a fast chain over the loopback interface.
+	cmtcfg.EnsureRoot(node.Home)
+	node.cmtCfg = clibuilder.DefaultCometConfig()
+	node.cmtCfg.SetRoot(node.Home)
+	node.cmtCfg.Moniker = node.Moniker
+	node.cmtCfg.Instrumentation.Prometheus = false
+	node.cmtCfg.P2P.ListenAddress = "tcp://" + p2pAddress
+	node.cmtCfg.P2P.AllowDuplicateIP = true
+	node.cmtCfg.P2P.AddrBookStrict = false
+	node.cmtCfg.RPC.ListenAddress = "tcp://" + node.RPCAddress
+	node.cmtCfg.Consensus.TimeoutPropose = n.cfg.TimeoutPropose
+	node.cmtCfg.Consensus.TimeoutPrevote = n.cfg.TimeoutVote
+	node.cmtCfg.Consensus.TimeoutPrecommit = n.cfg.TimeoutVote
+	node.cmtCfg.Consensus.TimeoutCommit = n.cfg.TimeoutCommit
+	// The maj23 query routine reads the block store after sleeping without
+	// checking whether the node stopped, which panics once a killed node has
+	// closed its databases. It only serves liveness under signature floods.
+	node.cmtCfg.Consensus.PeerQueryMaj23SleepDuration = maj23SleepDuration
+
+	nodeID, _, err := genutil.InitializeNodeValidatorFiles(
+		node.cmtCfg, crypto.CometBLSType,
+	)
+	if err != nil {
+		return node, err
+	}
+
+	secret, err := jwt.NewRandom()
+	if err != nil {
+		return node, err
+	}
+	jwtSecretPath := filepath.Join(node.Home, "config", "jwt.hex")
+	if err = os.WriteFile(
+		jwtSecretPath, []byte(secret.Hex()), 0o600,
+	); err != nil {
+		return node, err
+	}
+	if node.Engine, err = NewEngine(EngineConfig{
+		ChainID:          n.cfg.ChainSpec.DepositEth1ChainID(),
+		DepositContract:  n.cfg.ChainSpec.DepositContractAddress(),
+		MaxBlobsPerBlock: n.cfg.ChainSpec.MaxBlobsPerBlock(),
+		//#nosec:G115 // the genesis time is after the epoch.
+		GenesisTime: uint64(n.genesisTime.Unix()),
+		JWTSecret:   secret,
+		KZG:         n.kzg,
+	}); err != nil {
+		return node, err
+	}
+
+	if node.appOpts, err = n.appOpts(i, node, jwtSecretPath); err != nil {
+		return node, err
+	}
+	node.peer = nodeID + "@" + p2pAddress
+	return node, nil
+}
+
+// appOpts writes the app config of the given node and returns the options
+// the node is built with.
+func (n *Network[NodeT, _, _]) appOpts(
+	i int,
+	node *Node[NodeT],
+	jwtSecretPath string,
+) (*viper.Viper, error) {
+	appCfgPath := filepath.Join(node.Home, "config", "app.toml")
+	if err := serverconfig.SetConfigTemplate(
+		clibuilder.DefaultAppConfigTemplate(),
+	); err != nil {
+		return nil, err
+	}
+	if err := serverconfig.WriteConfigFile(
+		appCfgPath, clibuilder.DefaultAppConfig(),
+	); err != nil {
+		return nil, err
+	}
+
+	v := viper.New()
+	v.SetConfigFile(appCfgPath)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	v.Set(flags.FlagHome, node.Home)
+	v.Set(flags.FlagChainID, n.cfg.ChainID)
+	v.Set(server.FlagPruning, "nothing")
+	// Every snapshot is kept, so that the snapshot a joining node picks is
+	// still served once it fetches its chunks.
+	v.Set(server.FlagStateSyncSnapshotInterval, n.cfg.SnapshotInterval)
+	v.Set(server.FlagStateSyncSnapshotKeepRecent, 0)
+	v.Set("telemetry.enabled", false)
+	v.Set("priv_validator_key_file", node.cmtCfg.PrivValidatorKey)
+	v.Set("priv_validator_state_file", node.cmtCfg.PrivValidatorState)
+	v.Set(
+		beaconflags.RPCDialURL, "http://"+node.engineListener.Addr().String(),
+	)
+	v.Set(beaconflags.JWTSecretPath, jwtSecretPath)
+	v.Set(beaconflags.KZGTrustedSetupPath, n.cfg.KZGTrustedSetupPath)
+	v.Set(beaconflags.NodeAPIEnabled, true)
+	v.Set(beaconflags.NodeAPIAddress, node.APIAddress)
+	v.Set(beaconflags.BlockStoreServiceEnabled, true)
+	v.Set(beaconflags.SuggestedFeeRecipient, feeRecipient(i).Hex())
+	return v, nil
+}
+
+// Start starts the mock execution clients and beacon nodes of the network.
+// The nodes run until ctx is done, they are killed or the network stopped.
+func (n *Network[_, _, _]) Start(ctx context.Context) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, node := range n.nodes {
+		node.Engine.Start(node.engineListener)
+	}
+	for _, node := range n.nodes {
+		if err := n.startNode(ctx, node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stop stops every running beacon node, then the mock execution clients.
+func (n *Network[_, _, _]) Stop() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	errs := make([]error, 0)
+	for _, node := range n.nodes {
+		if node.Running() {
+			errs = append(errs, n.stopNode(node))
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+	for _, node := range n.nodes {
+		errs = append(errs, node.Engine.Stop(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// Kill stops the i-th beacon node, leaving its mock execution client and
+// home directory untouched.
+func (n *Network[_, _, _]) Kill(i int) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	node, err := n.node(i)
+	if err != nil {
+		return err
+	}
+	if !node.Running() {
+		return ErrNodeStopped
+	}
+	return n.stopNode(node)
+}
+
+// Restart starts the killed i-th beacon node again, from its home directory.
+func (n *Network[_, _, _]) Restart(ctx context.Context, i int) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	node, err := n.node(i)
+	if err != nil {
+		return err
+	}
+	if node.Running() {
+		return ErrNodeRunning
+	}
+	return n.startNode(ctx, node)
+}
+
+// JoinFromCheckpoint starts a new full node, bootstrapped through checkpoint
+// sync from the running i-th node. The light client of the new node trusts
+// the latest block of the i-th node and is served by the running nodes, with
+// the i-th node as primary, while its node API serves the checkpoint the
+// restored state is verified against. It returns the index of the new node.
+func (n *Network[_, _, _]) JoinFromCheckpoint(
+	ctx context.Context,
+	i int,
+) (int, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	trusted, err := n.node(i)
+	if err != nil {
+		return 0, err
+	}
+	if !trusted.Running() {
+		return 0, ErrNodeStopped
+	}
+	client, err := rpchttp.New("tcp://" + trusted.RPCAddress)
+	if err != nil {
+		return 0, err
+	}
+	commit, err := client.Commit(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	rpcServers := []string{"http://" + trusted.RPCAddress}
+	for _, node := range n.nodes {
+		if node != trusted && node.Running() {
+			rpcServers = append(rpcServers, "http://"+node.RPCAddress)
+		}
+	}
+
+	idx := len(n.nodes)
+	node, err := n.initNode(idx)
+	if err != nil {
+		if node != nil {
+			err = errors.Join(err, node.engineListener.Close())
+		}
+		return 0, err
+	}
+	node.cmtCfg.StateSync.RPCServers = rpcServers
+	node.cmtCfg.StateSync.TrustHeight = commit.Height
+	node.cmtCfg.StateSync.TrustHash = commit.Hash().String()
+	node.cmtCfg.StateSync.DiscoveryTime = stateSyncDiscoveryTime
+	node.appOpts.Set(
+		server.FlagCheckpointSyncURL, "http://"+trusted.APIAddress,
+	)
+	n.writeConfig(node, n.peers(idx))
+
+	genesis, err := os.ReadFile(n.nodes[0].cmtCfg.GenesisFile())
+	if err != nil {
+		return 0, errors.Join(err, node.engineListener.Close())
+	}
+	if err = os.WriteFile(
+		node.cmtCfg.GenesisFile(), genesis, 0o600,
+	); err != nil {
+		return 0, errors.Join(err, node.engineListener.Close())
+	}
+
+	n.nodes = append(n.nodes, node)
+	node.Engine.Start(node.engineListener)
+	return idx, n.startNode(ctx, node)
+}
+
+// Nodes returns the nodes of the network.
+func (n *Network[NodeT, _, _]) Nodes() []*Node[NodeT] {
+	return n.nodes
+}
+
+// Node returns the i-th node of the network.
+func (n *Network[NodeT, _, _]) Node(i int) (*Node[NodeT], error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.node(i)
+}
+
+// node returns the i-th node of the network. It must be called with the
+// lock held.
+func (n *Network[NodeT, _, _]) node(i int) (*Node[NodeT], error) {
+	if i < 0 || i >= len(n.nodes) {
+		return nil, ErrNodeIndex
+	}
+	return n.nodes[i], nil
+}
+
+// startNode builds and starts the given beacon node. It must be called with
+// the lock held.
+func (n *Network[NodeT, LoggerT, LoggerConfigT]) startNode(
+	ctx context.Context,
+	node *Node[NodeT],
+) error {
+	appDB, err := db.OpenDB(node.Home, dbm.PebbleDBBackend)
+	if err != nil {
+		return err
+	}
+	if node.node, err = n.build(node, appDB); err != nil {
+		return errors.Join(err, appDB.Close())
+	}
+
+	var cctx context.Context
+	cctx, node.cancel = context.WithCancel(ctx)
+	node.done = make(chan error, 1)
+	go func(beaconNode NodeT, done chan<- error) {
+		done <- beaconNode.Start(cctx)
+	}(node.node, node.done)
+	return nil
+}
+
+// build builds the given beacon node. The builder panics on failure, which
+// is recovered into an error.
+func (n *Network[NodeT, LoggerT, LoggerConfigT]) build(
+	node *Node[NodeT],
+	appDB dbm.DB,
+) (_ NodeT, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Wrapf(ErrBuildFailed, "%s: %v", node.Moniker, r)
+		}
+	}()
+	return n.builder.Build(
+		n.cfg.NewLogger(node.Moniker), appDB, nil, node.cmtCfg, node.appOpts,
+	), nil
+}
+
+// stopNode stops the given beacon node and waits for it to return. It must
+// be called with the lock held.
+func (n *Network[NodeT, _, _]) stopNode(node *Node[NodeT]) error {
+	node.cancel()
+	err := <-node.done
+	node.cancel, node.done = nil, nil
+	return err
+}
+
+// closeListeners closes the listeners of the mock execution clients, which
+// are only closed by the clients themselves once started.
+func (n *Network[_, _, _]) closeListeners() error {
+	errs := make([]error, 0)
+	for _, node := range n.nodes {
+		if node != nil && node.engineListener != nil {
+			errs = append(errs, node.engineListener.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// freeAddress returns a loopback address with a port that is free at the
+// time of the call.
+func freeAddress() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	address := listener.Addr().String()
+	return address, listener.Close()
+}
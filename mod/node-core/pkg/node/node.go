@@ -22,10 +22,12 @@ package node
 
 import (
 	"context"
+	"errors"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"cosmossdk.io/store"
 	"github.com/berachain/beacon-kit/mod/log"
 	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
@@ -41,16 +43,31 @@ type node struct {
 	logger log.Logger
 	// registry is the node's service registry.
 	registry *service.Registry
-
-	// TODO: FIX, HACK TO MAKE CLI HAPPY FOR NOW.
-	// THIS SHOULD BE REMOVED EVENTUALLY.
-	types.Node
+	// app provides the application state, e.g. to roll it back or replay
+	// blocks on top of it.
+	app interface {
+		CommitMultiStore() store.CommitMultiStore
+	}
 }
 
 // New returns a new node.
 func New[NodeT types.Node](
-	registry *service.Registry, logger log.Logger) NodeT {
-	return types.Node(&node{registry: registry, logger: logger}).(NodeT)
+	registry *service.Registry,
+	logger log.Logger,
+	app interface {
+		CommitMultiStore() store.CommitMultiStore
+	},
+) NodeT {
+	return types.Node(&node{
+		registry: registry,
+		logger:   logger,
+		app:      app,
+	}).(NodeT)
+}
+
+// CommitMultiStore returns the multistore holding the application state.
+func (n *node) CommitMultiStore() store.CommitMultiStore {
+	return n.app.CommitMultiStore()
 }
 
 // Start starts the node.
@@ -64,26 +81,35 @@ func (n *node) Start(
 	g, gctx := errgroup.WithContext(cctx)
 
 	// listen for quit signals so the calling parent process can gracefully exit
-	n.listenForQuitSignals(g, true, cancelFn)
+	n.listenForQuitSignals(cctx, g, true, cancelFn)
 
 	// Start all the registered services.
 	if err := n.registry.StartAll(gctx); err != nil {
-		return err
+		cancelFn()
+		return errors.Join(err, n.stop())
 	}
 
 	// Wait for those aforementioned exit signals.
-	return g.Wait()
+	return errors.Join(g.Wait(), n.stop())
+}
+
+// stop gracefully stops all the started services, in the reverse order they
+// were started. The parent context is done by now, so a fresh one is used to
+// give each service a chance to flush its state.
+func (n *node) stop() error {
+	return n.registry.StopAll(context.Background())
 }
 
 // listenForQuitSignals listens for SIGINT and SIGTERM. When a signal is
 // received,
 // the cleanup function is called, indicating the caller can gracefully exit or
-// return.
+// return. It also returns once ctx is done.
 //
 // Note, the blocking behavior of this depends on the block argument.
 // The caller must ensure the corresponding context derived from the cancelFn is
 // used correctly.
 func (n *node) listenForQuitSignals(
+	ctx context.Context,
 	g *errgroup.Group,
 	block bool,
 	cancelFn context.CancelFunc,
@@ -91,19 +117,23 @@ func (n *node) listenForQuitSignals(
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 
-	f := func() {
-		sig := <-sigCh
-		cancelFn()
-
-		n.logger.Info("caught exit signal", "signal", sig.String())
+	f := func(ctx context.Context) {
+		defer signal.Stop(sigCh)
+		select {
+		case sig := <-sigCh:
+			cancelFn()
+			n.logger.Info("caught exit signal", "signal", sig.String())
+		case <-ctx.Done():
+			// The node was stopped by its parent, e.g. an in-process devnet.
+		}
 	}
 
 	if block {
 		g.Go(func() error {
-			f()
+			f(ctx)
 			return nil
 		})
 	} else {
-		go f()
+		go f(ctx)
 	}
 }
@@ -20,7 +20,11 @@
 
 package service
 
-import "github.com/berachain/beacon-kit/mod/errors"
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
 
 var (
 	// errServiceAlreadyExists defines an error for when a service already
@@ -45,3 +49,16 @@ var (
 		"%T",
 	)
 )
+
+// errDependencyCycle is returned when service dependencies form a cycle.
+func errDependencyCycle(typeName string) error {
+	return fmt.Errorf("dependency cycle detected at service %s", typeName)
+}
+
+// errUnknownDependency is returned when a service depends on a service that
+// has not been registered.
+func errUnknownDependency(typeName, dep string) error {
+	return fmt.Errorf(
+		"service %s depends on unregistered service %s", typeName, dep,
+	)
+}
@@ -21,6 +21,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
@@ -41,3 +43,11 @@ func WithService(svc Basic) RegistryOption {
 		return r.RegisterService(svc)
 	}
 }
+
+// WithStopTimeout is an option to set the time each service is given to stop.
+func WithStopTimeout(timeout time.Duration) RegistryOption {
+	return func(r *Registry) error {
+		r.stopTimeout = timeout
+		return nil
+	}
+}
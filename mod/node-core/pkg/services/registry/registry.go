@@ -23,10 +23,16 @@ package service
 import (
 	"context"
 	"reflect"
+	"slices"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
+// defaultStopTimeout is the default time each service is given to stop.
+const defaultStopTimeout = 10 * time.Second
+
 // Basic is the minimal interface for a service.
 type Basic interface {
 	// Start spawns any goroutines required by the service.
@@ -35,6 +41,28 @@ type Basic interface {
 	Name() string
 }
 
+// Stoppable is implemented by services that need to release resources or
+// flush in-flight work on shutdown.
+type Stoppable interface {
+	// Stop blocks until the service has shut down or the context is done.
+	Stop(ctx context.Context) error
+}
+
+// Dependent is implemented by services that must be started after, and
+// stopped before, other services.
+type Dependent interface {
+	// Dependencies returns the names of the services this service
+	// depends on.
+	Dependencies() []string
+}
+
+// HealthReporter is implemented by services that can report their health.
+type HealthReporter interface {
+	// Status returns nil if the service is healthy, or an error describing
+	// why it is not.
+	Status() error
+}
+
 type Dispatcher interface {
 	Start(ctx context.Context) error
 }
@@ -49,13 +77,24 @@ type Registry struct {
 	services map[string]Basic
 	// serviceTypes is an ordered slice of registered service types.
 	serviceTypes []string
+	// started is the ordered slice of service types that have been started.
+	started []string
+	// cancel cancels the context every started service derives from.
+	cancel context.CancelFunc
+	// cancels holds the cancel function of the context each started service
+	// was given, keyed by service type.
+	cancels map[string]context.CancelFunc
+	// stopTimeout is the time each service is given to stop.
+	stopTimeout time.Duration
 }
 
 // NewRegistry starts a registry instance for convenience.
 func NewRegistry(
 	opts ...RegistryOption) *Registry {
 	r := &Registry{
-		services: make(map[string]Basic),
+		services:    make(map[string]Basic),
+		cancels:     make(map[string]context.CancelFunc),
+		stopTimeout: defaultStopTimeout,
 	}
 
 	for _, opt := range opts {
@@ -66,23 +105,141 @@ func NewRegistry(
 	return r
 }
 
-// StartAll initialized each service in order of registration.
+// StartAll initialized each service in dependency order, falling back to
+// the order of registration for services that are independent. The services
+// outlive ctx, which only aborts their startup: each one is given its own
+// context that is cancelled when it is stopped.
 func (s *Registry) StartAll(ctx context.Context) error {
+	order, err := s.startOrder()
+	if err != nil {
+		return err
+	}
+
+	var baseCtx context.Context
+	baseCtx, s.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	stopAborting := context.AfterFunc(ctx, s.cancel)
+	defer stopAborting()
+
 	// start all services
-	s.logger.Info("Starting services", "num", len(s.serviceTypes))
-	for _, typeName := range s.serviceTypes {
+	s.logger.Info("Starting services", "num", len(order))
+	for _, typeName := range order {
 		s.logger.Info("Starting service", "type", typeName)
-		svc := s.services[typeName]
-		if svc == nil {
-			s.logger.Error("service not found", "type", typeName)
+		svcCtx, cancel := context.WithCancel(baseCtx)
+		if err = s.services[typeName].Start(svcCtx); err != nil {
+			cancel()
+			return err
+		}
+		s.cancels[typeName] = cancel
+		s.started = append(s.started, typeName)
+	}
+	return nil
+}
+
+// StopAll stops each started service in the reverse order it was started,
+// giving each service at most the configured stop timeout. The context of a
+// service is cancelled right before it is stopped, so that the services it
+// depends on keep running until it has shut down. Services are stopped even
+// if a previous one failed to stop; all errors are returned.
+func (s *Registry) StopAll(ctx context.Context) error {
+	var errs []error
+	s.logger.Info("Stopping services", "num", len(s.started))
+	for _, typeName := range slices.Backward(s.started) {
+		s.cancels[typeName]()
+		delete(s.cancels, typeName)
+
+		svc, ok := s.services[typeName].(Stoppable)
+		if !ok {
 			continue
 		}
 
-		if err := svc.Start(ctx); err != nil {
-			return err
+		s.logger.Info("Stopping service", "type", typeName)
+		stopCtx, cancel := context.WithTimeout(ctx, s.stopTimeout)
+		if err := svc.Stop(stopCtx); err != nil {
+			s.logger.Error(
+				"failed to stop service", "type", typeName, "error", err,
+			)
+			errs = append(errs, errors.Wrapf(err, "%s", typeName))
 		}
+		cancel()
 	}
-	return nil
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.started = nil
+	return errors.Join(errs...)
+}
+
+// Statuses returns the health of every registered service that reports it,
+// keyed by service name.
+func (s *Registry) Statuses() map[string]error {
+	statuses := make(map[string]error)
+	for _, typeName := range s.serviceTypes {
+		if svc, ok := s.services[typeName].(HealthReporter); ok {
+			statuses[typeName] = svc.Status()
+		}
+	}
+	return statuses
+}
+
+// Status returns nil if every service is healthy, or the joined errors of
+// all unhealthy services.
+func (s *Registry) Status() error {
+	var (
+		errs     []error
+		statuses = s.Statuses()
+	)
+	for _, typeName := range s.serviceTypes {
+		if err := statuses[typeName]; err != nil {
+			errs = append(errs, errors.Wrapf(err, "%s", typeName))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// startOrder returns the registered service types sorted such that every
+// service comes after its dependencies. Independent services keep their
+// registration order.
+func (s *Registry) startOrder() ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		order = make([]string, 0, len(s.serviceTypes))
+		state = make(map[string]int, len(s.serviceTypes))
+		visit func(string) error
+	)
+	visit = func(typeName string) error {
+		switch state[typeName] {
+		case visiting:
+			return errDependencyCycle(typeName)
+		case visited:
+			return nil
+		}
+
+		state[typeName] = visiting
+		if svc, ok := s.services[typeName].(Dependent); ok {
+			for _, dep := range svc.Dependencies() {
+				if _, exists := s.services[dep]; !exists {
+					return errUnknownDependency(typeName, dep)
+				}
+				if err := visit(dep); err != nil {
+					return err
+				}
+			}
+		}
+		state[typeName] = visited
+		order = append(order, typeName)
+		return nil
+	}
+
+	for _, typeName := range s.serviceTypes {
+		if err := visit(typeName); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
 }
 
 // RegisterService appends a service constructor function to the service
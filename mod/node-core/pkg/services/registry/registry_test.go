@@ -22,6 +22,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -81,3 +82,144 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+// lifecycleService is a service that records its lifecycle calls.
+type lifecycleService struct {
+	name   string
+	deps   []string
+	status error
+	calls  *[]string
+}
+
+func (s *lifecycleService) Name() string { return s.name }
+
+func (s *lifecycleService) Dependencies() []string { return s.deps }
+
+func (s *lifecycleService) Status() error { return s.status }
+
+func (s *lifecycleService) Start(context.Context) error {
+	*s.calls = append(*s.calls, "start:"+s.name)
+	return nil
+}
+
+func (s *lifecycleService) Stop(context.Context) error {
+	*s.calls = append(*s.calls, "stop:"+s.name)
+	return nil
+}
+
+func TestRegistry_DependencyOrder(t *testing.T) {
+	var calls []string
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(&lifecycleService{
+			name: "api", deps: []string{"db", "engine"}, calls: &calls,
+		}),
+		service.WithService(&lifecycleService{
+			name: "engine", calls: &calls,
+		}),
+		service.WithService(&lifecycleService{
+			name: "db", calls: &calls,
+		}),
+	)
+
+	require.NoError(t, registry.StartAll(context.Background()))
+	require.NoError(t, registry.StopAll(context.Background()))
+	require.Equal(t, []string{
+		"start:db", "start:engine", "start:api",
+		"stop:api", "stop:engine", "stop:db",
+	}, calls)
+}
+
+func TestRegistry_DependencyErrors(t *testing.T) {
+	var calls []string
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(&lifecycleService{
+			name: "a", deps: []string{"b"}, calls: &calls,
+		}),
+		service.WithService(&lifecycleService{
+			name: "b", deps: []string{"a"}, calls: &calls,
+		}),
+	)
+	require.Error(t, registry.StartAll(context.Background()))
+	require.Empty(t, calls)
+
+	registry = service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(&lifecycleService{
+			name: "a", deps: []string{"missing"}, calls: &calls,
+		}),
+	)
+	require.Error(t, registry.StartAll(context.Background()))
+	require.Empty(t, calls)
+}
+
+func TestRegistry_Status(t *testing.T) {
+	errUnhealthy := errors.New("unhealthy")
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(&lifecycleService{name: "healthy"}),
+		service.WithService(&lifecycleService{
+			name: "sick", status: errUnhealthy,
+		}),
+	)
+
+	statuses := registry.Statuses()
+	require.Len(t, statuses, 2)
+	require.NoError(t, statuses["healthy"])
+	require.ErrorIs(t, registry.Status(), errUnhealthy)
+}
+
+// contextService records whether its dependencies' contexts were still live
+// when it was stopped.
+type contextService struct {
+	name string
+	deps []*contextService
+	ctx  context.Context
+	live []bool
+}
+
+func (s *contextService) Name() string { return s.name }
+
+func (s *contextService) Dependencies() []string {
+	names := make([]string, 0, len(s.deps))
+	for _, dep := range s.deps {
+		names = append(names, dep.name)
+	}
+	return names
+}
+
+func (s *contextService) Start(ctx context.Context) error {
+	s.ctx = ctx
+	return nil
+}
+
+func (s *contextService) Stop(context.Context) error {
+	s.live = append(s.live, s.ctx.Err() == nil)
+	for _, dep := range s.deps {
+		s.live = append(s.live, dep.ctx.Err() == nil)
+	}
+	return nil
+}
+
+func TestRegistry_StopCancelsInOrder(t *testing.T) {
+	db := &contextService{name: "db"}
+	api := &contextService{name: "api", deps: []*contextService{db}}
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(api),
+		service.WithService(db),
+	)
+
+	// The services outlive the context they were started with.
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, registry.StartAll(ctx))
+	cancel()
+	require.NoError(t, api.ctx.Err())
+	require.NoError(t, registry.StopAll(context.Background()))
+
+	// Each service's context is done once it is stopped, while the services
+	// it depends on are still running.
+	require.Equal(t, []bool{false, true}, api.live)
+	require.Equal(t, []bool{false}, db.live)
+}
@@ -31,6 +31,6 @@ import (
 type Node interface {
 	Start(context.Context) error
 
-	// TODO: FIX, HACK TO MAKE CLI HAPPY FOR NOW.
+	// CommitMultiStore returns the multistore holding the application state.
 	CommitMultiStore() store.CommitMultiStore
 }
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+// serviceName is the name the node reports to the collector.
+const serviceName = "beacond"
+
+// Service installs the global OpenTelemetry tracer provider. Instrumented
+// packages obtain their tracer through otel.Tracer, so that spans are
+// dropped by the default no-op provider unless tracing is enabled.
+type Service struct {
+	provider *sdktrace.TracerProvider
+}
+
+// NewService creates a new tracing service. If tracing is enabled, spans
+// are batched and exported to the configured OTLP/HTTP endpoint.
+func NewService(cfg *Config) (*Service, error) {
+	if !cfg.Enabled {
+		return &Service{}, nil
+	}
+
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(cfg.Endpoint),
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(serviceName),
+		)),
+		sdktrace.WithSampler(sdktrace.ParentBased(
+			sdktrace.TraceIDRatioBased(cfg.SampleRatio),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	return &Service{provider: provider}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "tracing"
+}
+
+// Start starts the tracing service.
+func (s *Service) Start(context.Context) error {
+	return nil
+}
+
+// Stop flushes the pending spans and shuts the tracer provider down.
+func (s *Service) Stop(ctx context.Context) error {
+	if s.provider == nil {
+		return nil
+	}
+	return s.provider.Shutdown(ctx)
+}
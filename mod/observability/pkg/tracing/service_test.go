@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/observability/pkg/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+func TestDisabledServiceKeepsNoopProvider(t *testing.T) {
+	provider := otel.GetTracerProvider()
+	cfg := tracing.DefaultConfig()
+	svc, err := tracing.NewService(&cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if otel.GetTracerProvider() != provider {
+		t.Fatal("disabled service replaced the global tracer provider")
+	}
+
+	_, span := otel.Tracer("test").Start(context.Background(), "span")
+	if span.IsRecording() {
+		t.Fatal("span is recorded while tracing is disabled")
+	}
+	span.End()
+
+	if err = svc.Stop(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEnabledServiceExportsSpans(t *testing.T) {
+	var exports atomic.Int32
+	collector := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/v1/traces" {
+				exports.Add(1)
+			}
+			w.WriteHeader(http.StatusOK)
+		},
+	))
+	defer collector.Close()
+
+	cfg := tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Endpoint = strings.TrimPrefix(collector.URL, "http://")
+	svc, err := tracing.NewService(&cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if svc.Name() != "tracing" {
+		t.Fatalf("unexpected name: %s", svc.Name())
+	}
+
+	_, span := otel.Tracer("test").Start(context.Background(), "span")
+	if !span.IsRecording() {
+		t.Fatal("span is not recorded while tracing is enabled")
+	}
+	span.End()
+
+	// Stopping the service flushes the batched spans to the collector.
+	if err = svc.Stop(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exports.Load() == 0 {
+		t.Fatal("no spans were exported to the collector")
+	}
+}
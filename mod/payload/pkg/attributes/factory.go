@@ -23,6 +23,7 @@ package attributes
 import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -36,9 +37,12 @@ type Factory[
 	chainSpec common.ChainSpec
 	// logger is the logger for the attributes factory.
 	logger log.Logger
-	// suggestedFeeRecipient is the suggested fee recipient sent to
-	// the execution client for the payload build.
-	suggestedFeeRecipient common.ExecutionAddress
+	// proposer is the pubkey of the local validator the payloads are
+	// built for.
+	proposer crypto.BLSPubkey
+	// feeRecipients resolves the fee recipient sent to the execution
+	// client for the payload build.
+	feeRecipients FeeRecipients
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -49,12 +53,14 @@ func NewAttributesFactory[
 ](
 	chainSpec common.ChainSpec,
 	logger log.Logger,
-	suggestedFeeRecipient common.ExecutionAddress,
+	proposer crypto.BLSPubkey,
+	feeRecipients FeeRecipients,
 ) *Factory[BeaconStateT, PayloadAttributesT, WithdrawalT] {
 	return &Factory[BeaconStateT, PayloadAttributesT, WithdrawalT]{
-		chainSpec:             chainSpec,
-		logger:                logger,
-		suggestedFeeRecipient: suggestedFeeRecipient,
+		chainSpec:     chainSpec,
+		logger:        logger,
+		proposer:      proposer,
+		feeRecipients: feeRecipients,
 	}
 }
 
@@ -97,8 +103,33 @@ func (f *Factory[
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.suggestedFeeRecipient(st),
 		withdrawals,
 		prevHeadRoot,
 	)
 }
+
+// suggestedFeeRecipient resolves the fee recipient for the local proposer,
+// preferring a mapping by pubkey over one by validator index.
+func (f *Factory[
+	BeaconStateT,
+	PayloadAttributesT,
+	WithdrawalT,
+]) suggestedFeeRecipient(st BeaconStateT) common.ExecutionAddress {
+	if recipient, ok := f.feeRecipients.FeeRecipientByPubkey(
+		f.proposer,
+	); ok {
+		return recipient
+	}
+
+	// The proposer may not be part of the validator set yet, in which case
+	// only the default applies.
+	if index, err := st.ValidatorIndexByPubkey(f.proposer); err == nil {
+		if recipient, ok := f.feeRecipients.FeeRecipientByIndex(
+			index,
+		); ok {
+			return recipient
+		}
+	}
+	return f.feeRecipients.DefaultFeeRecipient()
+}
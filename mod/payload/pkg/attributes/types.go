@@ -23,6 +23,8 @@ package attributes
 import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // BeaconState is an interface for accessing the beacon state.
@@ -31,6 +33,26 @@ type BeaconState[WithdrawalT any] interface {
 	ExpectedWithdrawals() ([]WithdrawalT, error)
 	// GetRandaoMixAtIndex returns the randao mix at the given index.
 	GetRandaoMixAtIndex(index uint64) (common.Bytes32, error)
+	// ValidatorIndexByPubkey returns the index of the validator with the
+	// given pubkey.
+	ValidatorIndexByPubkey(pubkey crypto.BLSPubkey) (math.ValidatorIndex, error)
+}
+
+// FeeRecipients resolves the fee recipient configured for a proposer.
+type FeeRecipients interface {
+	// DefaultFeeRecipient returns the fee recipient used for proposers
+	// without a mapping.
+	DefaultFeeRecipient() common.ExecutionAddress
+	// FeeRecipientByPubkey returns the fee recipient mapped to the given
+	// validator pubkey.
+	FeeRecipientByPubkey(
+		pubkey crypto.BLSPubkey,
+	) (common.ExecutionAddress, bool)
+	// FeeRecipientByIndex returns the fee recipient mapped to the given
+	// validator index.
+	FeeRecipientByIndex(
+		index math.ValidatorIndex,
+	) (common.ExecutionAddress, bool)
 }
 
 // PayloadAttributes is the interface for the payload attributes.
@@ -50,6 +50,8 @@ type PayloadBuilder[
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot]
 	// attributesFactory is used to create attributes for the
 	attributesFactory AttributesFactory[BeaconStateT, PayloadAttributesT]
+	// feeRecipients is used to check the fee recipient of built payloads.
+	feeRecipients FeeRecipients
 }
 
 // New creates a new service.
@@ -67,6 +69,7 @@ func New[
 	ee ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT],
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot],
 	af AttributesFactory[BeaconStateT, PayloadAttributesT],
+	fr FeeRecipients,
 ) *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -81,6 +84,7 @@ func New[
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		feeRecipients:     fr,
 	}
 }
 
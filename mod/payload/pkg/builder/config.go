@@ -30,6 +30,9 @@ const (
 	// defaultPayloadTimeout is the default value for local build
 	// payload timeout.
 	defaultPayloadTimeout = 1200 * time.Millisecond
+	// defaultFeeRecipientsPath is the default path to the fee recipients
+	// file, relative to the node home directory.
+	defaultFeeRecipientsPath = "config/fee_recipients.json"
 )
 
 // Config is the configuration for the payload builder.
@@ -41,6 +44,11 @@ type Config struct {
 	// SuggestedFeeRecipient is the address that will receive the transaction
 	// fees produced by any blocks from this node.
 	SuggestedFeeRecipient common.ExecutionAddress `mapstructure:"suggested-fee-recipient"`
+	// FeeRecipientsPath is the path to the file mapping validator pubkeys or
+	// indices to fee recipients. Proposers without a mapping use
+	// SuggestedFeeRecipient. Relative paths are resolved against the node
+	// home directory.
+	FeeRecipientsPath string `mapstructure:"fee-recipients-path"`
 	// PayloadTimeout is the timeout parameter for local build
 	// payload. This should match, or be slightly less than the configured
 	// timeout on your execution client. It also must be less than
@@ -53,6 +61,7 @@ func DefaultConfig() Config {
 	return Config{
 		Enabled:               true,
 		SuggestedFeeRecipient: common.ExecutionAddress{},
+		FeeRecipientsPath:     defaultFeeRecipientsPath,
 		PayloadTimeout:        defaultPayloadTimeout,
 	}
 }
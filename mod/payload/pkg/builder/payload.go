@@ -196,12 +196,12 @@ func (pb *PayloadBuilder[
 
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	if !pb.feeRecipients.IsKnownFeeRecipient(payload.GetFeeRecipient()) {
 		pb.logger.Warn(
-			"Payload fee recipient does not match suggested fee recipient - "+
-				"please check both your CL and EL configuration",
+			"Payload fee recipient does not match any suggested fee "+
+				"recipient - please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"default_fee_recipient", pb.feeRecipients.DefaultFeeRecipient(),
 		)
 	}
 	return envelope, err
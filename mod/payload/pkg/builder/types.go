@@ -78,6 +78,17 @@ type ExecutionPayloadHeader interface {
 	GetParentHash() common.ExecutionHash
 }
 
+// FeeRecipients is the interface for the fee recipients configured for the
+// local proposers.
+type FeeRecipients interface {
+	// DefaultFeeRecipient returns the fee recipient used for proposers
+	// without a mapping.
+	DefaultFeeRecipient() common.ExecutionAddress
+	// IsKnownFeeRecipient returns true if the given address is the fee
+	// recipient of any local proposer.
+	IsKnownFeeRecipient(address common.ExecutionAddress) bool
+}
+
 // AttributesFactory is the interface for the attributes factory.
 type AttributesFactory[
 	BeaconStateT any,
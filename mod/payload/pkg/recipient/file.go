@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recipient
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// filePermissions are the permissions used when persisting the fee
+// recipients file.
+const filePermissions = 0o600
+
+// mapping holds the fee recipients configured per proposer.
+type mapping struct {
+	byPubkey map[crypto.BLSPubkey]common.ExecutionAddress
+	byIndex  map[math.ValidatorIndex]common.ExecutionAddress
+}
+
+// newMapping returns an empty mapping.
+func newMapping() *mapping {
+	return &mapping{
+		byPubkey: make(map[crypto.BLSPubkey]common.ExecutionAddress),
+		byIndex:  make(map[math.ValidatorIndex]common.ExecutionAddress),
+	}
+}
+
+// decodeMapping parses the fee recipients file format, a JSON object whose
+// keys are either 0x-prefixed validator pubkeys or decimal validator indices
+// and whose values are execution addresses:
+//
+//	{
+//	  "0x93247f...": "0x9f1a...",
+//	  "12": "0x20f3..."
+//	}
+func decodeMapping(bz []byte) (*mapping, error) {
+	var raw map[string]common.ExecutionAddress
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, err
+	}
+
+	m := newMapping()
+	for key, recipient := range raw {
+		if strings.HasPrefix(key, "0x") {
+			var pubkey crypto.BLSPubkey
+			if err := pubkey.UnmarshalText([]byte(key)); err != nil {
+				return nil, errors.Wrapf(ErrInvalidProposerKey, "%s", key)
+			}
+			m.byPubkey[pubkey] = recipient
+			continue
+		}
+
+		index, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidProposerKey, "%s", key)
+		}
+		m.byIndex[math.ValidatorIndex(index)] = recipient
+	}
+	return m, nil
+}
+
+// encode serializes the mapping into the fee recipients file format.
+func (m *mapping) encode() ([]byte, error) {
+	raw := make(
+		map[string]common.ExecutionAddress, len(m.byPubkey)+len(m.byIndex),
+	)
+	for pubkey, recipient := range m.byPubkey {
+		raw[pubkey.String()] = recipient
+	}
+	for index, recipient := range m.byIndex {
+		raw[index.Base10()] = recipient
+	}
+	return json.MarshalIndent(raw, "", "  ")
+}
+
+// readMapping reads the mapping from the file at the given path. A missing
+// file is treated as an empty mapping.
+func readMapping(path string) (*mapping, error) {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return newMapping(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	return decodeMapping(bz)
+}
+
+// writeMapping atomically replaces the file at the given path with the
+// mapping.
+func writeMapping(path string, m *mapping) error {
+	bz, err := m.encode()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(bz); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Chmod(filePermissions); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// clone returns a deep copy of the mapping.
+func (m *mapping) clone() *mapping {
+	c := newMapping()
+	for pubkey, recipient := range m.byPubkey {
+		c.byPubkey[pubkey] = recipient
+	}
+	for index, recipient := range m.byIndex {
+		c.byIndex[index] = recipient
+	}
+	return c
+}
@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recipient
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// defaultReloadInterval is the interval at which the fee recipients file is
+// checked for changes.
+const defaultReloadInterval = 5 * time.Second
+
+// Registry resolves the fee recipient of a block proposer. Recipients are
+// looked up by validator pubkey first, then by validator index, falling back
+// to the default suggested fee recipient. The mapping is backed by a file
+// that is reloaded whenever it changes on disk.
+type Registry struct {
+	// logger is used to report reloads of the fee recipients file.
+	logger log.Logger
+	// path is the location of the fee recipients file. If empty, the
+	// mapping is neither loaded nor persisted.
+	path string
+	// defaultRecipient is used for proposers without a mapping.
+	defaultRecipient common.ExecutionAddress
+	// reloadInterval is the interval at which the file is checked for
+	// changes.
+	reloadInterval time.Duration
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// mapping holds the configured fee recipients.
+	mapping *mapping
+	// modTime is the modification time of the file when it was last
+	// loaded or written.
+	modTime time.Time
+	// loaded is true once the file has been read at least once.
+	loaded bool
+}
+
+// NewRegistry creates a new fee recipient registry and loads the mapping
+// from the file at the given path, if any.
+func NewRegistry(
+	logger log.Logger,
+	path string,
+	defaultRecipient common.ExecutionAddress,
+) (*Registry, error) {
+	r := &Registry{
+		logger:           logger,
+		path:             path,
+		defaultRecipient: defaultRecipient,
+		reloadInterval:   defaultReloadInterval,
+		mapping:          newMapping(),
+	}
+	if path == "" {
+		return r, nil
+	}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Name returns the name of the service.
+func (r *Registry) Name() string {
+	return "fee-recipients"
+}
+
+// Start watches the fee recipients file and reloads the mapping whenever
+// the file changes.
+func (r *Registry) Start(ctx context.Context) error {
+	if r.path == "" {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(r.reloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				reloaded, err := r.reload()
+				if err != nil {
+					r.logger.Error(
+						"Failed to reload fee recipients; keeping previous",
+						"path", r.path, "error", err,
+					)
+					continue
+				}
+				if reloaded {
+					r.logger.Info("Reloaded fee recipients", "path", r.path)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// DefaultFeeRecipient returns the fee recipient used for proposers without
+// a mapping.
+func (r *Registry) DefaultFeeRecipient() common.ExecutionAddress {
+	return r.defaultRecipient
+}
+
+// FeeRecipientByPubkey returns the fee recipient mapped to the given
+// validator pubkey.
+func (r *Registry) FeeRecipientByPubkey(
+	pubkey crypto.BLSPubkey,
+) (common.ExecutionAddress, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	recipient, ok := r.mapping.byPubkey[pubkey]
+	return recipient, ok
+}
+
+// FeeRecipientByIndex returns the fee recipient mapped to the given
+// validator index.
+func (r *Registry) FeeRecipientByIndex(
+	index math.ValidatorIndex,
+) (common.ExecutionAddress, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	recipient, ok := r.mapping.byIndex[index]
+	return recipient, ok
+}
+
+// IsKnownFeeRecipient returns true if the given address is the default fee
+// recipient or is mapped to any proposer.
+func (r *Registry) IsKnownFeeRecipient(address common.ExecutionAddress) bool {
+	if address == r.defaultRecipient {
+		return true
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, recipient := range r.mapping.byPubkey {
+		if recipient == address {
+			return true
+		}
+	}
+	for _, recipient := range r.mapping.byIndex {
+		if recipient == address {
+			return true
+		}
+	}
+	return false
+}
+
+// SetFeeRecipients maps each of the given validator indices to its fee
+// recipient and persists the updated mapping.
+func (r *Registry) SetFeeRecipients(
+	recipients map[math.ValidatorIndex]common.ExecutionAddress,
+) error {
+	if r.path == "" {
+		return ErrPersistenceDisabled
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	updated := r.mapping.clone()
+	for index, recipient := range recipients {
+		updated.byIndex[index] = recipient
+	}
+	if err := writeMapping(r.path, updated); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return err
+	}
+	r.mapping, r.modTime, r.loaded = updated, info.ModTime(), true
+	return nil
+}
+
+// reload reads the fee recipients file if it changed since it was last
+// loaded or written, and reports whether the mapping was replaced.
+func (r *Registry) reload() (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var modTime time.Time
+	info, err := os.Stat(r.path)
+	switch {
+	case err == nil:
+		modTime = info.ModTime()
+	case !os.IsNotExist(err):
+		return false, err
+	}
+	if r.loaded && modTime.Equal(r.modTime) {
+		return false, nil
+	}
+
+	m, err := readMapping(r.path)
+	if err != nil {
+		return false, err
+	}
+	r.mapping, r.modTime, r.loaded = m, modTime, true
+	return true, nil
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recipient
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	defaultRecipient = common.ExecutionAddress{0xde}
+	pubkeyRecipient  = common.ExecutionAddress{0x01}
+	indexRecipient   = common.ExecutionAddress{0x02}
+	pubkey           = crypto.BLSPubkey{0xaa}
+)
+
+func writeFile(t *testing.T, path string, m *mapping, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, writeMapping(path, m))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestRegistryLoadsMapping(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fee_recipients.json")
+	m := newMapping()
+	m.byPubkey[pubkey] = pubkeyRecipient
+	m.byIndex[7] = indexRecipient
+	writeFile(t, path, m, time.Now())
+
+	r, err := NewRegistry(noop.NewLogger[any](), path, defaultRecipient)
+	require.NoError(t, err)
+
+	recipient, ok := r.FeeRecipientByPubkey(pubkey)
+	require.True(t, ok)
+	require.Equal(t, pubkeyRecipient, recipient)
+
+	recipient, ok = r.FeeRecipientByIndex(7)
+	require.True(t, ok)
+	require.Equal(t, indexRecipient, recipient)
+
+	_, ok = r.FeeRecipientByIndex(8)
+	require.False(t, ok)
+
+	require.Equal(t, defaultRecipient, r.DefaultFeeRecipient())
+	require.True(t, r.IsKnownFeeRecipient(defaultRecipient))
+	require.True(t, r.IsKnownFeeRecipient(indexRecipient))
+	require.False(t, r.IsKnownFeeRecipient(common.ExecutionAddress{0xff}))
+}
+
+func TestRegistryMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fee_recipients.json")
+	r, err := NewRegistry(noop.NewLogger[any](), path, defaultRecipient)
+	require.NoError(t, err)
+
+	_, ok := r.FeeRecipientByPubkey(pubkey)
+	require.False(t, ok)
+
+	// A file that is still missing is not reloaded again.
+	reloaded, err := r.reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+}
+
+func TestRegistryRejectsInvalidKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fee_recipients.json")
+	for _, contents := range []string{
+		`{"validator-1": "0x0000000000000000000000000000000000000001"}`,
+		`{"0x1234": "0x0000000000000000000000000000000000000001"}`,
+		`{"1": "not-an-address"}`,
+	} {
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+		_, err := NewRegistry(noop.NewLogger[any](), path, defaultRecipient)
+		require.Error(t, err, contents)
+	}
+}
+
+func TestRegistrySetFeeRecipientsPersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fee_recipients.json")
+	r, err := NewRegistry(noop.NewLogger[any](), path, defaultRecipient)
+	require.NoError(t, err)
+
+	require.NoError(t, r.SetFeeRecipients(
+		map[math.ValidatorIndex]common.ExecutionAddress{3: indexRecipient},
+	))
+	recipient, ok := r.FeeRecipientByIndex(3)
+	require.True(t, ok)
+	require.Equal(t, indexRecipient, recipient)
+
+	// Writing the file ourselves must not trigger a reload.
+	reloaded, err := r.reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+
+	// A fresh registry sees the persisted mapping.
+	r, err = NewRegistry(noop.NewLogger[any](), path, defaultRecipient)
+	require.NoError(t, err)
+	recipient, ok = r.FeeRecipientByIndex(3)
+	require.True(t, ok)
+	require.Equal(t, indexRecipient, recipient)
+}
+
+func TestRegistrySetFeeRecipientsWithoutFile(t *testing.T) {
+	r, err := NewRegistry(noop.NewLogger[any](), "", defaultRecipient)
+	require.NoError(t, err)
+	require.ErrorIs(t, r.SetFeeRecipients(
+		map[math.ValidatorIndex]common.ExecutionAddress{3: indexRecipient},
+	), ErrPersistenceDisabled)
+}
+
+func TestRegistryReloadsChangedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fee_recipients.json")
+	start := time.Now().Add(-time.Hour)
+	writeFile(t, path, newMapping(), start)
+
+	r, err := NewRegistry(noop.NewLogger[any](), path, defaultRecipient)
+	require.NoError(t, err)
+
+	m := newMapping()
+	m.byPubkey[pubkey] = pubkeyRecipient
+	writeFile(t, path, m, start.Add(time.Minute))
+
+	reloaded, err := r.reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	recipient, ok := r.FeeRecipientByPubkey(pubkey)
+	require.True(t, ok)
+	require.Equal(t, pubkeyRecipient, recipient)
+
+	// A broken edit keeps the previous mapping in place.
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = r.reload()
+	require.Error(t, err)
+	recipient, ok = r.FeeRecipientByPubkey(pubkey)
+	require.True(t, ok)
+	require.Equal(t, pubkeyRecipient, recipient)
+}
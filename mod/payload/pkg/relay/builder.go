@@ -0,0 +1,453 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// registrationTimeout is the deadline for the relay to accept a validator
+// registration.
+const registrationTimeout = 10 * time.Second
+
+// Builder sources execution payloads from external block builders through a
+// relay speaking the builder API, in the manner of MEV-boost. It registers
+// the local validator with the relay, requests and verifies bids, and
+// unblinds the blocks signed over the winning bids.
+type Builder[
+	BeaconBlockT BeaconBlock[BlindedBeaconBlockT, ExecutionPayloadHeaderT],
+	BlindedBeaconBlockT BlindedBeaconBlock[BlindedBeaconBlockBodyT],
+	BlindedBeaconBlockBodyT BlindedBeaconBlockBody[ExecutionPayloadHeaderT],
+	BuilderBidT BuilderBid[ExecutionPayloadHeaderT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadHeaderT],
+	ExecutionPayloadAndBlobsBundleT ExecutionPayloadAndBlobsBundle[ExecutionPayloadT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ForkDataT ForkData[ForkDataT],
+	SignedBlindedBeaconBlockT SignedBlindedBeaconBlock[
+		SignedBlindedBeaconBlockT, BlindedBeaconBlockT, ForkDataT,
+	],
+	SignedBuilderBidT SignedBuilderBid[BuilderBidT, ForkDataT],
+	SignedValidatorRegistrationT SignedValidatorRegistration[
+		SignedValidatorRegistrationT, ForkDataT,
+	],
+] struct {
+	// cfg is the relay configuration.
+	cfg *Config
+	// logger is used to report registrations and relay failures.
+	logger log.Logger
+	// chainSpec is the chain spec.
+	chainSpec common.ChainSpec
+	// signer signs registrations and blinded blocks, and verifies bids.
+	signer crypto.BLSSigner
+	// feeRecipients resolves the fee recipient registered with the relay.
+	feeRecipients FeeRecipients
+	// client speaks the builder API to the relay.
+	client *client
+	// builderPubkey is the builder pinned in the relay URL, if any.
+	builderPubkey *crypto.BLSPubkey
+}
+
+// NewBuilder creates a new external builder for the relay in the given
+// config.
+func NewBuilder[
+	BeaconBlockT BeaconBlock[BlindedBeaconBlockT, ExecutionPayloadHeaderT],
+	BlindedBeaconBlockT BlindedBeaconBlock[BlindedBeaconBlockBodyT],
+	BlindedBeaconBlockBodyT BlindedBeaconBlockBody[ExecutionPayloadHeaderT],
+	BuilderBidT BuilderBid[ExecutionPayloadHeaderT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadHeaderT],
+	ExecutionPayloadAndBlobsBundleT ExecutionPayloadAndBlobsBundle[ExecutionPayloadT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ForkDataT ForkData[ForkDataT],
+	SignedBlindedBeaconBlockT SignedBlindedBeaconBlock[
+		SignedBlindedBeaconBlockT, BlindedBeaconBlockT, ForkDataT,
+	],
+	SignedBuilderBidT SignedBuilderBid[BuilderBidT, ForkDataT],
+	SignedValidatorRegistrationT SignedValidatorRegistration[
+		SignedValidatorRegistrationT, ForkDataT,
+	],
+](
+	cfg *Config,
+	logger log.Logger,
+	chainSpec common.ChainSpec,
+	signer crypto.BLSSigner,
+	feeRecipients FeeRecipients,
+) (*Builder[
+	BeaconBlockT, BlindedBeaconBlockT, BlindedBeaconBlockBodyT, BuilderBidT,
+	ExecutionPayloadT, ExecutionPayloadAndBlobsBundleT,
+	ExecutionPayloadHeaderT, ForkDataT, SignedBlindedBeaconBlockT,
+	SignedBuilderBidT, SignedValidatorRegistrationT,
+], error) {
+	b := &Builder[
+		BeaconBlockT, BlindedBeaconBlockT, BlindedBeaconBlockBodyT,
+		BuilderBidT, ExecutionPayloadT, ExecutionPayloadAndBlobsBundleT,
+		ExecutionPayloadHeaderT, ForkDataT, SignedBlindedBeaconBlockT,
+		SignedBuilderBidT, SignedValidatorRegistrationT,
+	]{
+		cfg:           cfg,
+		logger:        logger,
+		chainSpec:     chainSpec,
+		signer:        signer,
+		feeRecipients: feeRecipients,
+	}
+	if !cfg.Enabled {
+		return b, nil
+	}
+
+	relayURL, err := url.Parse(cfg.URL)
+	if err != nil || relayURL.Host == "" {
+		return nil, errors.Wrapf(ErrInvalidRelayURL, "%q", cfg.URL)
+	}
+	if relayURL.User != nil {
+		pubkey := new(crypto.BLSPubkey)
+		if err = pubkey.UnmarshalText(
+			[]byte(relayURL.User.Username()),
+		); err != nil {
+			return nil, errors.Wrapf(
+				ErrInvalidRelayURL, "relay pubkey: %v", err,
+			)
+		}
+		b.builderPubkey = pubkey
+		relayURL.User = nil
+	}
+	b.client = newClient(relayURL.String(), &http.Client{})
+	return b, nil
+}
+
+// Name returns the name of the service.
+func (b *Builder[_, _, _, _, _, _, _, _, _, _, _]) Name() string {
+	return "relay"
+}
+
+// Start registers the validator with the relay and keeps the registration
+// fresh until the context is cancelled.
+func (b *Builder[_, _, _, _, _, _, _, _, _, _, _]) Start(
+	ctx context.Context,
+) error {
+	if !b.cfg.Enabled {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(b.cfg.RegistrationInterval)
+		defer ticker.Stop()
+		for {
+			if err := b.registerValidator(ctx); err != nil {
+				b.logger.Error(
+					"Failed to register validator with relay",
+					"error", err,
+				)
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Enabled returns true if payloads are requested from the relay.
+func (b *Builder[_, _, _, _, _, _, _, _, _, _, _]) Enabled() bool {
+	return b.cfg.Enabled
+}
+
+// GetHeader requests the relay's best bid for a payload building on top of
+// parentHash at the given slot. The bid is verified to be signed by its
+// builder, to build on the given parent and to carry a valid number of
+// blobs. It returns the header of the offered payload, the commitments to
+// its blobs and the value of the bid.
+func (b *Builder[
+	_, _, _, _, _, _, ExecutionPayloadHeaderT, ForkDataT, _,
+	SignedBuilderBidT, _,
+]) GetHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+) (
+	ExecutionPayloadHeaderT,
+	eip4844.KZGCommitments[common.ExecutionHash],
+	*math.U256,
+	error,
+) {
+	var (
+		header ExecutionPayloadHeaderT
+		resp   versioned[SignedBuilderBidT]
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, b.cfg.GetHeaderTimeout)
+	defer cancel()
+	if err := b.client.getHeader(
+		ctx,
+		slot.Base10(),
+		parentHash.Hex(),
+		b.signer.PublicKey().String(),
+		&resp,
+	); err != nil {
+		return header, nil, nil, err
+	}
+
+	signedBid := resp.Data
+	if signedBid.IsNil() {
+		return header, nil, nil, ErrNoBid
+	}
+	if err := signedBid.Verify(
+		b.builderForkData(),
+		b.chainSpec.DomainTypeApplicationMask(),
+		b.signer.VerifySignature,
+	); err != nil {
+		return header, nil, nil, err
+	}
+
+	bid := signedBid.GetMessage()
+	if b.builderPubkey != nil && bid.GetPubkey() != *b.builderPubkey {
+		return header, nil, nil, errors.Wrapf(
+			ErrUnexpectedBuilder, "got %s", bid.GetPubkey(),
+		)
+	}
+	header = bid.GetHeader()
+	if header.GetParentHash() != parentHash {
+		return header, nil, nil, errors.Wrapf(
+			ErrBidParentHashMismatch,
+			"expected: %s, got: %s", parentHash, header.GetParentHash(),
+		)
+	}
+	if bid.GetValue().IsZero() {
+		return header, nil, nil, ErrZeroValueBid
+	}
+	if commitments := bid.GetBlobKzgCommitments(); uint64(
+		len(commitments),
+	) > b.chainSpec.MaxBlobsPerBlock() {
+		return header, nil, nil, errors.Wrapf(
+			ErrTooManyBlobs, "got %d", len(commitments),
+		)
+	}
+	return header, bid.GetBlobKzgCommitments(), bid.GetValue(), nil
+}
+
+// SubmitBlindedBlock blinds the given block over the header of a bid, signs
+// it as its proposer and submits it to the relay, which reveals the payload
+// of the bid. The revealed payload and blobs are verified against the
+// blinded block before they are returned.
+func (b *Builder[
+	BeaconBlockT, _, _, _, ExecutionPayloadT, _, ExecutionPayloadHeaderT,
+	ForkDataT, SignedBlindedBeaconBlockT, _, _,
+]) SubmitBlindedBlock(
+	ctx context.Context,
+	blk BeaconBlockT,
+	header ExecutionPayloadHeaderT,
+	genesisValidatorsRoot common.Root,
+) (ExecutionPayloadT, engineprimitives.BlobsBundle, error) {
+	var (
+		forkData  ForkDataT
+		signedBlk SignedBlindedBeaconBlockT
+		payload   ExecutionPayloadT
+	)
+
+	blinded := blk.Blind(header)
+	signedBlk, err := signedBlk.New(
+		blinded,
+		forkData.New(
+			version.FromUint32[common.Version](
+				b.chainSpec.ActiveForkVersionForSlot(blinded.GetSlot()),
+			),
+			genesisValidatorsRoot,
+		),
+		b.chainSpec.DomainTypeProposer(),
+		b.signer,
+	)
+	if err != nil {
+		return payload, nil, err
+	}
+	return b.unblind(ctx, signedBlk)
+}
+
+// unblind submits the signed blinded block to the relay and verifies the
+// revealed payload and blobs against it.
+func (b *Builder[
+	_, _, _, _, ExecutionPayloadT, ExecutionPayloadAndBlobsBundleT, _, _,
+	SignedBlindedBeaconBlockT, _, _,
+]) unblind(
+	ctx context.Context,
+	signedBlk SignedBlindedBeaconBlockT,
+) (ExecutionPayloadT, engineprimitives.BlobsBundle, error) {
+	var (
+		payload ExecutionPayloadT
+		resp    versioned[ExecutionPayloadAndBlobsBundleT]
+	)
+	if signedBlk.IsNil() || signedBlk.GetMessage().IsNil() ||
+		signedBlk.GetMessage().GetBody().IsNil() ||
+		signedBlk.GetMessage().GetBody().GetExecutionPayloadHeader().IsNil() {
+		return payload, nil, ErrIncompleteBlindedBlock
+	}
+	body := signedBlk.GetMessage().GetBody()
+
+	ctx, cancel := context.WithTimeout(ctx, b.cfg.SubmitBlindedBlockTimeout)
+	defer cancel()
+	if err := b.client.submitBlindedBlock(
+		ctx,
+		version.Name(
+			b.chainSpec.ActiveForkVersionForSlot(
+				signedBlk.GetMessage().GetSlot(),
+			),
+		),
+		signedBlk,
+		&resp,
+	); err != nil {
+		return payload, nil, err
+	}
+	if resp.Data.IsNil() || resp.Data.GetExecutionPayload().IsNil() {
+		return payload, nil, ErrNilPayload
+	}
+
+	payload = resp.Data.GetExecutionPayload()
+	if err := b.verifyPayload(
+		payload, body.GetExecutionPayloadHeader(),
+	); err != nil {
+		return payload, nil, err
+	}
+
+	blobsBundle := resp.Data.GetBlobsBundle()
+	if blobsBundle == nil {
+		blobsBundle = &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{}
+	}
+	if err := verifyBlobsBundle(
+		blobsBundle, body.GetBlobKzgCommitments(),
+	); err != nil {
+		return payload, nil, err
+	}
+	return payload, blobsBundle, nil
+}
+
+// verifyPayload verifies that the revealed payload is the one committed to
+// by the header, both as is and once converted to a header by the state
+// transition.
+func (b *Builder[
+	_, _, _, _, ExecutionPayloadT, _, ExecutionPayloadHeaderT, _, _, _, _,
+]) verifyPayload(
+	payload ExecutionPayloadT,
+	header ExecutionPayloadHeaderT,
+) error {
+	headerRoot := header.HashTreeRoot()
+	if payload.HashTreeRoot() != headerRoot {
+		return ErrPayloadMismatch
+	}
+	payloadHeader, err := payload.ToHeader(
+		b.chainSpec.MaxWithdrawalsPerPayload(),
+		b.chainSpec.DepositEth1ChainID(),
+	)
+	if err != nil {
+		return err
+	}
+	if payloadHeader.HashTreeRoot() != headerRoot {
+		return ErrPayloadMismatch
+	}
+	return nil
+}
+
+// verifyBlobsBundle verifies that the revealed blobs bundle carries one blob
+// and proof for each of the given commitments.
+func verifyBlobsBundle(
+	blobsBundle engineprimitives.BlobsBundle,
+	commitments eip4844.KZGCommitments[common.ExecutionHash],
+) error {
+	if len(blobsBundle.GetCommitments()) != len(commitments) ||
+		len(blobsBundle.GetProofs()) != len(commitments) ||
+		len(blobsBundle.GetBlobs()) != len(commitments) {
+		return ErrBlobsBundleMismatch
+	}
+	for i, commitment := range blobsBundle.GetCommitments() {
+		if commitment != commitments[i] {
+			return ErrBlobsBundleMismatch
+		}
+	}
+	return nil
+}
+
+// registerValidator registers the local validator and its fee recipient
+// with the relay.
+func (b *Builder[
+	_, _, _, _, _, _, _, _, _, _, SignedValidatorRegistrationT,
+]) registerValidator(ctx context.Context) error {
+	var registration SignedValidatorRegistrationT
+
+	pubkey := b.signer.PublicKey()
+	feeRecipient, ok := b.feeRecipients.FeeRecipientByPubkey(pubkey)
+	if !ok {
+		feeRecipient = b.feeRecipients.DefaultFeeRecipient()
+	}
+
+	registration, err := registration.New(
+		b.builderForkData(),
+		b.chainSpec.DomainTypeApplicationMask(),
+		b.signer,
+		feeRecipient,
+		math.U64(b.cfg.GasLimit),
+		//#nosec:G115 // unix time is positive.
+		math.U64(time.Now().Unix()),
+	)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, registrationTimeout)
+	defer cancel()
+	if err = b.client.registerValidators(
+		ctx, []SignedValidatorRegistrationT{registration},
+	); err != nil {
+		return err
+	}
+
+	b.logger.Info(
+		"Registered validator with relay",
+		"pubkey", pubkey.String(), "fee_recipient", feeRecipient,
+	)
+	return nil
+}
+
+// builderForkData returns the fork data of the application builder domain,
+// which is computed over the genesis fork version and an empty genesis
+// validators root.
+func (b *Builder[
+	_, _, _, _, _, _, _, ForkDataT, _, _, _,
+]) builderForkData() ForkDataT {
+	var forkData ForkDataT
+	return forkData.New(
+		version.FromUint32[common.Version](
+			b.chainSpec.ActiveForkVersionForEpoch(0),
+		),
+		common.Root{},
+	)
+}
@@ -0,0 +1,434 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testBuilder = relay.Builder[
+	*types.BeaconBlock,
+	*types.BlindedBeaconBlock,
+	*types.BlindedBeaconBlockBody,
+	*types.BuilderBid,
+	*types.ExecutionPayload,
+	*types.ExecutionPayloadAndBlobsBundle,
+	*types.ExecutionPayloadHeader,
+	*types.ForkData,
+	*types.SignedBlindedBeaconBlock,
+	*types.SignedBuilderBid,
+	*types.SignedValidatorRegistration,
+]
+
+var (
+	proposerPubkey = crypto.BLSPubkey{0x01}
+	builderPubkey  = crypto.BLSPubkey{0x02}
+	feeRecipient   = common.ExecutionAddress{0x03}
+	parentHash     = common.ExecutionHash{0x04}
+)
+
+// stubRelay is a relay serving the builder API from canned responses.
+type stubRelay struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	bid           *types.SignedBuilderBid
+	reveal        *types.ExecutionPayloadAndBlobsBundle
+	delay         time.Duration
+	registrations []*types.SignedValidatorRegistration
+	submitted     *types.SignedBlindedBeaconBlock
+}
+
+func newStubRelay(t *testing.T) *stubRelay {
+	t.Helper()
+	r := &stubRelay{}
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"POST /eth/v1/builder/validators",
+		func(w http.ResponseWriter, req *http.Request) {
+			var registrations []*types.SignedValidatorRegistration
+			if err := json.NewDecoder(req.Body).Decode(
+				&registrations,
+			); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.registrations = append(r.registrations, registrations...)
+		},
+	)
+	mux.HandleFunc(
+		"GET /eth/v1/builder/header/{slot}/{parent_hash}/{pubkey}",
+		func(w http.ResponseWriter, req *http.Request) {
+			time.Sleep(r.delay)
+			if req.PathValue("pubkey") != proposerPubkey.String() ||
+				r.bid == nil {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			r.respond(w, r.bid)
+		},
+	)
+	mux.HandleFunc(
+		"POST /eth/v1/builder/blinded_blocks",
+		func(w http.ResponseWriter, req *http.Request) {
+			time.Sleep(r.delay)
+			blk := new(types.SignedBlindedBeaconBlock)
+			if req.Header.Get("Eth-Consensus-Version") != "deneb" ||
+				json.NewDecoder(req.Body).Decode(blk) != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			r.mu.Lock()
+			r.submitted = blk
+			r.mu.Unlock()
+			r.respond(w, r.reveal)
+		},
+	)
+	r.Server = httptest.NewServer(mux)
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *stubRelay) respond(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	//#nosec:G104 // test server.
+	json.NewEncoder(w).Encode(map[string]any{
+		"version": "deneb",
+		"data":    data,
+	})
+}
+
+type stubFeeRecipients struct{}
+
+func (stubFeeRecipients) DefaultFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func (stubFeeRecipients) FeeRecipientByPubkey(
+	pubkey crypto.BLSPubkey,
+) (common.ExecutionAddress, bool) {
+	return feeRecipient, pubkey == proposerPubkey
+}
+
+func newTestBuilder(t *testing.T, relayURL string) *testBuilder {
+	t.Helper()
+	signer := &mocks.BLSSigner{}
+	signer.On("PublicKey").Return(proposerPubkey)
+	signer.On("Sign", mock.Anything).Return(crypto.BLSSignature{0x05}, nil)
+	signer.On(
+		"VerifySignature", builderPubkey, mock.Anything, mock.Anything,
+	).Return(nil)
+
+	cfg := relay.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URL = relayURL
+	cfg.GetHeaderTimeout = 200 * time.Millisecond
+	cfg.SubmitBlindedBlockTimeout = 200 * time.Millisecond
+	cfg.RegistrationInterval = time.Hour
+
+	b, err := relay.NewBuilder[
+		*types.BeaconBlock,
+		*types.BlindedBeaconBlock,
+		*types.BlindedBeaconBlockBody,
+		*types.BuilderBid,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadAndBlobsBundle,
+		*types.ExecutionPayloadHeader,
+		*types.ForkData,
+		*types.SignedBlindedBeaconBlock,
+		*types.SignedBuilderBid,
+		*types.SignedValidatorRegistration,
+	](
+		&cfg,
+		noop.NewLogger[any](),
+		chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType, math.Epoch, common.ExecutionAddress,
+				math.Slot, any,
+			]{
+				SlotsPerEpoch:             32,
+				DenebPlusForkEpoch:        1 << 62,
+				ElectraForkEpoch:          1 << 62,
+				MaxBlobsPerBlock:          6,
+				MaxWithdrawalsPerPayload:  16,
+				DepositEth1ChainID:        1,
+				DomainTypeApplicationMask: common.DomainType{0, 0, 0, 1},
+			},
+		),
+		signer,
+		stubFeeRecipients{},
+	)
+	require.NoError(t, err)
+	return b
+}
+
+// newPayload returns a payload building on top of parentHash along with its
+// header.
+func newPayload(
+	t *testing.T,
+) (*types.ExecutionPayload, *types.ExecutionPayloadHeader) {
+	t.Helper()
+	payload := &types.ExecutionPayload{
+		ParentHash:    parentHash,
+		Number:        10,
+		Timestamp:     20,
+		ExtraData:     []byte("builder"),
+		BaseFeePerGas: math.NewU256(7),
+		BlockHash:     common.ExecutionHash{0x06},
+		Transactions:  [][]byte{[]byte("tx1")},
+		Withdrawals: []*engineprimitives.Withdrawal{
+			{Index: 0, Amount: 100},
+		},
+	}
+	header, err := payload.ToHeader(16, 1)
+	require.NoError(t, err)
+	return payload, header
+}
+
+func newBid(
+	header *types.ExecutionPayloadHeader,
+	commitments []eip4844.KZGCommitment,
+) *types.SignedBuilderBid {
+	return &types.SignedBuilderBid{
+		Message: &types.BuilderBid{
+			Header:             header,
+			BlobKzgCommitments: commitments,
+			Value:              math.NewU256(1_000),
+			Pubkey:             builderPubkey,
+		},
+		Signature: crypto.BLSSignature{0x07},
+	}
+}
+
+func newBlock(commitments []eip4844.KZGCommitment) *types.BeaconBlock {
+	return &types.BeaconBlock{
+		Slot:          5,
+		ProposerIndex: 1,
+		ParentRoot:    common.Root{0x08},
+		StateRoot:     common.Root{0x09},
+		Body: &types.BeaconBlockBody{
+			Eth1Data:           &types.Eth1Data{},
+			BlobKzgCommitments: commitments,
+		},
+	}
+}
+
+func TestBuilder_GetHeader(t *testing.T) {
+	stub := newStubRelay(t)
+	b := newTestBuilder(t, stub.URL)
+	_, header := newPayload(t)
+	commitments := []eip4844.KZGCommitment{{0x0a}}
+
+	// No bid.
+	_, _, _, err := b.GetHeader(context.Background(), 5, parentHash)
+	require.ErrorIs(t, err, relay.ErrNoBid)
+
+	stub.bid = newBid(header, commitments)
+	gotHeader, gotCommitments, value, err := b.GetHeader(
+		context.Background(), 5, parentHash,
+	)
+	require.NoError(t, err)
+	require.Equal(t, header.HashTreeRoot(), gotHeader.HashTreeRoot())
+	require.Equal(
+		t,
+		eip4844.KZGCommitments[common.ExecutionHash](commitments),
+		gotCommitments,
+	)
+	require.Equal(t, uint64(1_000), value.Uint64())
+
+	// A bid on top of another parent is rejected.
+	_, _, _, err = b.GetHeader(
+		context.Background(), 5, common.ExecutionHash{0xff},
+	)
+	require.ErrorIs(t, err, relay.ErrBidParentHashMismatch)
+
+	// A bid with too many blobs is rejected.
+	stub.bid = newBid(header, make([]eip4844.KZGCommitment, 7))
+	_, _, _, err = b.GetHeader(context.Background(), 5, parentHash)
+	require.ErrorIs(t, err, relay.ErrTooManyBlobs)
+
+	// A bid paying nothing is rejected.
+	stub.bid = newBid(header, nil)
+	stub.bid.Message.Value = math.NewU256(0)
+	_, _, _, err = b.GetHeader(context.Background(), 5, parentHash)
+	require.ErrorIs(t, err, relay.ErrZeroValueBid)
+}
+
+func TestBuilder_GetHeaderPinnedBuilder(t *testing.T) {
+	stub := newStubRelay(t)
+	_, header := newPayload(t)
+	stub.bid = newBid(header, nil)
+
+	pinned := strings.Replace(
+		stub.URL, "http://", "http://"+crypto.BLSPubkey{0xee}.String()+"@", 1,
+	)
+	b := newTestBuilder(t, pinned)
+	_, _, _, err := b.GetHeader(context.Background(), 5, parentHash)
+	require.ErrorIs(t, err, relay.ErrUnexpectedBuilder)
+
+	pinned = strings.Replace(
+		stub.URL, "http://", "http://"+builderPubkey.String()+"@", 1,
+	)
+	b = newTestBuilder(t, pinned)
+	_, _, _, err = b.GetHeader(context.Background(), 5, parentHash)
+	require.NoError(t, err)
+}
+
+func TestBuilder_GetHeaderDeadline(t *testing.T) {
+	stub := newStubRelay(t)
+	b := newTestBuilder(t, stub.URL)
+	_, header := newPayload(t)
+	stub.bid = newBid(header, nil)
+	stub.delay = time.Second
+
+	start := time.Now()
+	_, _, _, err := b.GetHeader(context.Background(), 5, parentHash)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), stub.delay)
+}
+
+func TestBuilder_SubmitBlindedBlock(t *testing.T) {
+	stub := newStubRelay(t)
+	b := newTestBuilder(t, stub.URL)
+	payload, header := newPayload(t)
+	commitments := []eip4844.KZGCommitment{{0x0a}}
+	stub.reveal = &types.ExecutionPayloadAndBlobsBundle{
+		ExecutionPayload: payload,
+		BlobsBundle: &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{
+			Commitments: commitments,
+			Proofs:      []eip4844.KZGProof{{0x0b}},
+			Blobs:       []*eip4844.Blob{{0x0c}},
+		},
+	}
+
+	blk := newBlock(commitments)
+	revealed, blobsBundle, err := b.SubmitBlindedBlock(
+		context.Background(), blk, header, common.Root{0x0d},
+	)
+	require.NoError(t, err)
+	require.Equal(t, payload.HashTreeRoot(), revealed.HashTreeRoot())
+	require.Equal(t, commitments, blobsBundle.GetCommitments())
+
+	// The relay received the block blinded over the bid header, which
+	// commits to the same root as the block carrying the revealed payload.
+	require.NotNil(t, stub.submitted)
+	require.Equal(t, crypto.BLSSignature{0x05}, stub.submitted.Signature)
+	blk.Body.ExecutionPayload = revealed
+	require.Equal(t, blk.HashTreeRoot(), stub.submitted.Message.HashTreeRoot())
+}
+
+func TestBuilder_SubmitBlindedBlockMismatch(t *testing.T) {
+	stub := newStubRelay(t)
+	b := newTestBuilder(t, stub.URL)
+	payload, header := newPayload(t)
+
+	// The relay reveals a payload other than the one of the bid.
+	other := *payload
+	other.Number++
+	stub.reveal = &types.ExecutionPayloadAndBlobsBundle{
+		ExecutionPayload: &other,
+	}
+	_, _, err := b.SubmitBlindedBlock(
+		context.Background(), newBlock(nil), header, common.Root{},
+	)
+	require.ErrorIs(t, err, relay.ErrPayloadMismatch)
+
+	// The relay reveals the payload without the committed blobs.
+	stub.reveal.ExecutionPayload = payload
+	_, _, err = b.SubmitBlindedBlock(
+		context.Background(),
+		newBlock([]eip4844.KZGCommitment{{0x0a}}),
+		header,
+		common.Root{},
+	)
+	require.ErrorIs(t, err, relay.ErrBlobsBundleMismatch)
+
+	// The relay does not reveal the payload in time.
+	stub.delay = time.Second
+	_, _, err = b.SubmitBlindedBlock(
+		context.Background(), newBlock(nil), header, common.Root{},
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestBuilder_RegistersValidator(t *testing.T) {
+	stub := newStubRelay(t)
+	b := newTestBuilder(t, stub.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, b.Start(ctx))
+
+	require.Eventually(t, func() bool {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		return len(stub.registrations) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	registration := stub.registrations[0].GetMessage()
+	require.Equal(t, proposerPubkey, registration.Pubkey)
+	require.Equal(t, feeRecipient, registration.FeeRecipient)
+	require.Equal(t, math.U64(30_000_000), registration.GasLimit)
+}
+
+func TestNewBuilder_InvalidURL(t *testing.T) {
+	cfg := relay.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URL = "http://0xnotapubkey@localhost:18550"
+	_, err := relay.NewBuilder[
+		*types.BeaconBlock,
+		*types.BlindedBeaconBlock,
+		*types.BlindedBeaconBlockBody,
+		*types.BuilderBid,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadAndBlobsBundle,
+		*types.ExecutionPayloadHeader,
+		*types.ForkData,
+		*types.SignedBlindedBeaconBlock,
+		*types.SignedBuilderBid,
+		*types.SignedValidatorRegistration,
+	](&cfg, noop.NewLogger[any](), nil, nil, nil)
+	require.ErrorIs(t, err, relay.ErrInvalidRelayURL)
+}
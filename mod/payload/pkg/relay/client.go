@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// statusPath is the builder API path reporting the relay status.
+	statusPath = "/eth/v1/builder/status"
+	// validatorsPath is the builder API path registering validators.
+	validatorsPath = "/eth/v1/builder/validators"
+	// headerPath is the builder API path serving bids, followed by
+	// {slot}/{parent_hash}/{pubkey}.
+	headerPath = "/eth/v1/builder/header/"
+	// blindedBlocksPath is the builder API path revealing the payloads of
+	// signed blinded blocks.
+	blindedBlocksPath = "/eth/v1/builder/blinded_blocks"
+	// consensusVersionHeader is the header naming the fork of a submitted
+	// blinded block.
+	consensusVersionHeader = "Eth-Consensus-Version"
+	// mimeJSON is the content type of requests and responses.
+	mimeJSON = "application/json"
+)
+
+// versioned is the envelope of the builder API responses.
+type versioned[T any] struct {
+	Version string `json:"version"`
+	Data    T      `json:"data"`
+}
+
+// client speaks the builder API to a relay.
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// newClient creates a new client for the builder API served at url.
+func newClient(url string, httpClient *http.Client) *client {
+	return &client{
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// status checks that the relay is reachable and healthy.
+func (c *client) status(ctx context.Context) error {
+	_, err := c.do(ctx, http.MethodGet, statusPath, nil, nil, nil)
+	return err
+}
+
+// registerValidators submits the signed validator registrations.
+func (c *client) registerValidators(
+	ctx context.Context,
+	registrations any,
+) error {
+	_, err := c.do(
+		ctx, http.MethodPost, validatorsPath, nil, registrations, nil,
+	)
+	return err
+}
+
+// getHeader requests the best bid for the given slot, parent hash and
+// proposer, decoding it into bid. It returns ErrNoBid if the relay has none.
+func (c *client) getHeader(
+	ctx context.Context,
+	slot, parentHash, pubkey string,
+	bid any,
+) error {
+	status, err := c.do(
+		ctx,
+		http.MethodGet,
+		headerPath+slot+"/"+parentHash+"/"+pubkey,
+		nil,
+		nil,
+		bid,
+	)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusNoContent {
+		return ErrNoBid
+	}
+	return nil
+}
+
+// submitBlindedBlock submits the signed blinded block of the given fork,
+// decoding the revealed payload into payload.
+func (c *client) submitBlindedBlock(
+	ctx context.Context,
+	forkName string,
+	blk any,
+	payload any,
+) error {
+	_, err := c.do(
+		ctx,
+		http.MethodPost,
+		blindedBlocksPath,
+		map[string]string{consensusVersionHeader: forkName},
+		blk,
+		payload,
+	)
+	return err
+}
+
+// do issues a request for the given path, encoding in as the JSON body if it
+// is not nil and decoding the JSON response into out if it is not nil. It
+// returns the status of the response, which is either 200 or 204.
+func (c *client) do(
+	ctx context.Context,
+	method string,
+	path string,
+	headers map[string]string,
+	in any,
+	out any,
+) (int, error) {
+	body := io.Reader(http.NoBody)
+	if in != nil {
+		bz, err := json.Marshal(in)
+		if err != nil {
+			return 0, err
+		}
+		body = bytes.NewReader(bz)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, body)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", mimeJSON)
+	if in != nil {
+		req.Header.Set("Content-Type", mimeJSON)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if out == nil {
+			return resp.StatusCode, nil
+		}
+		return resp.StatusCode, json.NewDecoder(resp.Body).Decode(out)
+	case http.StatusNoContent:
+		return resp.StatusCode, nil
+	default:
+		return resp.StatusCode, errors.Wrapf(
+			ErrUnexpectedStatus, "%s %s: %s", method, path, resp.Status,
+		)
+	}
+}
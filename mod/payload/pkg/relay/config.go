@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultGetHeaderTimeout is the default deadline for a relay to answer
+	// a header request before the local payload is used.
+	defaultGetHeaderTimeout = 950 * time.Millisecond
+	// defaultSubmitBlindedBlockTimeout is the default deadline for a relay
+	// to reveal the payload of a signed blinded block before the local
+	// payload is used.
+	defaultSubmitBlindedBlockTimeout = 2 * time.Second
+	// defaultGasLimit is the default gas limit registered with the relay.
+	defaultGasLimit = 30_000_000
+	// defaultRegistrationInterval is the default interval at which the
+	// validator registration is resubmitted to the relay.
+	defaultRegistrationInterval = 5 * time.Minute
+)
+
+// Config is the configuration for the external block builder relay.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// Enabled determines if payloads are requested from the relay.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the builder API endpoint of the relay. The relay's public key
+	// may be given as the user of the URL, i.e. https://0xpubkey@host, in
+	// which case bids signed by any other builder are rejected.
+	URL string `mapstructure:"url"`
+	// GetHeaderTimeout is the deadline for the relay to answer a header
+	// request. Past it, the block is built with the local payload.
+	GetHeaderTimeout time.Duration `mapstructure:"get-header-timeout"`
+	// SubmitBlindedBlockTimeout is the deadline for the relay to reveal the
+	// payload of a signed blinded block. Past it, the block is built with the
+	// local payload.
+	SubmitBlindedBlockTimeout time.Duration `mapstructure:"submit-blinded-block-timeout"`
+	// GasLimit is the gas limit builders are asked to target.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+	// RegistrationInterval is the interval at which the validator
+	// registration is resubmitted to the relay.
+	RegistrationInterval time.Duration `mapstructure:"registration-interval"`
+}
+
+// DefaultConfig returns the default relay configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:                   false,
+		URL:                       "",
+		GetHeaderTimeout:          defaultGetHeaderTimeout,
+		SubmitBlindedBlockTimeout: defaultSubmitBlindedBlockTimeout,
+		GasLimit:                  defaultGasLimit,
+		RegistrationInterval:      defaultRegistrationInterval,
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUnexpectedStatus is returned when the relay responds with an
+	// unexpected status code.
+	ErrUnexpectedStatus = errors.New("unexpected relay response status")
+	// ErrInvalidRelayURL is returned when the configured relay URL cannot be
+	// parsed.
+	ErrInvalidRelayURL = errors.New("invalid relay url")
+	// ErrNoBid is returned when the relay has no bid for the requested slot.
+	ErrNoBid = errors.New("relay has no bid")
+	// ErrUnexpectedBuilder is returned when a bid is not signed by the
+	// builder pinned in the relay URL.
+	ErrUnexpectedBuilder = errors.New("bid from unexpected builder")
+	// ErrBidParentHashMismatch is returned when a bid does not build on top
+	// of the requested parent.
+	ErrBidParentHashMismatch = errors.New("bid parent hash mismatch")
+	// ErrZeroValueBid is returned when a bid pays nothing to the proposer.
+	ErrZeroValueBid = errors.New("bid has zero value")
+	// ErrTooManyBlobs is returned when a bid commits to more blobs than a
+	// block may carry.
+	ErrTooManyBlobs = errors.New("bid commits to too many blobs")
+	// ErrIncompleteBlindedBlock is returned when a signed blinded block is
+	// missing its message, body or execution payload header.
+	ErrIncompleteBlindedBlock = errors.New("incomplete signed blinded block")
+	// ErrNilPayload is returned when the relay reveals no payload.
+	ErrNilPayload = errors.New("relay revealed a nil payload")
+	// ErrPayloadMismatch is returned when the payload revealed by the relay
+	// does not match the header of the blinded block.
+	ErrPayloadMismatch = errors.New("revealed payload does not match header")
+	// ErrBlobsBundleMismatch is returned when the blobs revealed by the relay
+	// do not match the commitments of the blinded block.
+	ErrBlobsBundleMismatch = errors.New(
+		"revealed blobs do not match commitments",
+	)
+)
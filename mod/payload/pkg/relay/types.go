@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface for a beacon block that can be blinded.
+type BeaconBlock[BlindedBeaconBlockT, ExecutionPayloadHeaderT any] interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// Blind returns the blinded counterpart of the block, committing to the
+	// given execution payload header.
+	Blind(ExecutionPayloadHeaderT) BlindedBeaconBlockT
+}
+
+// BlindedBeaconBlock is the interface for a blinded beacon block.
+type BlindedBeaconBlock[BlindedBeaconBlockBodyT any] interface {
+	constraints.Nillable
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetBody returns the blinded body of the block.
+	GetBody() BlindedBeaconBlockBodyT
+	// HashTreeRoot returns the hash tree root of the block.
+	HashTreeRoot() common.Root
+}
+
+// BlindedBeaconBlockBody is the interface for a blinded beacon block body.
+type BlindedBeaconBlockBody[ExecutionPayloadHeaderT any] interface {
+	constraints.Nillable
+	// GetExecutionPayloadHeader returns the execution payload header.
+	GetExecutionPayloadHeader() ExecutionPayloadHeaderT
+	// GetBlobKzgCommitments returns the KZG commitments of the blobs.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+}
+
+// BuilderBid is the interface for the bid of an external builder.
+type BuilderBid[ExecutionPayloadHeaderT any] interface {
+	// GetHeader returns the header of the offered execution payload.
+	GetHeader() ExecutionPayloadHeaderT
+	// GetBlobKzgCommitments returns the KZG commitments of the blobs.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	// GetValue returns the value of the bid in wei.
+	GetValue() *math.U256
+	// GetPubkey returns the public key of the builder.
+	GetPubkey() crypto.BLSPubkey
+}
+
+// ExecutionPayload is the interface for the execution payload.
+type ExecutionPayload[ExecutionPayloadHeaderT any] interface {
+	constraints.Nillable
+	// HashTreeRoot returns the hash tree root of the payload.
+	HashTreeRoot() common.Root
+	// ToHeader converts the payload to its header.
+	ToHeader(
+		maxWithdrawalsPerPayload uint64,
+		eth1ChainID uint64,
+	) (ExecutionPayloadHeaderT, error)
+}
+
+// ExecutionPayloadAndBlobsBundle is the interface for the payload revealed by
+// a relay for a signed blinded block.
+type ExecutionPayloadAndBlobsBundle[ExecutionPayloadT any] interface {
+	constraints.Nillable
+	// GetExecutionPayload returns the revealed execution payload.
+	GetExecutionPayload() ExecutionPayloadT
+	// GetBlobsBundle returns the blobs bundle of the revealed payload.
+	GetBlobsBundle() engineprimitives.BlobsBundle
+}
+
+// ExecutionPayloadHeader is the interface for the execution payload header.
+type ExecutionPayloadHeader interface {
+	constraints.Nillable
+	// HashTreeRoot returns the hash tree root of the header.
+	HashTreeRoot() common.Root
+	// GetParentHash returns the parent hash.
+	GetParentHash() common.ExecutionHash
+}
+
+// FeeRecipients resolves the fee recipient registered for a validator.
+type FeeRecipients interface {
+	// DefaultFeeRecipient returns the fee recipient used for validators
+	// without a mapping.
+	DefaultFeeRecipient() common.ExecutionAddress
+	// FeeRecipientByPubkey returns the fee recipient mapped to the given
+	// validator pubkey.
+	FeeRecipientByPubkey(crypto.BLSPubkey) (common.ExecutionAddress, bool)
+}
+
+// ForkData is the interface for the fork data.
+type ForkData[ForkDataT any] interface {
+	// New creates a new fork data object.
+	New(common.Version, common.Root) ForkDataT
+}
+
+// SignedBlindedBeaconBlock is the interface for a signed blinded block.
+type SignedBlindedBeaconBlock[T, BlindedBeaconBlockT, ForkDataT any] interface {
+	constraints.Nillable
+	// New signs the given blinded block under the given domain.
+	New(
+		blk BlindedBeaconBlockT,
+		forkData ForkDataT,
+		domainType common.DomainType,
+		signer crypto.BLSSigner,
+	) (T, error)
+	// GetMessage returns the blinded block.
+	GetMessage() BlindedBeaconBlockT
+}
+
+// SignedBuilderBid is the interface for a bid signed by its builder.
+type SignedBuilderBid[BuilderBidT, ForkDataT any] interface {
+	constraints.Nillable
+	// GetMessage returns the bid.
+	GetMessage() BuilderBidT
+	// Verify verifies that the bid was signed by the builder it names.
+	Verify(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte,
+			signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
+// SignedValidatorRegistration is the interface for a validator registration
+// signed by the registering validator.
+type SignedValidatorRegistration[T, ForkDataT any] interface {
+	// New creates and signs a registration for the signer's public key.
+	New(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		signer crypto.BLSSigner,
+		feeRecipient common.ExecutionAddress,
+		gasLimit math.U64,
+		timestamp math.U64,
+	) (T, error)
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package common
+
+import (
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// HistoricalSummarySize is the size of the HistoricalSummary object in bytes.
+const HistoricalSummarySize = 2 * RootSize
+
+var _ ssz.StaticObject = (*HistoricalSummary)(nil)
+
+// HistoricalSummary as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#historicalsummary
+//
+//nolint:lll
+type HistoricalSummary struct {
+	// BlockSummaryRoot is the root of the block roots of a period of
+	// SlotsPerHistoricalRoot slots.
+	BlockSummaryRoot Root `json:"block_summary_root"`
+	// StateSummaryRoot is the root of the state roots of a period of
+	// SlotsPerHistoricalRoot slots.
+	StateSummaryRoot Root `json:"state_summary_root"`
+}
+
+// Empty creates an empty HistoricalSummary.
+func (*HistoricalSummary) Empty() *HistoricalSummary {
+	return &HistoricalSummary{}
+}
+
+// New creates a new HistoricalSummary.
+func (*HistoricalSummary) New(
+	blockSummaryRoot Root,
+	stateSummaryRoot Root,
+) *HistoricalSummary {
+	return &HistoricalSummary{
+		BlockSummaryRoot: blockSummaryRoot,
+		StateSummaryRoot: stateSummaryRoot,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the SSZ encoded size of the HistoricalSummary in bytes.
+func (*HistoricalSummary) SizeSSZ() uint32 {
+	return HistoricalSummarySize
+}
+
+// DefineSSZ defines the SSZ encoding for the HistoricalSummary.
+func (h *HistoricalSummary) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &h.BlockSummaryRoot)
+	ssz.DefineStaticBytes(codec, &h.StateSummaryRoot)
+}
+
+// MarshalSSZ marshals the HistoricalSummary to SSZ format.
+func (h *HistoricalSummary) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, h.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, h)
+}
+
+// UnmarshalSSZ unmarshals the HistoricalSummary from SSZ format.
+func (h *HistoricalSummary) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, h)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the HistoricalSummary.
+func (h *HistoricalSummary) HashTreeRoot() Root {
+	return ssz.HashSequential(h)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo ssz marshals the HistoricalSummary to a target array.
+func (h *HistoricalSummary) MarshalSSZTo(buf []byte) ([]byte, error) {
+	bz, err := h.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the HistoricalSummary with a hasher.
+func (h *HistoricalSummary) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'BlockSummaryRoot'
+	hh.PutBytes(h.BlockSummaryRoot[:])
+
+	// Field (1) 'StateSummaryRoot'
+	hh.PutBytes(h.StateSummaryRoot[:])
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the HistoricalSummary.
+func (h *HistoricalSummary) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(h)
+}
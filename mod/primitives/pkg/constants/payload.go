@@ -34,6 +34,14 @@ const (
 	// MaxDepositsPerBlock is the maximum number of deposits per block.
 	MaxDepositsPerBlock uint64 = 16
 
+	// MaxAttestationsPerBlock is the maximum number of attestations per block,
+	// i.e. of validators voting in the last commit.
+	MaxAttestationsPerBlock uint64 = 8192
+
+	// MaxSlashingInfoPerBlock is the maximum number of slashing info per
+	// block.
+	MaxSlashingInfoPerBlock uint64 = 1024
+
 	// MaxWithdrawalsPerPayload is the maximum number of withdrawals in a
 	// execution payload.
 	MaxWithdrawalsPerPayload uint64 = 16
@@ -20,7 +20,10 @@
 
 package crypto
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
 
 const (
 	// CometBLSType is the BLS curve type used in the Comet BFT consensus
@@ -58,3 +61,27 @@ type BLSSigner interface {
 	// VerifySignature verifies a signature against a message and a public key.
 	VerifySignature(pubKey BLSPubkey, msg []byte, signature BLSSignature) error
 }
+
+// BLSSlotSigner is a BLSSigner that ties each signature to a domain type and
+// slot, so that it can refuse to sign conflicting messages for them.
+type BLSSlotSigner interface {
+	BLSSigner
+
+	// SignAt signs the message for the domain type at the given slot,
+	// refusing it if it conflicts with the messages signed before.
+	SignAt(domainType bytes.B4, slot math.Slot, msg []byte) (BLSSignature, error)
+}
+
+// SignAt signs the message for the domain type and slot, going through the
+// signer's protection when it is a BLSSlotSigner.
+func SignAt(
+	signer BLSSigner,
+	domainType bytes.B4,
+	slot math.Slot,
+	msg []byte,
+) (BLSSignature, error) {
+	if slotSigner, ok := signer.(BLSSlotSigner); ok {
+		return slotSigner.SignAt(domainType, slot, msg)
+	}
+	return signer.Sign(msg)
+}
@@ -35,6 +35,9 @@ type Context struct {
 	SkipPayloadVerification bool
 	// SkipValidateRandao indicates whether to skip validating the Randao mix.
 	SkipValidateRandao bool
+	// SkipValidateProposerSignature indicates whether to skip validating the
+	// proposer's signature over the block.
+	SkipValidateProposerSignature bool
 	// SkipValidateResult indicates whether to validate the result of
 	// the state transition.
 	SkipValidateResult bool
@@ -59,6 +62,12 @@ func (c *Context) GetSkipValidateRandao() bool {
 	return c.SkipValidateRandao
 }
 
+// GetSkipValidateProposerSignature returns whether to skip validating the
+// proposer's signature over the block.
+func (c *Context) GetSkipValidateProposerSignature() bool {
+	return c.SkipValidateProposerSignature
+}
+
 // GetSkipValidateResult returns whether to validate the result of the state
 // transition.
 func (c *Context) GetSkipValidateResult() bool {
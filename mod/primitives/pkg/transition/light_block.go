@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+
+// LightBlock is a CometBFT header together with the commit that signed it
+// and the validator set the commit was signed by. The fields are JSON encoded
+// the way the CometBFT RPC encodes them, so that CometBFT light clients can
+// consume them as is.
+type LightBlock struct {
+	// SignedHeader is the CometBFT header and its commit.
+	SignedHeader json.RawMessage
+	// ValidatorSet is the validator set that signed the commit.
+	ValidatorSet json.RawMessage
+}
+
+// StoreProof is a key/value pair of the beacon store with its ICS-23 proof
+// against the app hash of a height.
+type StoreProof struct {
+	// Height is the height whose app hash the proof is against.
+	Height int64
+	// Key is the key in the beacon store.
+	Key []byte
+	// Value is the value stored under the key.
+	Value []byte
+	// ProofOps are the ICS-23 proof operations, from the beacon store up to
+	// the app hash.
+	ProofOps []*ProofOp
+}
+
+// ProofOp is a single ICS-23 proof operation.
+type ProofOp struct {
+	// Type is the type of the proof operation.
+	Type string
+	// Key is the key the operation proves.
+	Key []byte
+	// Data is the encoded proof.
+	Data []byte
+}
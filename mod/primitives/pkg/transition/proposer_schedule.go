@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+
+// ProposerCandidate is a member of the CometBFT validator set as seen by the
+// proposer selection.
+type ProposerCandidate struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey
+	// VotingPower is the voting power of the validator, which equals its
+	// effective balance.
+	VotingPower int64
+	// ProposerPriority is the accumulated proposer priority of the validator.
+	ProposerPriority int64
+}
+
+// ProposerSchedule predicts block proposers by replaying CometBFT's weighted
+// round-robin proposer selection over a validator set. It assumes every block
+// is decided in round 0, so its predictions are best-effort.
+type ProposerSchedule interface {
+	// Next advances the schedule by one height and returns the proposer of
+	// that height.
+	Next() (crypto.BLSPubkey, error)
+	// Update applies the given validator updates to the validator set the
+	// way CometBFT applies the updates returned by the application.
+	Update(updates ValidatorUpdates) error
+}
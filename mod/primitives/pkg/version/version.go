@@ -45,3 +45,25 @@ func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 func ToUint32[VersionT ~[4]byte](version VersionT) uint32 {
 	return binary.LittleEndian.Uint32(version[:])
 }
+
+// names maps each fork version to its name as used by the beacon API.
+//
+//nolint:gochecknoglobals // static lookup table.
+var names = map[uint32]string{
+	Phase0:    "phase0",
+	Altair:    "altair",
+	Bellatrix: "bellatrix",
+	Capella:   "capella",
+	Deneb:     "deneb",
+	DenebPlus: "deneb",
+	Electra:   "electra",
+}
+
+// Name returns the lowercase fork name of the given version, e.g. "deneb".
+// Unknown versions are returned as "unknown".
+func Name(version uint32) string {
+	if name, ok := names[version]; ok {
+		return name
+	}
+	return "unknown"
+}
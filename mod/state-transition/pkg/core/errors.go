@@ -63,6 +63,10 @@ var (
 	ErrSlashedProposer = errors.New(
 		"attempted to process a block with a slashed proposer")
 
+	// ErrInvalidProposerSignature is returned when the signature over a block
+	// does not verify against the public key of its proposer.
+	ErrInvalidProposerSignature = errors.New("invalid proposer signature")
+
 	// ErrStateRootMismatch is returned when the state root in a block header
 	// does not match the expected value.
 	ErrStateRootMismatch = errors.New("state root mismatch")
@@ -66,6 +66,7 @@ type ReadOnlyBeaconState[
 	ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT]
 	ReadOnlyRandaoMixes
 	ReadOnlyStateRoots
+	ReadOnlyHistoricalSummaries
 	ReadOnlyValidators[ValidatorT]
 	ReadOnlyWithdrawals[WithdrawalT]
 
@@ -96,6 +97,7 @@ type WriteOnlyBeaconState[
 	WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT]
 	WriteOnlyRandaoMixes
 	WriteOnlyStateRoots
+	WriteOnlyHistoricalSummaries
 	WriteOnlyValidators[ValidatorT]
 
 	SetGenesisValidatorsRoot(root common.Root) error
@@ -123,6 +125,20 @@ type ReadOnlyStateRoots interface {
 	StateRootAtIndex(uint64) (common.Root, error)
 }
 
+// WriteOnlyHistoricalSummaries defines a struct which only has write access
+// to historical summaries methods.
+type WriteOnlyHistoricalSummaries interface {
+	AddHistoricalSummary(*common.HistoricalSummary, math.Slot) error
+}
+
+// ReadOnlyHistoricalSummaries defines a struct which only has read access to
+// historical summaries methods.
+type ReadOnlyHistoricalSummaries interface {
+	GetHistoricalSummaries() ([]*common.HistoricalSummary, error)
+	GetHistoricalSummaryAtIndex(uint64) (*common.HistoricalSummary, error)
+	GetHistoricalSummariesStartSlot() (math.Slot, error)
+}
+
 // WriteOnlyRandaoMixes defines a struct which only has write access to randao
 // mixes methods.
 type WriteOnlyRandaoMixes interface {
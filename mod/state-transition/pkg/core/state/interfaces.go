@@ -44,6 +44,12 @@ type KVStore[
 	WithContext(ctx context.Context) T
 	// Copy returns a copy of the key-value store.
 	Copy() T
+	// StateHashTreeRoot returns the hash tree root of the beacon state held
+	// in the key-value store, laid out for the given fork version.
+	StateHashTreeRoot(
+		forkVersion uint32,
+		slotsPerHistoricalRoot, epochsPerHistoricalVector uint64,
+	) (common.Root, error)
 	// GetLatestExecutionPayloadHeader retrieves the latest execution payload
 	// header.
 	GetLatestExecutionPayloadHeader() (
@@ -83,6 +89,21 @@ type KVStore[
 	GetBlockRootAtIndex(index uint64) (common.Root, error)
 	// StateRootAtIndex retrieves the state root at the given index.
 	StateRootAtIndex(index uint64) (common.Root, error)
+	// GetHistoricalSummaries retrieves all historical summaries.
+	GetHistoricalSummaries() ([]*common.HistoricalSummary, error)
+	// GetHistoricalSummaryAtIndex retrieves the historical summary at the
+	// given index.
+	GetHistoricalSummaryAtIndex(
+		index uint64,
+	) (*common.HistoricalSummary, error)
+	// GetHistoricalSummariesStartSlot retrieves the first slot of the
+	// period of the first historical summary.
+	GetHistoricalSummariesStartSlot() (math.Slot, error)
+	// AddHistoricalSummary appends a historical summary of the period
+	// starting at the given slot.
+	AddHistoricalSummary(
+		summary *common.HistoricalSummary, periodStart math.Slot,
+	) error
 	// GetEth1Data retrieves the eth1 data.
 	GetEth1Data() (Eth1DataT, error)
 	// SetEth1Data sets the eth1 data.
@@ -378,6 +378,11 @@ func (s *StateDB[
 		return empty, err
 	}
 
+	historicalSummaries, err := s.GetHistoricalSummaries()
+	if err != nil {
+		return empty, err
+	}
+
 	// TODO: Properly move BeaconState into full generics.
 	return (*new(BeaconStateMarshallableT)).New(
 		s.cs.ActiveForkVersionForSlot(slot),
@@ -397,16 +402,26 @@ func (s *StateDB[
 		nextWithdrawalValidatorIndex,
 		slashings,
 		totalSlashings,
+		historicalSummaries,
 	)
 }
 
-// HashTreeRoot is the interface for the beacon store.
+// HashTreeRoot returns the hash tree root of the beacon state, laid out for
+// the fork of its slot, re-hashing only the entries of its lists modified
+// since it was last computed.
 func (s *StateDB[
 	_, _, _, _, _, _, _, _, _, _,
 ]) HashTreeRoot() common.Root {
-	st, err := s.GetMarshallable()
+	slot, err := s.GetSlot()
+	if err != nil {
+		panic(err)
+	}
+	root, err := s.StateHashTreeRoot(
+		s.cs.ActiveForkVersionForSlot(slot),
+		s.cs.SlotsPerHistoricalRoot(), s.cs.EpochsPerHistoricalVector(),
+	)
 	if err != nil {
 		panic(err)
 	}
-	return st.HashTreeRoot()
+	return root
 }
@@ -22,13 +22,16 @@ package core
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/spans"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // StateProcessor is a basic Processor, which takes care of the
@@ -168,24 +171,53 @@ func (sp *StateProcessor[
 		return nil, nil
 	}
 
+	spanCtx, span := startSpan(ctx, "Transition", blk.GetSlot())
+	setBlockRoot(span, blk.HashTreeRoot)
+	validatorUpdates, err := sp.transition(spanCtx, ctx, st, blk)
+	spans.End(span, err)
+	return validatorUpdates, err
+}
+
+// transition processes the slots up to the slot of the block and then the
+// block itself. Spans of the sub-steps are children of spanCtx.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, ContextT,
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) transition(
+	spanCtx context.Context,
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) (transition.ValidatorUpdates, error) {
 	// Process the slots.
-	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
+	validatorUpdates, err := sp.processSlots(spanCtx, st, blk.GetSlot())
 	if err != nil {
 		return nil, err
 	}
 
 	// Process the block.
-	if err = sp.ProcessBlock(ctx, st, blk); err != nil {
+	if err = sp.processBlock(spanCtx, ctx, st, blk); err != nil {
 		return nil, err
 	}
 
 	return validatorUpdates, nil
 }
 
+// ProcessSlots processes the slots of the state up to the given slot.
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) ProcessSlots(
 	st BeaconStateT, slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	return sp.processSlots(context.Background(), st, slot)
+}
+
+// processSlots processes the slots of the state up to the given slot,
+// tracing the epoch processing under spanCtx.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processSlots(
+	spanCtx context.Context, st BeaconStateT, slot math.Slot,
 ) (transition.ValidatorUpdates, error) {
 	var (
 		validatorUpdates      transition.ValidatorUpdates
@@ -208,7 +240,7 @@ func (sp *StateProcessor[
 		boundary := (stateSlot.Unwrap()+1)%sp.cs.SlotsPerEpoch() == 0
 		if boundary {
 			if epochValidatorUpdates, err =
-				sp.processEpoch(st); err != nil {
+				sp.processEpoch(spanCtx, st, stateSlot); err != nil {
 				return nil, err
 			}
 			validatorUpdates = append(
@@ -263,10 +295,14 @@ func (sp *StateProcessor[
 	}
 
 	// We update the block root.
-	return st.UpdateBlockRootAtIndex(
+	if err = st.UpdateBlockRootAtIndex(
 		stateSlot.Unwrap()%sp.cs.SlotsPerHistoricalRoot(),
 		latestHeader.HashTreeRoot(),
-	)
+	); err != nil {
+		return err
+	}
+
+	return sp.processHistoricalSummariesUpdate(st, stateSlot)
 }
 
 // ProcessBlock processes the block, it optionally verifies the
@@ -277,9 +313,23 @@ func (sp *StateProcessor[
 	ctx ContextT,
 	st BeaconStateT,
 	blk BeaconBlockT,
+) error {
+	return sp.processBlock(ctx, ctx, st, blk)
+}
+
+// processBlock processes the block, tracing its sub-steps under spanCtx.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processBlock(
+	spanCtx context.Context,
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
 ) error {
 	// process the freshly created header.
-	if err := sp.processBlockHeader(st, blk); err != nil {
+	if err := sp.processBlockHeader(
+		st, blk, ctx.GetSkipValidateProposerSignature(),
+	); err != nil {
 		return err
 	}
 
@@ -292,7 +342,7 @@ func (sp *StateProcessor[
 
 	// process the withdrawals.
 	if err := sp.processWithdrawals(
-		st, blk.GetBody(),
+		spanCtx, st, blk,
 	); err != nil {
 		return err
 	}
@@ -305,7 +355,7 @@ func (sp *StateProcessor[
 	}
 
 	// process the deposits and ensure they match the local state.
-	if err := sp.processOperations(st, blk); err != nil {
+	if err := sp.processOperations(spanCtx, st, blk); err != nil {
 		return err
 	}
 
@@ -332,9 +382,14 @@ func (sp *StateProcessor[
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processEpoch(
+	ctx context.Context,
 	st BeaconStateT,
-) (transition.ValidatorUpdates, error) {
-	if err := sp.processRewardsAndPenalties(st); err != nil {
+	slot math.Slot,
+) (validatorUpdates transition.ValidatorUpdates, err error) {
+	_, span := startSpan(ctx, "processEpoch", slot)
+	defer func() { spans.End(span, err) }()
+
+	if err = sp.processRewardsAndPenalties(st); err != nil {
 		return nil, err
 	} else if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
@@ -345,13 +400,16 @@ func (sp *StateProcessor[
 }
 
 // processBlockHeader processes the header and ensures it matches the local
-// state.
+// state, verifying the proposer's signature over the block unless
+// skipSignatureVerification is set. Blocks only carry a proposer signature
+// from DenebPlus on, earlier blocks are never verified.
 func (sp *StateProcessor[
 	BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT,
 	_, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
 ]) processBlockHeader(
 	st BeaconStateT,
 	blk BeaconBlockT,
+	skipSignatureVerification bool,
 ) error {
 	var (
 		slot              math.Slot
@@ -425,6 +483,46 @@ func (sp *StateProcessor[
 			ErrSlashedProposer, "index: %d", blk.GetProposerIndex(),
 		)
 	}
+
+	if skipSignatureVerification ||
+		sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.DenebPlus {
+		return nil
+	}
+	return sp.verifyProposerSignature(st, blk, proposer.GetPubkey())
+}
+
+// verifyProposerSignature verifies the proposer's signature over the block
+// under the proposer domain.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT,
+	_, _, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+]) verifyProposerSignature(
+	st BeaconStateT,
+	blk BeaconBlockT,
+	pubkey crypto.BLSPubkey,
+) error {
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	var fd ForkDataT
+	fd = fd.New(
+		version.FromUint32[common.Version](
+			sp.cs.ActiveForkVersionForSlot(blk.GetSlot()),
+		), genesisValidatorsRoot,
+	)
+	signingRoot := fd.ComputeBlockSigningRoot(
+		sp.cs.DomainTypeProposer(), blk.HashTreeRoot(),
+	)
+	if err = sp.signer.VerifySignature(
+		pubkey, signingRoot[:], blk.GetSignature(),
+	); err != nil {
+		return errors.Wrapf(
+			ErrInvalidProposerSignature, "slot %d, proposer %d: %v",
+			blk.GetSlot(), blk.GetProposerIndex(), err,
+		)
+	}
 	return nil
 }
 
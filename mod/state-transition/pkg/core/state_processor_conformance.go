@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package core
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// ErrUnknownEpochStep is returned when running an epoch processing step that
+// does not exist.
+var ErrUnknownEpochStep = errors.New("unknown epoch processing step")
+
+// EpochStep is a step of the epoch processing, named after its handler in the
+// Ethereum consensus-spec tests.
+type EpochStep string
+
+const (
+	// EpochStepRewardsAndPenalties applies the attestation rewards and
+	// penalties.
+	EpochStepRewardsAndPenalties EpochStep = "rewards_and_penalties"
+	// EpochStepSlashings applies the correlation penalty to the slashed
+	// validators.
+	EpochStepSlashings EpochStep = "slashings"
+	// EpochStepSlashingsReset clears the slashings of the next epoch.
+	EpochStepSlashingsReset EpochStep = "slashings_reset"
+	// EpochStepRandaoMixesReset carries the randao mix over to the next
+	// epoch.
+	EpochStepRandaoMixesReset EpochStep = "randao_mixes_reset"
+	// EpochStepHistoricalSummariesUpdate appends a historical summary at the
+	// end of a historical roots period.
+	EpochStepHistoricalSummariesUpdate EpochStep = "historical_summaries_update"
+)
+
+// ProcessEpochStep runs a single step of the epoch processing on a state
+// sitting at the last slot of an epoch. The steps are otherwise only run
+// together at the epoch boundary, this lets the consensus-spec conformance
+// tests exercise them in isolation.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessEpochStep(st BeaconStateT, step EpochStep) error {
+	switch step {
+	case EpochStepRewardsAndPenalties:
+		return sp.processRewardsAndPenalties(st)
+	case EpochStepSlashings:
+		return sp.processSlashings(st)
+	case EpochStepSlashingsReset:
+		return sp.processSlashingsReset(st)
+	case EpochStepRandaoMixesReset:
+		return sp.processRandaoMixesReset(st)
+	case EpochStepHistoricalSummariesUpdate:
+		slot, err := st.GetSlot()
+		if err != nil {
+			return err
+		}
+		return sp.processHistoricalSummariesUpdate(st, slot)
+	default:
+		return errors.Wrapf(ErrUnknownEpochStep, "%q", step)
+	}
+}
+
+// ProcessDeposit processes a single deposit outside of a block.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessDeposit(st BeaconStateT, dep DepositT) error {
+	return sp.processDeposit(st, dep)
+}
+
+// ProcessWithdrawals processes the withdrawals of the execution payload of
+// the block, without processing the rest of the block.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessWithdrawals(
+	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
+) error {
+	return sp.processWithdrawals(ctx, st, blk)
+}
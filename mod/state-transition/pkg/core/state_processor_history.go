@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processHistoricalSummariesUpdate as defined in the Ethereum 2.0
+// specification, adapted to run at the end of every historical roots
+// period rather than at an epoch boundary, since the period may be shorter
+// than an epoch. The historical summaries are only part of the state from
+// DenebPlus on.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#historical-summaries-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processHistoricalSummariesUpdate(
+	st BeaconStateT,
+	stateSlot math.Slot,
+) error {
+	if sp.cs.ActiveForkVersionForSlot(stateSlot) < version.DenebPlus {
+		return nil
+	}
+	period := sp.cs.SlotsPerHistoricalRoot()
+	if (stateSlot.Unwrap()+1)%period != 0 {
+		return nil
+	}
+
+	blockRoots := make([]common.Root, period)
+	stateRoots := make([]common.Root, period)
+	for i := range period {
+		blockRoot, err := st.GetBlockRootAtIndex(i)
+		if err != nil {
+			return err
+		}
+		stateRoot, err := st.StateRootAtIndex(i)
+		if err != nil {
+			return err
+		}
+		blockRoots[i], stateRoots[i] = blockRoot, stateRoot
+	}
+
+	blockTree, err := merkle.NewTreeFromLeaves(blockRoots)
+	if err != nil {
+		return err
+	}
+	stateTree, err := merkle.NewTreeFromLeaves(stateRoots)
+	if err != nil {
+		return err
+	}
+
+	return st.AddHistoricalSummary(
+		(&common.HistoricalSummary{}).New(
+			blockTree.Root(), stateTree.Root(),
+		),
+		stateSlot+1-math.Slot(period),
+	)
+}
@@ -21,7 +21,10 @@
 package core
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/spans"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -33,9 +36,13 @@ import (
 func (sp *StateProcessor[
 	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processOperations(
+	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) error {
+) (err error) {
+	_, span := startSpan(ctx, "processOperations", blk.GetSlot())
+	defer func() { spans.End(span, err) }()
+
 	// Verify that outstanding deposits are processed up to the maximum number
 	// of deposits.
 	deposits := blk.GetBody().GetDeposits()
@@ -214,15 +221,19 @@ func (sp *StateProcessor[
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processWithdrawals(
+	ctx context.Context,
 	st BeaconStateT,
-	body BeaconBlockBodyT,
-) error {
+	blk BeaconBlockT,
+) (err error) {
+	_, span := startSpan(ctx, "processWithdrawals", blk.GetSlot())
+	defer func() { spans.End(span, err) }()
+
 	// Dequeue and verify the logs.
 	var (
 		nextValidatorIndex math.ValidatorIndex
-		payload            = body.GetExecutionPayload()
+		payload            = blk.GetBody().GetExecutionPayload()
 		payloadWithdrawals = payload.GetWithdrawals()
 	)
 
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer records the spans of the state transition. It resolves to a no-op
+// tracer unless a tracer provider is installed.
+//
+//nolint:gochecknoglobals // tracers are looked up once per package.
+var tracer = otel.Tracer(
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core",
+)
+
+// startSpan starts a span of the state transition for the given slot.
+func startSpan(
+	ctx context.Context,
+	name string,
+	slot math.Slot,
+) (context.Context, trace.Span) {
+	return tracer.Start(
+		ctx, name, trace.WithAttributes(
+			//#nosec:G115 // slot will never overflow an int64.
+			attribute.Int64("slot", int64(slot.Unwrap())),
+		),
+	)
+}
+
+// setBlockRoot annotates the span with the root of the block being
+// processed. The root is only computed if the span is sampled.
+func setBlockRoot(span trace.Span, rootFn func() common.Root) {
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String("block_root", rootFn().String()))
+	}
+}
@@ -57,6 +57,10 @@ type BeaconBlock[
 	GetParentBlockRoot() common.Root
 	// GetStateRoot returns the state root of the block.
 	GetStateRoot() common.Root
+	// HashTreeRoot returns the hash tree root of the block.
+	HashTreeRoot() common.Root
+	// GetSignature returns the proposer's signature over the block.
+	GetSignature() crypto.BLSSignature
 }
 
 // BeaconBlockBody represents a generic interface for the body of a beacon
@@ -115,6 +119,9 @@ type Context interface {
 	// GetSkipValidateRandao returns whether to skip validating the RANDAO
 	// reveal.
 	GetSkipValidateRandao() bool
+	// GetSkipValidateProposerSignature returns whether to skip validating the
+	// proposer's signature over the block.
+	GetSkipValidateProposerSignature() bool
 	// GetSkipValidateResult returns whether to validate the result of the state
 	// transition.
 	GetSkipValidateResult() bool
@@ -200,6 +207,12 @@ type ForkData[ForkDataT any] interface {
 		domainType common.DomainType,
 		epoch math.Epoch,
 	) common.Root
+	// ComputeBlockSigningRoot returns the signing root of the beacon block
+	// with the given root.
+	ComputeBlockSigningRoot(
+		domainType common.DomainType,
+		blockRoot common.Root,
+	) common.Root
 }
 
 // Validator represents an interface for a validator with generic type
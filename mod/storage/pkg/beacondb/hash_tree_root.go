@@ -0,0 +1,315 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+)
+
+const (
+	// beaconStateDepthDeneb is the depth of the tree of the fields of the
+	// Deneb beacon state, which holds 16 fields.
+	beaconStateDepthDeneb = 4
+	// beaconStateDepthDenebPlus is the depth of the tree of the fields of the
+	// DenebPlus beacon state, which adds the historical summaries.
+	beaconStateDepthDenebPlus = 5
+)
+
+// StateHashTreeRoot returns the hash tree root of the beacon state held in
+// the store laid out for the given fork version, with block and state roots
+// vectors of slotsPerHistoricalRoot entries and a randao mixes vector of
+// epochsPerHistoricalVector entries.
+// The lists of the state are hashed through the Merkle cache of the store,
+// so that only the entries modified since the last call are read and
+// re-hashed.
+//
+//nolint:funlen // mirrors the fields of the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) StateHashTreeRoot(
+	forkVersion uint32,
+	slotsPerHistoricalRoot, epochsPerHistoricalVector uint64,
+) (common.Root, error) {
+	genesisValidatorsRoot, err := kv.GetGenesisValidatorsRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	slot, err := kv.GetSlot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	fork, err := kv.GetFork()
+	if err != nil {
+		return common.Root{}, err
+	}
+	latestBlockHeader, err := kv.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	blockRootsRoot, err := kv.rootsHashTreeRoot(
+		kv.merkleCache.blockRoots, keys.BlockRootsPrefix,
+		slotsPerHistoricalRoot,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	stateRootsRoot, err := kv.rootsHashTreeRoot(
+		kv.merkleCache.stateRoots, keys.StateRootsPrefix,
+		slotsPerHistoricalRoot,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	eth1Data, err := kv.GetEth1Data()
+	if err != nil {
+		return common.Root{}, err
+	}
+	eth1DepositIndex, err := kv.GetEth1DepositIndex()
+	if err != nil {
+		return common.Root{}, err
+	}
+	latestExecutionPayloadHeader, err := kv.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	validatorsRoot, err := kv.validatorsHashTreeRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	balancesRoot, err := kv.uint64sHashTreeRoot(
+		kv.merkleCache.balances, keys.BalancesPrefix,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	randaoMixesRoot, err := kv.rootsHashTreeRoot(
+		kv.merkleCache.randaoMixes, keys.RandaoMixPrefix,
+		epochsPerHistoricalVector,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	nextWithdrawalIndex, err := kv.GetNextWithdrawalIndex()
+	if err != nil {
+		return common.Root{}, err
+	}
+	nextWithdrawalValidatorIndex, err := kv.GetNextWithdrawalValidatorIndex()
+	if err != nil {
+		return common.Root{}, err
+	}
+	slashingsRoot, err := kv.uint64sHashTreeRoot(
+		kv.merkleCache.slashings, keys.SlashingsPrefix,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	totalSlashing, err := kv.GetTotalSlashing()
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	chunks := []common.Root{
+		genesisValidatorsRoot,
+		uint64Chunk(slot.Unwrap()),
+		fork.HashTreeRoot(),
+		latestBlockHeader.HashTreeRoot(),
+		blockRootsRoot,
+		stateRootsRoot,
+		eth1Data.HashTreeRoot(),
+		uint64Chunk(eth1DepositIndex),
+		latestExecutionPayloadHeader.HashTreeRoot(),
+		validatorsRoot,
+		balancesRoot,
+		randaoMixesRoot,
+		uint64Chunk(nextWithdrawalIndex),
+		uint64Chunk(nextWithdrawalValidatorIndex.Unwrap()),
+		slashingsRoot,
+		uint64Chunk(totalSlashing.Unwrap()),
+	}
+	if forkVersion < version.DenebPlus {
+		return merkleizeChunks(chunks, beaconStateDepthDeneb), nil
+	}
+
+	historicalSummariesRoot, err := kv.historicalSummariesHashTreeRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	return merkleizeChunks(
+		append(chunks, historicalSummariesRoot), beaconStateDepthDenebPlus,
+	), nil
+}
+
+// rootsHashTreeRoot returns the hash tree root of the first length roots of
+// the map with the given prefix.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) rootsHashTreeRoot(
+	cache *listCache,
+	prefix byte,
+	length uint64,
+) (common.Root, error) {
+	return kv.listHashTreeRoot(
+		cache, prefix, length, func(bz [][]byte) (common.Root, error) {
+			return common.Root(bz[0]), nil
+		},
+	)
+}
+
+// uint64sHashTreeRoot returns the hash tree root of the uint64s of the map
+// with the given prefix.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) uint64sHashTreeRoot(
+	cache *listCache,
+	prefix byte,
+) (common.Root, error) {
+	return kv.listHashTreeRoot(
+		cache, prefix, 0, func(bz [][]byte) (common.Root, error) {
+			var err error
+			values := make([]uint64, len(bz))
+			for i := range bz {
+				if values[i], err = sdkcollections.Uint64Value.Decode(
+					bz[i],
+				); err != nil {
+					return common.Root{}, err
+				}
+			}
+			return uint64Chunk(values...), nil
+		},
+	)
+}
+
+// validatorsHashTreeRoot returns the hash tree root of the validators.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) validatorsHashTreeRoot() (common.Root, error) {
+	return kv.listHashTreeRoot(
+		kv.merkleCache.validators, keys.ValidatorByIndexPrefix, 0,
+		encodedHashTreeRoot[ValidatorT],
+	)
+}
+
+// historicalSummariesHashTreeRoot returns the hash tree root of the
+// historical summaries.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) historicalSummariesHashTreeRoot() (common.Root, error) {
+	return kv.listHashTreeRoot(
+		kv.merkleCache.historicalSummaries, keys.HistoricalSummariesPrefix, 0,
+		encodedHashTreeRoot[*common.HistoricalSummary],
+	)
+}
+
+// listHashTreeRoot returns the hash tree root of the map with the given
+// prefix through its cache, reading only the entries written since it was
+// last computed. If length is non-zero, the map is read as a vector holding
+// exactly the keys [0, length).
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) listHashTreeRoot(
+	cache *listCache,
+	prefix byte,
+	length uint64,
+	chunk func([][]byte) (common.Root, error),
+) (common.Root, error) {
+	return cache.hashTreeRoot(
+		kv.height(),
+		func() ([][]byte, error) {
+			return kv.rawValues(prefix, length)
+		},
+		func(index uint64) ([]byte, error) {
+			return kv.kss.OpenKVStore(kv.ctx).Get(
+				binary.BigEndian.AppendUint64([]byte{prefix}, index),
+			)
+		},
+		chunk,
+	)
+}
+
+// rawValues returns the stored encodings of the entries of the map with the
+// given prefix in key order. If length is non-zero, the map is read as a
+// vector holding exactly the keys [0, length).
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) rawValues(prefix byte, length uint64) ([][]byte, error) {
+	start, end := []byte{prefix}, []byte{prefix + 1}
+	if length > 0 {
+		start = binary.BigEndian.AppendUint64([]byte{prefix}, 0)
+		end = binary.BigEndian.AppendUint64([]byte{prefix}, length)
+	}
+
+	iter, err := kv.kss.OpenKVStore(kv.ctx).Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	elems := make([][]byte, 0, length)
+	for ; iter.Valid(); iter.Next() {
+		// Vectors must not have holes, as the entries are read by index.
+		if length > 0 && !bytes.Equal(iter.Key(), binary.BigEndian.AppendUint64(
+			[]byte{prefix}, uint64(len(elems)),
+		)) {
+			break
+		}
+		elems = append(elems, iter.Value())
+	}
+	if err = iter.Close(); err != nil {
+		return nil, err
+	}
+	if uint64(len(elems)) < length {
+		return nil, errors.Wrapf(
+			sdkcollections.ErrNotFound,
+			"entry %d with prefix %d", len(elems), prefix,
+		)
+	}
+	return elems, nil
+}
+
+// encodedHashTreeRoot returns the hash tree root of the single SSZ encoded
+// element in bz.
+func encodedHashTreeRoot[
+	T interface {
+		constraints.Empty[T]
+		constraints.SSZMarshallableRootable
+	},
+](bz [][]byte) (common.Root, error) {
+	value, err := encoding.SSZValueCodec[T]{}.Decode(bz[0])
+	if err != nil {
+		return common.Root{}, err
+	}
+	return value.HashTreeRoot(), nil
+}
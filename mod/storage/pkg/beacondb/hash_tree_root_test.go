@@ -0,0 +1,351 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"context"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSlotsPerHistoricalRoot    = 8
+	testEpochsPerHistoricalVector = 4
+)
+
+type testBeaconState = types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+]
+
+// contextKVStoreService opens the store of the context it is given, so that
+// branched contexts are honoured, and counts the iterators opened over it.
+type contextKVStoreService struct {
+	iterators *int
+}
+
+func (s contextKVStoreService) OpenKVStore(
+	ctx context.Context,
+) corestore.KVStore {
+	return countingKVStore{
+		KVStore: components.NewKVStore(
+			sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey),
+		),
+		iterators: s.iterators,
+	}
+}
+
+type countingKVStore struct {
+	corestore.KVStore
+	iterators *int
+}
+
+func (s countingKVStore) Iterator(
+	start, end []byte,
+) (corestore.Iterator, error) {
+	*s.iterators++
+	return s.KVStore.Iterator(start, end)
+}
+
+func TestStateHashTreeRoot(t *testing.T) {
+	kv, st, _ := initTestStateStore(t)
+	requireStateRoot(t, kv, st)
+
+	// Recomputing without modifications yields the same root.
+	requireStateRoot(t, kv, st)
+
+	// Modify a few entries of every list.
+	st.Validators[1].EffectiveBalance = 1e9
+	require.NoError(t, kv.UpdateValidatorAtIndex(1, st.Validators[1]))
+	st.Balances[4] = 7
+	require.NoError(t, kv.SetBalance(4, math.Gwei(7)))
+	st.BlockRoots[3] = common.Root{0xaa}
+	require.NoError(t, kv.UpdateBlockRootAtIndex(3, st.BlockRoots[3]))
+	st.StateRoots[7] = common.Root{0xbb}
+	require.NoError(t, kv.UpdateStateRootAtIndex(7, st.StateRoots[7]))
+	st.RandaoMixes[0] = common.Bytes32{0xcc}
+	require.NoError(t, kv.UpdateRandaoMixAtIndex(0, st.RandaoMixes[0]))
+	st.Slashings[1] = 42
+	require.NoError(t, kv.SetSlashingAtIndex(1, st.Slashings[1]))
+	st.Slot = 2
+	require.NoError(t, kv.SetSlot(st.Slot))
+	requireStateRoot(t, kv, st)
+
+	// Grow the lists.
+	val := &types.Validator{Pubkey: bytes.B48{0xff}, EffectiveBalance: 32e9}
+	st.Validators = append(st.Validators, val)
+	st.Balances = append(st.Balances, 0)
+	require.NoError(t, kv.AddValidator(val))
+	st.Slashings = append(st.Slashings, 3)
+	require.NoError(t, kv.SetSlashingAtIndex(2, 3))
+	summary := (&common.HistoricalSummary{}).New(
+		common.Root{0x01}, common.Root{0x02},
+	)
+	st.HistoricalSummaries = append(st.HistoricalSummaries, summary)
+	require.NoError(t, kv.AddHistoricalSummary(summary, 0))
+	requireStateRoot(t, kv, st)
+}
+
+func TestStateHashTreeRootReadsDirtyEntries(t *testing.T) {
+	kv, st, iterators := initTestStateStore(t)
+	requireStateRoot(t, kv, st)
+
+	// Once the cache is populated, only the written entries are read back
+	// from the store, instead of iterating over the lists.
+	st.Balances[2] = 9
+	require.NoError(t, kv.SetBalance(2, math.Gwei(9)))
+	val := &types.Validator{Pubkey: bytes.B48{0xff}, EffectiveBalance: 32e9}
+	st.Validators = append(st.Validators, val)
+	st.Balances = append(st.Balances, 0)
+	require.NoError(t, kv.AddValidator(val))
+	*iterators = 0
+	requireStateRoot(t, kv, st)
+	require.Zero(t, *iterators)
+}
+
+func TestStateHashTreeRootBranches(t *testing.T) {
+	kv, st, _ := initTestStateStore(t)
+	requireStateRoot(t, kv, st)
+
+	// Hashing a modified branch shares the cache with the parent store...
+	branch := kv.Copy()
+	require.NoError(t, branch.SetBalance(0, 1))
+	require.NoError(t, branch.UpdateBlockRootAtIndex(0, common.Root{0x01}))
+	branchRoot, err := branch.StateHashTreeRoot(
+		version.Deneb,
+		testSlotsPerHistoricalRoot, testEpochsPerHistoricalVector,
+	)
+	require.NoError(t, err)
+	require.NotEqual(t, st.HashTreeRoot(), branchRoot)
+
+	// ...which must not be affected by the discarded modifications.
+	requireStateRoot(t, kv, st)
+}
+
+func TestStateHashTreeRootOlderHeight(t *testing.T) {
+	kv, st, _ := initTestStateStore(t)
+	requireStateRoot(t, kv, st)
+
+	// A state of the next height, built on top of a modified branch, moves
+	// the cache forward...
+	branch := kv.Copy()
+	require.NoError(t, branch.SetBalance(3, 11))
+	ctx := sdk.UnwrapSDKContext(branch.Context())
+	next := branch.WithContext(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	require.NoError(t, next.SetBalance(1, 5))
+	nextRoot, err := next.StateHashTreeRoot(
+		version.Deneb,
+		testSlotsPerHistoricalRoot, testEpochsPerHistoricalVector,
+	)
+	require.NoError(t, err)
+	require.NotEqual(t, st.HashTreeRoot(), nextRoot)
+
+	// ...while the state of the older height is hashed from all its
+	// entries, and leaves the cache untouched.
+	requireStateRoot(t, kv, st)
+	root, err := next.StateHashTreeRoot(
+		version.Deneb,
+		testSlotsPerHistoricalRoot, testEpochsPerHistoricalVector,
+	)
+	require.NoError(t, err)
+	require.Equal(t, nextRoot, root)
+}
+
+func TestStateHashTreeRootMissingVectorEntry(t *testing.T) {
+	kv, _, _ := initTestStateStore(t)
+	_, err := kv.StateHashTreeRoot(
+		version.Deneb,
+		testSlotsPerHistoricalRoot+1, testEpochsPerHistoricalVector,
+	)
+	require.Error(t, err)
+}
+
+func requireStateRoot(
+	t *testing.T,
+	kv *beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		[]*types.Validator,
+	],
+	st *testBeaconState,
+) {
+	t.Helper()
+	for _, forkVersion := range []uint32{version.Deneb, version.DenebPlus} {
+		root, err := kv.StateHashTreeRoot(
+			forkVersion,
+			testSlotsPerHistoricalRoot, testEpochsPerHistoricalVector,
+		)
+		require.NoError(t, err)
+		layout, err := st.New(
+			forkVersion,
+			st.GenesisValidatorsRoot,
+			st.Slot,
+			st.Fork,
+			st.LatestBlockHeader,
+			st.BlockRoots,
+			st.StateRoots,
+			st.Eth1Data,
+			st.Eth1DepositIndex,
+			st.LatestExecutionPayloadHeader,
+			st.Validators,
+			st.Balances,
+			st.RandaoMixes,
+			st.NextWithdrawalIndex,
+			st.NextWithdrawalValidatorIndex,
+			st.Slashings,
+			st.TotalSlashing,
+			st.HistoricalSummaries,
+		)
+		require.NoError(t, err)
+		require.Equal(t, layout.HashTreeRoot(), root, "fork %d", forkVersion)
+	}
+}
+
+//nolint:funlen // populates the whole state.
+func initTestStateStore(t *testing.T) (
+	*beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		[]*types.Validator,
+	],
+	*testBeaconState,
+	*int,
+) {
+	t.Helper()
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	cms := store.NewCommitMultiStore(
+		memDB, log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	iterators := new(int)
+	ctx := sdk.NewContext(cms, true, log.NewNopLogger()).WithBlockHeight(1)
+
+	kv := beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		[]*types.Validator,
+	](contextKVStoreService{iterators: iterators}, testCodec).WithContext(ctx)
+
+	st := &testBeaconState{
+		GenesisValidatorsRoot: common.Root{0x01},
+		Slot:                  1,
+		Fork: &types.Fork{
+			PreviousVersion: common.Version{0x01},
+			CurrentVersion:  common.Version{0x02},
+		},
+		LatestBlockHeader: &types.BeaconBlockHeader{
+			Slot:          1,
+			ProposerIndex: 2,
+		},
+		Eth1Data:         &types.Eth1Data{DepositCount: 3},
+		Eth1DepositIndex: 4,
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			Number: 5,
+		},
+		NextWithdrawalIndex:          6,
+		NextWithdrawalValidatorIndex: 7,
+		Slashings:                    []math.Gwei{1, 2},
+		TotalSlashing:                3,
+		HistoricalSummaries:          []*common.HistoricalSummary{},
+	}
+	require.NoError(t, kv.SetGenesisValidatorsRoot(st.GenesisValidatorsRoot))
+	require.NoError(t, kv.SetSlot(st.Slot))
+	require.NoError(t, kv.SetFork(st.Fork))
+	require.NoError(t, kv.SetLatestBlockHeader(st.LatestBlockHeader))
+	require.NoError(t, kv.SetEth1Data(st.Eth1Data))
+	require.NoError(t, kv.SetEth1DepositIndex(st.Eth1DepositIndex))
+	require.NoError(t, kv.SetLatestExecutionPayloadHeader(
+		st.LatestExecutionPayloadHeader,
+	))
+	require.NoError(t, kv.SetNextWithdrawalIndex(st.NextWithdrawalIndex))
+	require.NoError(t, kv.SetNextWithdrawalValidatorIndex(
+		st.NextWithdrawalValidatorIndex,
+	))
+	for i, slashing := range st.Slashings {
+		require.NoError(t, kv.SetSlashingAtIndex(uint64(i), slashing))
+	}
+	require.NoError(t, kv.SetTotalSlashing(st.TotalSlashing))
+
+	for i := range testSlotsPerHistoricalRoot {
+		st.BlockRoots = append(st.BlockRoots, common.Root{byte(i), 0x01})
+		st.StateRoots = append(st.StateRoots, common.Root{byte(i), 0x02})
+		require.NoError(t, kv.UpdateBlockRootAtIndex(
+			uint64(i), st.BlockRoots[i],
+		))
+		require.NoError(t, kv.UpdateStateRootAtIndex(
+			uint64(i), st.StateRoots[i],
+		))
+	}
+	for i := range testEpochsPerHistoricalVector {
+		st.RandaoMixes = append(st.RandaoMixes, common.Bytes32{byte(i)})
+		require.NoError(t, kv.UpdateRandaoMixAtIndex(
+			uint64(i), st.RandaoMixes[i],
+		))
+	}
+	for i := range 5 {
+		val := &types.Validator{
+			Pubkey:           bytes.B48{byte(i)},
+			EffectiveBalance: 32e9,
+		}
+		st.Validators = append(st.Validators, val)
+		st.Balances = append(st.Balances, uint64(i)*1e9)
+		require.NoError(t, kv.AddValidator(val))
+		require.NoError(t, kv.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(st.Balances[i]),
+		))
+	}
+	return kv, st, iterators
+}
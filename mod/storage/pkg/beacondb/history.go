@@ -20,7 +20,10 @@
 
 package beacondb
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
 
 // UpdateBlockRootAtIndex sets a block root in the BeaconStore.
 func (kv *KVStore[
@@ -30,6 +33,7 @@ func (kv *KVStore[
 	index uint64,
 	root common.Root,
 ) error {
+	kv.merkleCache.blockRoots.markDirty(kv.height(), index)
 	return kv.blockRoots.Set(kv.ctx, index, root[:])
 }
 
@@ -75,6 +79,7 @@ func (kv *KVStore[
 	idx uint64,
 	stateRoot common.Root,
 ) error {
+	kv.merkleCache.stateRoots.markDirty(kv.height(), idx)
 	return kv.stateRoots.Set(kv.ctx, idx, stateRoot[:])
 }
 
@@ -91,3 +96,69 @@ func (kv *KVStore[
 	}
 	return common.Root(bz), nil
 }
+
+// AddHistoricalSummary appends a historical summary of the period starting at
+// the given slot to the BeaconStore. The start slot of the first summary is
+// kept, as the summaries are indexed from it.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) AddHistoricalSummary(
+	summary *common.HistoricalSummary,
+	periodStart math.Slot,
+) error {
+	idx, err := kv.historicalSummariesLength.Next(kv.ctx)
+	if err != nil {
+		return err
+	}
+	if idx == 0 {
+		if err = kv.historicalSummariesStartSlot.Set(
+			kv.ctx, periodStart.Unwrap(),
+		); err != nil {
+			return err
+		}
+	}
+	kv.merkleCache.historicalSummaries.markDirty(kv.height(), idx)
+	return kv.historicalSummaries.Set(kv.ctx, idx, summary)
+}
+
+// GetHistoricalSummaryAtIndex returns the historical summary at the given
+// index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetHistoricalSummaryAtIndex(
+	idx uint64,
+) (*common.HistoricalSummary, error) {
+	return kv.historicalSummaries.Get(kv.ctx, idx)
+}
+
+// GetHistoricalSummariesStartSlot returns the first slot of the period of the
+// first historical summary, which errors if no summary was added yet.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetHistoricalSummariesStartSlot() (math.Slot, error) {
+	slot, err := kv.historicalSummariesStartSlot.Get(kv.ctx)
+	return math.Slot(slot), err
+}
+
+// GetHistoricalSummaries returns all the historical summaries, oldest first.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetHistoricalSummaries() ([]*common.HistoricalSummary, error) {
+	length, err := kv.historicalSummariesLength.Peek(kv.ctx)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]*common.HistoricalSummary, length)
+	for idx := range length {
+		if summaries[idx], err = kv.historicalSummaries.Get(
+			kv.ctx, idx,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return summaries, nil
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalSummariesStartSlot(t *testing.T) {
+	kv, _, _ := initTestStateStore(t)
+
+	// The start slot is only known once the first summary is added.
+	_, err := kv.GetHistoricalSummariesStartSlot()
+	require.Error(t, err)
+
+	// Only the period of the first summary is kept.
+	for i := range uint64(3) {
+		require.NoError(t, kv.AddHistoricalSummary(
+			(&common.HistoricalSummary{}).New(
+				common.Root{byte(i)}, common.Root{byte(i)},
+			),
+			math.Slot(16+i*8),
+		))
+	}
+	startSlot, err := kv.GetHistoricalSummariesStartSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(16), startSlot)
+
+	summaries, err := kv.GetHistoricalSummaries()
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+}
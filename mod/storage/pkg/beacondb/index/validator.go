@@ -113,3 +113,19 @@ func NewValidatorsIndex[ValidatorT Validator](
 		),
 	}
 }
+
+// ValidatorPubkeyKey returns the raw store key under which the index of the
+// validator with the given pubkey is stored. The value under this key is the
+// big-endian encoded validator index.
+func ValidatorPubkeyKey(pubkey []byte) []byte {
+	return append([]byte(validatorPubkeyToIndexPrefix), pubkey...)
+}
+
+// Prefixes returns the prefixes of the raw store keys of the indexes.
+func Prefixes() []string {
+	return []string{
+		validatorPubkeyToIndexPrefix,
+		validatorConsAddrToIndexPrefix,
+		validatorEffectiveBalanceToIndexPrefix,
+	}
+}
@@ -44,6 +44,9 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	HistoricalSummariesPrefix
+	HistoricalSummariesLengthPrefix
+	HistoricalSummariesStartSlotPrefix
 )
 
 //nolint:lll
@@ -71,4 +74,48 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	HistoricalSummariesPrefixHumanReadable              = "HistoricalSummariesPrefix"
+	HistoricalSummariesLengthPrefixHumanReadable        = "HistoricalSummariesLengthPrefix"
+	HistoricalSummariesStartSlotPrefixHumanReadable     = "HistoricalSummariesStartSlotPrefix"
 )
+
+// humanReadable maps the prefixes to their human readable names.
+//
+//nolint:gochecknoglobals,lll // lookup table.
+var humanReadable = [...]string{
+	WithdrawalQueuePrefix:                  WithdrawalQueuePrefixHumanReadable,
+	RandaoMixPrefix:                        RandaoMixPrefixHumanReadable,
+	SlashingsPrefix:                        SlashingsPrefixHumanReadable,
+	TotalSlashingPrefix:                    TotalSlashingPrefixHumanReadable,
+	ValidatorIndexPrefix:                   ValidatorIndexPrefixHumanReadable,
+	BlockRootsPrefix:                       BlockRootsPrefixHumanReadable,
+	StateRootsPrefix:                       StateRootsPrefixHumanReadable,
+	ValidatorByIndexPrefix:                 ValidatorByIndexPrefixHumanReadable,
+	ValidatorPubkeyToIndexPrefix:           ValidatorPubkeyToIndexPrefixHumanReadable,
+	ValidatorConsAddrToIndexPrefix:         ValidatorConsAddrToIndexPrefixHumanReadable,
+	ValidatorEffectiveBalanceToIndexPrefix: ValidatorEffectiveBalanceToIndexPrefixHumanReadable,
+	LatestBeaconBlockHeaderPrefix:          LatestBeaconBlockHeaderPrefixHumanReadable,
+	SlotPrefix:                             SlotPrefixHumanReadable,
+	BalancesPrefix:                         BalancesPrefixHumanReadable,
+	Eth1BlockHashPrefix:                    Eth1BlockHashPrefixHumanReadable,
+	Eth1DataPrefix:                         Eth1DataPrefixHumanReadable,
+	Eth1DepositIndexPrefix:                 Eth1DepositIndexPrefixHumanReadable,
+	LatestExecutionPayloadHeaderPrefix:     LatestExecutionPayloadHeaderPrefixHumanReadable,
+	LatestExecutionPayloadVersionPrefix:    LatestExecutionPayloadVersionPrefixHumanReadable,
+	GenesisValidatorsRootPrefix:            GenesisValidatorsRootPrefixHumanReadable,
+	NextWithdrawalIndexPrefix:              NextWithdrawalIndexPrefixHumanReadable,
+	NextWithdrawalValidatorIndexPrefix:     NextWithdrawalValidatorIndexPrefixHumanReadable,
+	ForkPrefix:                             ForkPrefixHumanReadable,
+	HistoricalSummariesPrefix:              HistoricalSummariesPrefixHumanReadable,
+	HistoricalSummariesLengthPrefix:        HistoricalSummariesLengthPrefixHumanReadable,
+	HistoricalSummariesStartSlotPrefix:     HistoricalSummariesStartSlotPrefixHumanReadable,
+}
+
+// HumanReadable returns the human readable name of the prefix, or false if
+// the prefix is unknown.
+func HumanReadable(prefix byte) (string, bool) {
+	if int(prefix) >= len(humanReadable) {
+		return "", false
+	}
+	return humanReadable[prefix], true
+}
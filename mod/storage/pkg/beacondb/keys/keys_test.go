@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHumanReadable(t *testing.T) {
+	name, ok := keys.HumanReadable(keys.BalancesPrefix)
+	require.True(t, ok)
+	require.Equal(t, keys.BalancesPrefixHumanReadable, name)
+
+	name, ok = keys.HumanReadable(keys.HistoricalSummariesStartSlotPrefix)
+	require.True(t, ok)
+	require.Equal(t, keys.HistoricalSummariesStartSlotPrefixHumanReadable, name)
+
+	_, ok = keys.HumanReadable(keys.HistoricalSummariesStartSlotPrefix + 1)
+	require.False(t, ok)
+}
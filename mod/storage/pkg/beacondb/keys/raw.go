@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import "encoding/binary"
+
+// The raw keys below mirror the encoding used by the collections in the
+// beacon store, i.e. a single byte prefix followed by the big-endian encoded
+// uint64 key, if any. They allow reading entries directly from the
+// underlying store, e.g. to serve ABCI queries with Merkle proofs.
+
+// SlotKey returns the raw store key of the current slot.
+func SlotKey() []byte {
+	return []byte{SlotPrefix}
+}
+
+// BalanceKey returns the raw store key of the balance of the validator at
+// the given index.
+func BalanceKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{BalancesPrefix}, index)
+}
+
+// ValidatorByIndexKey returns the raw store key of the validator at the given
+// index.
+func ValidatorByIndexKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64(
+		[]byte{ValidatorByIndexPrefix}, index,
+	)
+}
+
+// BlockRootKey returns the raw store key of the block root at the given index
+// of the block roots ring buffer.
+func BlockRootKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{BlockRootsPrefix}, index)
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys_test
+
+import (
+	"testing"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawKeys(t *testing.T) {
+	for _, index := range []uint64{0, 1, 1_989, 1 << 40} {
+		want, err := sdkcollections.EncodeKeyWithPrefix(
+			sdkcollections.NewPrefix([]byte{keys.BalancesPrefix}),
+			sdkcollections.Uint64Key,
+			index,
+		)
+		require.NoError(t, err)
+		require.Equal(t, want, keys.BalanceKey(index))
+
+		want, err = sdkcollections.EncodeKeyWithPrefix(
+			sdkcollections.NewPrefix([]byte{keys.ValidatorByIndexPrefix}),
+			sdkcollections.Uint64Key,
+			index,
+		)
+		require.NoError(t, err)
+		require.Equal(t, want, keys.ValidatorByIndexKey(index))
+
+		want, err = sdkcollections.EncodeKeyWithPrefix(
+			sdkcollections.NewPrefix([]byte{keys.BlockRootsPrefix}),
+			sdkcollections.Uint64Key,
+			index,
+		)
+		require.NoError(t, err)
+		require.Equal(t, want, keys.BlockRootKey(index))
+	}
+
+	require.Equal(
+		t,
+		sdkcollections.NewPrefix([]byte{keys.SlotPrefix}).Bytes(),
+		keys.SlotKey(),
+	)
+}
@@ -25,6 +25,7 @@ import (
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/index"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
@@ -37,25 +38,30 @@ import (
 type KVStore[
 	BeaconBlockHeaderT interface {
 		constraints.Empty[BeaconBlockHeaderT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	Eth1DataT interface {
 		constraints.Empty[Eth1DataT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	ExecutionPayloadHeaderT interface {
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 		NewFromSSZ([]byte, uint32) (ExecutionPayloadHeaderT, error)
 		Version() uint32
 	},
 	ForkT interface {
 		constraints.Empty[ForkT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	ValidatorT Validator[ValidatorT],
 	ValidatorsT ~[]ValidatorT,
 ] struct {
 	ctx context.Context
+	// kss is the service used to read raw entries out of the store.
+	kss store.KVStoreService
+	// merkleCache caches the Merkle trees of the list fields of the beacon
+	// state across every copy of the store.
+	merkleCache *merkleCache
 	// Versioning
 	// genesisValidatorsRoot is the root of the genesis validators.
 	genesisValidatorsRoot sdkcollections.Item[[]byte]
@@ -106,6 +112,18 @@ type KVStore[
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
+	// Historical summaries
+	// historicalSummaries stores the summaries of the block and state roots
+	// of every past period of SlotsPerHistoricalRoot slots.
+	historicalSummaries sdkcollections.Map[
+		uint64, *common.HistoricalSummary,
+	]
+	// historicalSummariesLength provides the index of the next historical
+	// summary.
+	historicalSummariesLength sdkcollections.Sequence
+	// historicalSummariesStartSlot stores the first slot of the period of the
+	// first historical summary, from which the summaries are indexed.
+	historicalSummariesStartSlot sdkcollections.Item[uint64]
 }
 
 // New creates a new instance of Store.
@@ -114,20 +132,20 @@ type KVStore[
 func New[
 	BeaconBlockHeaderT interface {
 		constraints.Empty[BeaconBlockHeaderT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	Eth1DataT interface {
 		constraints.Empty[Eth1DataT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	ExecutionPayloadHeaderT interface {
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 		NewFromSSZ([]byte, uint32) (ExecutionPayloadHeaderT, error)
 		Version() uint32
 	},
 	ForkT interface {
 		constraints.Empty[ForkT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	ValidatorT Validator[ValidatorT],
 	ValidatorsT ~[]ValidatorT,
@@ -143,7 +161,9 @@ func New[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 		ForkT, ValidatorT, ValidatorsT,
 	]{
-		ctx: nil,
+		ctx:         nil,
+		kss:         kss,
+		merkleCache: newMerkleCache(),
 		genesisValidatorsRoot: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.GenesisValidatorsRootPrefix}),
@@ -267,6 +287,28 @@ func New[
 			keys.LatestBeaconBlockHeaderPrefixHumanReadable,
 			encoding.SSZValueCodec[BeaconBlockHeaderT]{},
 		),
+		historicalSummaries: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.HistoricalSummariesPrefix}),
+			keys.HistoricalSummariesPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[*common.HistoricalSummary]{},
+		),
+		historicalSummariesLength: sdkcollections.NewSequence(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.HistoricalSummariesLengthPrefix},
+			),
+			keys.HistoricalSummariesLengthPrefixHumanReadable,
+		),
+		historicalSummariesStartSlot: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.HistoricalSummariesStartSlotPrefix},
+			),
+			keys.HistoricalSummariesStartSlotPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
 	}
 }
 
@@ -292,6 +334,25 @@ func (kv *KVStore[
 	return kv.ctx
 }
 
+// height returns the height of the block the state of the store belongs
+// to, or zero if the context does not carry it.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) height() int64 {
+	if kv.ctx == nil {
+		return 0
+	}
+	sdkCtx, ok := kv.ctx.(sdk.Context)
+	if !ok {
+		sdkCtx, ok = kv.ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	}
+	if !ok {
+		return 0
+	}
+	return sdkCtx.BlockHeight()
+}
+
 // WithContext returns a copy of the Store with the given context.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
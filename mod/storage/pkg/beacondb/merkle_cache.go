@@ -0,0 +1,340 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// Depths of the chunk trees of the list fields of the beacon state, i.e.
+// log2 of their limits expressed in chunks.
+const (
+	historicalRootsDepth     = 13 // 8192 roots
+	randaoMixesDepth         = 16 // 65536 mixes
+	validatorsDepth          = 40 // 2^40 validators
+	packedUint64sDepth       = 38 // 2^40 uint64s, 4 per chunk
+	historicalSummariesDepth = 24 // 2^24 summaries
+	uint64sPerChunk          = 4
+)
+
+// merkleCache holds the Merkle trees of the list fields of the beacon state
+// that are expensive to re-hash. It is shared by every copy of the KVStore.
+type merkleCache struct {
+	blockRoots          *listCache
+	stateRoots          *listCache
+	validators          *listCache
+	balances            *listCache
+	randaoMixes         *listCache
+	slashings           *listCache
+	historicalSummaries *listCache
+}
+
+// newMerkleCache creates an empty merkleCache.
+func newMerkleCache() *merkleCache {
+	return &merkleCache{
+		blockRoots:          newListCache(historicalRootsDepth, 1),
+		stateRoots:          newListCache(historicalRootsDepth, 1),
+		validators:          newListCache(validatorsDepth, 1),
+		balances:            newListCache(packedUint64sDepth, uint64sPerChunk),
+		randaoMixes:         newListCache(randaoMixesDepth, 1),
+		slashings:           newListCache(packedUint64sDepth, uint64sPerChunk),
+		historicalSummaries: newListCache(historicalSummariesDepth, 1),
+	}
+}
+
+// listCache caches the Merkle tree of an SSZ list together with the stored
+// encodings of the elements it was computed from. The setters of the store
+// mark the indices they write as dirty under the height of the block being
+// processed, and only the dirty entries are read back from the store.
+//
+// Every state of a given height is a branch of the state committed at the
+// previous height, or of the state committed at that height. Hence, it only
+// differs from the cached elements at the indices written at the heights
+// since the one the tree was last computed at, even when the cache is used
+// alternately by branched contexts, e.g. ProcessProposal and FinalizeBlock.
+// States of older or unknown heights, e.g. historical queries, are hashed
+// from all their entries, without affecting the cache.
+type listCache struct {
+	mu sync.Mutex
+	// depth is the depth of the tree at the limit of the list.
+	depth uint8
+	// elemsPerChunk is the number of elements packed in a single chunk.
+	elemsPerChunk uint64
+	// height is the height of the state the tree was last computed from.
+	height int64
+	// dirty holds the indices written at every height since height.
+	dirty map[int64]map[uint64]struct{}
+	// elems are the encodings the tree was last computed from.
+	elems [][]byte
+	// layers holds the nodes of the tree, with the chunks at layers[0] and
+	// the root of the populated part of the tree as the only node of the last
+	// layer.
+	layers [][]common.Root
+}
+
+// newListCache creates an empty listCache.
+func newListCache(depth uint8, elemsPerChunk uint64) *listCache {
+	return &listCache{
+		depth:         depth,
+		elemsPerChunk: elemsPerChunk,
+		dirty:         make(map[int64]map[uint64]struct{}),
+	}
+}
+
+// markDirty records that the element at the given index was written in a
+// state of the given height.
+func (c *listCache) markDirty(height int64, index uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.dirty[height] == nil {
+		c.dirty[height] = make(map[uint64]struct{})
+	}
+	c.dirty[height][index] = struct{}{}
+}
+
+// hashTreeRoot returns the hash tree root of the list held in a state of the
+// given height. The readAll function returns the encodings of every element
+// of the list, and read the encoding of the element at an index, or nil if
+// there is none. The chunk function builds a chunk out of the encodings of
+// the elements it packs, and is only invoked for chunks holding modified
+// elements.
+func (c *listCache) hashTreeRoot(
+	height int64,
+	readAll func() ([][]byte, error),
+	read func(index uint64) ([]byte, error),
+	chunk func([][]byte) (common.Root, error),
+) (common.Root, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height <= 0 || height < c.height {
+		elems, err := readAll()
+		if err != nil {
+			return common.Root{}, err
+		}
+		return newListCache(c.depth, c.elemsPerChunk).update(elems, chunk)
+	}
+
+	elems, err := c.readDirty(read)
+	if err != nil {
+		return common.Root{}, err
+	}
+	if elems == nil {
+		if elems, err = readAll(); err != nil {
+			return common.Root{}, err
+		}
+	}
+
+	root, err := c.update(elems, chunk)
+	if err != nil {
+		return common.Root{}, err
+	}
+	c.height = height
+	for h := range c.dirty {
+		if h < height {
+			delete(c.dirty, h)
+		}
+	}
+	return root, nil
+}
+
+// readDirty returns the cached elements with the dirty ones read back from
+// the store. It returns nil if the tree was not computed yet, or if the list
+// in the store is not the cached one with elements modified or appended, in
+// which case it must be read entirely.
+func (c *listCache) readDirty(
+	read func(index uint64) ([]byte, error),
+) ([][]byte, error) {
+	if c.layers == nil {
+		return nil, nil
+	}
+
+	var indices []uint64
+	for h, dirty := range c.dirty {
+		if h < c.height {
+			continue
+		}
+		for idx := range dirty {
+			indices = append(indices, idx)
+		}
+	}
+	slices.Sort(indices)
+	indices = slices.Compact(indices)
+
+	elems := slices.Clone(c.elems)
+	for _, idx := range indices {
+		elem, err := read(idx)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case idx < uint64(len(elems)) && elem != nil:
+			elems[idx] = elem
+		case idx == uint64(len(elems)) && elem != nil:
+			elems = append(elems, elem)
+		case idx < uint64(len(elems)) || elem != nil:
+			// An element was removed, or appended after a missing one.
+			return nil, nil
+		}
+		// Otherwise the element was appended in another branch only.
+	}
+	return elems, nil
+}
+
+// update returns the hash tree root of the list of the given encoded
+// elements, re-hashing only the chunks holding elements that differ from
+// the cached ones.
+func (c *listCache) update(
+	elems [][]byte,
+	chunk func([][]byte) (common.Root, error),
+) (common.Root, error) {
+	// Removing elements is not expected from the beacon state lists, so it
+	// is simply handled by starting over.
+	if len(elems) < len(c.elems) {
+		c.elems, c.layers = nil, nil
+	}
+
+	var dirty []uint64
+	for i, elem := range elems {
+		if i < len(c.elems) && bytes.Equal(c.elems[i], elem) {
+			elems[i] = c.elems[i]
+			continue
+		}
+		elems[i] = bytes.Clone(elem)
+		idx := uint64(i) / c.elemsPerChunk
+		if len(dirty) == 0 || dirty[len(dirty)-1] != idx {
+			dirty = append(dirty, idx)
+		}
+	}
+
+	numChunks := (uint64(len(elems)) + c.elemsPerChunk - 1) / c.elemsPerChunk
+	if len(c.layers) == 0 {
+		c.layers = make([][]common.Root, 1)
+	}
+	c.layers[0] = resize(c.layers[0], numChunks)
+	for _, idx := range dirty {
+		end := min((idx+1)*c.elemsPerChunk, uint64(len(elems)))
+		root, err := chunk(elems[idx*c.elemsPerChunk : end])
+		if err != nil {
+			// The tree is left partially updated, start over next time.
+			c.elems, c.layers = nil, nil
+			return common.Root{}, err
+		}
+		c.layers[0][idx] = root
+	}
+	c.elems = elems
+
+	height := 0
+	for ; len(c.layers[height]) > 1; height++ {
+		if len(c.layers) == height+1 {
+			c.layers = append(c.layers, nil)
+		}
+		layer := c.layers[height]
+		parents := resize(c.layers[height+1], (uint64(len(layer))+1)/2)
+		var dirtyParents []uint64
+		for _, idx := range dirty {
+			parent := idx / 2
+			if len(dirtyParents) > 0 &&
+				dirtyParents[len(dirtyParents)-1] == parent {
+				continue
+			}
+			dirtyParents = append(dirtyParents, parent)
+			right := common.Root(zero.Hashes[height])
+			if 2*parent+1 < uint64(len(layer)) {
+				right = layer[2*parent+1]
+			}
+			parents[parent] = hashPair(layer[2*parent], right)
+		}
+		c.layers[height+1] = parents
+		dirty = dirtyParents
+	}
+	c.layers = c.layers[:height+1]
+
+	root := common.Root(zero.Hashes[c.depth])
+	if numChunks > 0 {
+		root = c.layers[height][0]
+		for d := height; d < int(c.depth); d++ {
+			root = hashPair(root, zero.Hashes[d])
+		}
+	}
+	return mixInLength(root, uint64(len(elems))), nil
+}
+
+// resize returns the given nodes resized to the given length.
+func resize(nodes []common.Root, length uint64) []common.Root {
+	if uint64(cap(nodes)) >= length {
+		return nodes[:length]
+	}
+	return append(nodes, make([]common.Root, length-uint64(len(nodes)))...)
+}
+
+// merkleizeChunks returns the root of the tree of the given depth with the
+// given chunks as its leftmost leaves.
+func merkleizeChunks(chunks []common.Root, depth uint8) common.Root {
+	layer := chunks
+	for d := range depth {
+		next := make([]common.Root, (len(layer)+1)/2)
+		for i := range next {
+			right := common.Root(zero.Hashes[d])
+			if 2*i+1 < len(layer) {
+				right = layer[2*i+1]
+			}
+			next[i] = hashPair(layer[2*i], right)
+		}
+		layer = next
+	}
+	if len(layer) == 0 {
+		return zero.Hashes[depth]
+	}
+	return layer[0]
+}
+
+// mixInLength mixes the length of a list into the root of its chunks.
+func mixInLength(root common.Root, length uint64) common.Root {
+	var lengthChunk common.Root
+	binary.LittleEndian.PutUint64(lengthChunk[:], length)
+	return hashPair(root, lengthChunk)
+}
+
+// uint64Chunk packs the given little-endian uint64s into a chunk.
+func uint64Chunk(values ...uint64) common.Root {
+	var chunk common.Root
+	for i, value := range values {
+		binary.LittleEndian.PutUint64(chunk[8*i:], value)
+	}
+	return chunk
+}
+
+// hashPair returns the hash of the concatenation of two nodes.
+func hashPair(left, right common.Root) common.Root {
+	var buf [64]byte
+	copy(buf[:32], left[:])
+	copy(buf[32:], right[:])
+	return sha256.Hash(buf[:])
+}
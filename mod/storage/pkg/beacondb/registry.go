@@ -40,6 +40,8 @@ func (kv *KVStore[
 	}
 
 	// Push onto the validators list.
+	kv.merkleCache.validators.markDirty(kv.height(), idx)
+	kv.merkleCache.balances.markDirty(kv.height(), idx)
 	if err = kv.validators.Set(kv.ctx, idx, val); err != nil {
 		return err
 	}
@@ -59,6 +61,8 @@ func (kv *KVStore[
 	}
 
 	// Push onto the validators list.
+	kv.merkleCache.validators.markDirty(kv.height(), idx)
+	kv.merkleCache.balances.markDirty(kv.height(), idx)
 	if err = kv.validators.Set(kv.ctx, idx, val); err != nil {
 		return err
 	}
@@ -75,6 +79,7 @@ func (kv *KVStore[
 	index math.ValidatorIndex,
 	val ValidatorT,
 ) error {
+	kv.merkleCache.validators.markDirty(kv.height(), index.Unwrap())
 	return kv.validators.Set(kv.ctx, index.Unwrap(), val)
 }
 
@@ -233,6 +238,7 @@ func (kv *KVStore[
 	idx math.ValidatorIndex,
 	balance math.Gwei,
 ) error {
+	kv.merkleCache.balances.markDirty(kv.height(), idx.Unwrap())
 	return kv.balances.Set(kv.ctx, idx.Unwrap(), balance.Unwrap())
 }
 
@@ -74,6 +74,7 @@ func (kv *KVStore[
 	index uint64,
 	amount math.Gwei,
 ) error {
+	kv.merkleCache.slashings.markDirty(kv.height(), index)
 	return kv.slashings.Set(kv.ctx, index, amount.Unwrap())
 }
 
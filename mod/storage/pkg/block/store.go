@@ -21,18 +21,74 @@
 package block
 
 import (
+	"context"
+	"encoding/binary"
 	"fmt"
+	"sync"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	lru "github.com/hashicorp/golang-lru/v2"
 )
 
-// KVStore is a simple memory store based implementation that stores metadata of
-// beacon blocks.
-type KVStore[BeaconBlockT BeaconBlock] struct {
+const (
+	// blockKey is the key of the record of a slot in the range store.
+	blockKey = "block"
+	// firstSlotKey is the key of the first slot that may hold a block.
+	firstSlotKey = "firstslot"
+	// blockRootPrefix prefixes the keys mapping block roots to slots.
+	blockRootPrefix = "blockroot/"
+	// stateRootPrefix prefixes the keys mapping state roots to slots.
+	stateRootPrefix = "stateroot/"
+	// timestampPrefix prefixes the keys mapping timestamps to slots.
+	timestampPrefix = "timestamp/"
+	// recordHeaderSize is the size of the fork version and the proposer
+	// signature preceding the SSZ bytes of a blinded block in its record.
+	recordHeaderSize = 4 + len(crypto.BLSSignature{})
+	// slotSize is the size of an encoded slot.
+	slotSize = 8
+	// noBlocks is the first slot of a store that never held a block.
+	noBlocks = ^uint64(0)
+)
+
+// ErrInvalidRecord is returned when a stored value cannot be decoded.
+var ErrInvalidRecord = errors.New("invalid block store record")
+
+// KVStore is a key-value database based implementation that stores metadata
+// of beacon blocks along with the blocks themselves in blinded form, keeping
+// the last availability window of them. Full blocks are rebuilt on read by
+// fetching the bodies of their execution payloads from the execution client.
+// LRU caches sized by the availability window sit in front of the database.
+type KVStore[
+	BeaconBlockT BeaconBlock[BlindedBeaconBlockT],
+	BlindedBeaconBlockT BlindedBeaconBlock[
+		BlindedBeaconBlockT, BeaconBlockT, PayloadBodyT,
+	],
+	PayloadBodyT any,
+] struct {
+	// mu guards the database against reads racing with pruning.
+	mu sync.RWMutex
+
+	// db maps the block roots, state roots and timestamps of the stored
+	// blocks to their slot.
+	db storagedb.DB
+
+	// rangeDB holds the record of each slot: the fork version and proposer
+	// signature of the block followed by its blinded form.
+	rangeDB *filedb.RangeDB
+
+	// availabilityWindow is the number of slots kept in the store.
+	availabilityWindow uint64
+
+	// firstSlot is the first slot that may hold a block, no block is stored
+	// below it.
+	firstSlot uint64
+
 	// Beacon block root to slot mapping is injective for finalized blocks.
 	blockRoots *lru.Cache[common.Root, math.Slot]
 
@@ -44,65 +100,234 @@ type KVStore[BeaconBlockT BeaconBlock] struct {
 	// Beacon state root to slot mapping is injective for finalized blocks.
 	stateRoots *lru.Cache[common.Root, math.Slot]
 
+	// Slot to proposer signature mapping for finalized blocks.
+	signatures *lru.Cache[math.Slot, crypto.BLSSignature]
+
+	// Slot to blinded block mapping for finalized blocks. Only the header of
+	// the execution payload is kept, the execution client holds the rest.
+	blocks *lru.Cache[math.Slot, BlindedBeaconBlockT]
+
+	// eth1ChainID is the chain ID of the execution chain, used to compute
+	// execution payload headers.
+	eth1ChainID uint64
+
+	// fetcher fetches execution payload bodies to rebuild full blocks.
+	fetcher PayloadBodyFetcher[PayloadBodyT]
+
 	// Logger for the store.
 	logger log.Logger
 }
 
-// NewStore creates a new block store.
-func NewStore[BeaconBlockT BeaconBlock](
+// NewStore creates a new block store on top of the given database.
+func NewStore[
+	BeaconBlockT BeaconBlock[BlindedBeaconBlockT],
+	BlindedBeaconBlockT BlindedBeaconBlock[
+		BlindedBeaconBlockT, BeaconBlockT, PayloadBodyT,
+	],
+	PayloadBodyT any,
+](
 	logger log.Logger,
+	db storagedb.DB,
 	availabilityWindow int,
-) *KVStore[BeaconBlockT] {
+	eth1ChainID uint64,
+	fetcher PayloadBodyFetcher[PayloadBodyT],
+) (*KVStore[BeaconBlockT, BlindedBeaconBlockT, PayloadBodyT], error) {
+	if availabilityWindow <= 0 {
+		return nil, errors.New("availability window must be positive")
+	}
+	firstSlot := noBlocks
+	switch bz, err := db.Get([]byte(firstSlotKey)); {
+	case err == nil:
+		if len(bz) != slotSize {
+			return nil, errors.Wrap(ErrInvalidRecord, "first slot")
+		}
+		firstSlot = binary.BigEndian.Uint64(bz)
+	case !errors.Is(err, storagedb.ErrNotFound):
+		return nil, err
+	}
+
 	blockRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	timestamps, err := lru.New[math.U64, math.Slot](availabilityWindow)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	stateRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
-		logger:     logger,
+	signatures, err := lru.New[math.Slot, crypto.BLSSignature](
+		availabilityWindow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := lru.New[math.Slot, BlindedBeaconBlockT](availabilityWindow)
+	if err != nil {
+		return nil, err
 	}
+	return &KVStore[BeaconBlockT, BlindedBeaconBlockT, PayloadBodyT]{
+		db:      db,
+		rangeDB: filedb.NewRangeDB(db),
+		//#nosec:G115 // checked to be positive above.
+		availabilityWindow: uint64(availabilityWindow),
+		firstSlot:          firstSlot,
+		blockRoots:         blockRoots,
+		timestamps:         timestamps,
+		stateRoots:         stateRoots,
+		signatures:         signatures,
+		blocks:             blocks,
+		eth1ChainID:        eth1ChainID,
+		fetcher:            fetcher,
+		logger:             logger,
+	}, nil
 }
 
 // Set sets the block by a given index in the store, storing the block root,
-// timestamp, and state root. Only this function may potentially evict
-// entries from the store if the availability window is reached.
-func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
+// timestamp, state root, proposer signature and the blinded block. Only this
+// function prunes the blocks that fall out of the availability window.
+func (kv *KVStore[BeaconBlockT, _, _]) Set(blk BeaconBlockT) error {
+	blinded, err := blk.ToBlinded(kv.eth1ChainID)
+	if err != nil {
+		return err
+	}
+	bz, err := blinded.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	record := make([]byte, 4, recordHeaderSize+len(bz))
+	binary.BigEndian.PutUint32(record, blinded.Version())
+	signature := blk.GetSignature()
+	record = append(append(record, signature[:]...), bz...)
+
 	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
+	blockRoot := blk.HashTreeRoot()
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if slot.Unwrap() < kv.firstSlot {
+		if err = kv.setFirstSlot(slot.Unwrap()); err != nil {
+			return err
+		}
+	}
+	// The record goes first, so that pruning the slot finds the indexes
+	// even if storing them was interrupted.
+	if err = kv.rangeDB.Set(
+		slot.Unwrap(), []byte(blockKey), record,
+	); err != nil {
+		return err
+	}
+	for _, key := range [][]byte{
+		blockRootKey(blockRoot),
+		stateRootKey(blk.GetStateRoot()),
+		timestampKey(blk.GetTimestamp()),
+	} {
+		if err = kv.db.Set(key, binary.BigEndian.AppendUint64(
+			nil, slot.Unwrap(),
+		)); err != nil {
+			return err
+		}
+	}
+
+	kv.blockRoots.Add(blockRoot, slot)
 	kv.timestamps.Add(blk.GetTimestamp(), slot)
 	kv.stateRoots.Add(blk.GetStateRoot(), slot)
+	kv.signatures.Add(slot, signature)
+	kv.blocks.Add(slot, blinded)
+
+	if slot.Unwrap() < kv.availabilityWindow {
+		return nil
+	}
+	return kv.prune(slot.Unwrap() + 1 - kv.availabilityWindow)
+}
+
+// prune removes the blocks of the slots below end along with their indexes.
+func (kv *KVStore[_, _, _]) prune(end uint64) error {
+	if end <= kv.firstSlot {
+		return nil
+	}
+	for slot := kv.firstSlot; slot < end; slot++ {
+		blinded, _, err := kv.load(math.Slot(slot))
+		if errors.Is(err, storagedb.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		blockRoot := blinded.HashTreeRoot()
+		for _, key := range [][]byte{
+			blockRootKey(blockRoot),
+			stateRootKey(blinded.GetStateRoot()),
+			timestampKey(blinded.GetTimestamp()),
+		} {
+			if err = kv.db.Delete(key); err != nil {
+				return err
+			}
+		}
+		if err = kv.rangeDB.DeleteRange(slot, slot+1); err != nil {
+			return err
+		}
+
+		kv.blockRoots.Remove(blockRoot)
+		kv.timestamps.Remove(blinded.GetTimestamp())
+		kv.stateRoots.Remove(blinded.GetStateRoot())
+		kv.signatures.Remove(math.Slot(slot))
+		kv.blocks.Remove(math.Slot(slot))
+	}
+	return kv.setFirstSlot(end)
+}
+
+// setFirstSlot persists the first slot that may hold a block.
+func (kv *KVStore[_, _, _]) setFirstSlot(slot uint64) error {
+	if err := kv.db.Set(
+		[]byte(firstSlotKey), binary.BigEndian.AppendUint64(nil, slot),
+	); err != nil {
+		return err
+	}
+	kv.firstSlot = slot
 	return nil
 }
 
 // GetSlotByRoot retrieves the slot by a given block root from the store.
-func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
+func (kv *KVStore[_, _, _]) GetSlotByBlockRoot(
 	blockRoot common.Root,
 ) (math.Slot, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
 	slot, ok := kv.blockRoots.Peek(blockRoot)
-	if !ok {
+	if ok {
+		return slot, nil
+	}
+	slot, err := kv.getSlot(blockRootKey(blockRoot))
+	if errors.Is(err, storagedb.ErrNotFound) {
 		return 0, fmt.Errorf("slot not found at block root: %s", blockRoot)
+	} else if err != nil {
+		return 0, err
 	}
+	kv.blockRoots.Add(blockRoot, slot)
 	return slot, nil
 }
 
 // GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
 // the store.
-func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
+func (kv *KVStore[_, _, _]) GetParentSlotByTimestamp(
 	timestamp math.U64,
 ) (math.Slot, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
 	slot, ok := kv.timestamps.Peek(timestamp)
 	if !ok {
-		return slot, fmt.Errorf("slot not found at timestamp: %d", timestamp)
+		var err error
+		slot, err = kv.getSlot(timestampKey(timestamp))
+		if errors.Is(err, storagedb.ErrNotFound) {
+			return slot, fmt.Errorf(
+				"slot not found at timestamp: %d", timestamp,
+			)
+		} else if err != nil {
+			return slot, err
+		}
+		kv.timestamps.Add(timestamp, slot)
 	}
 	if slot == 0 {
 		return slot, errors.New("parent slot not supported for genesis slot 0")
@@ -112,12 +337,202 @@ func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
 }
 
 // GetSlotByStateRoot retrieves the slot by a given state root from the store.
-func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
+func (kv *KVStore[_, _, _]) GetSlotByStateRoot(
 	stateRoot common.Root,
 ) (math.Slot, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
 	slot, ok := kv.stateRoots.Peek(stateRoot)
-	if !ok {
+	if ok {
+		return slot, nil
+	}
+	slot, err := kv.getSlot(stateRootKey(stateRoot))
+	if errors.Is(err, storagedb.ErrNotFound) {
 		return 0, fmt.Errorf("slot not found at state root: %s", stateRoot)
+	} else if err != nil {
+		return 0, err
 	}
+	kv.stateRoots.Add(stateRoot, slot)
 	return slot, nil
 }
+
+// GetSignatureBySlot retrieves the proposer signature of the block at the
+// given slot from the store.
+func (kv *KVStore[_, _, _]) GetSignatureBySlot(
+	slot math.Slot,
+) (crypto.BLSSignature, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	sig, ok := kv.signatures.Peek(slot)
+	if ok {
+		return sig, nil
+	}
+	_, sig, err := kv.loadAndCache(slot)
+	if errors.Is(err, storagedb.ErrNotFound) {
+		return sig, fmt.Errorf("signature not found at slot: %d", slot)
+	}
+	return sig, err
+}
+
+// GetParentBlockRootBySlot retrieves the parent root of the block at the
+// given slot from the store, which is the root of the block at the previous
+// slot, the genesis block included.
+func (kv *KVStore[_, _, _]) GetParentBlockRootBySlot(
+	slot math.Slot,
+) (common.Root, error) {
+	blk, err := kv.GetBlindedBlockBySlot(slot)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return blk.GetParentBlockRoot(), nil
+}
+
+// GetBlindedBlockBySlot retrieves the blinded block at the given slot from the
+// store.
+func (kv *KVStore[_, BlindedBeaconBlockT, _]) GetBlindedBlockBySlot(
+	slot math.Slot,
+) (BlindedBeaconBlockT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	blk, ok := kv.blocks.Peek(slot)
+	if ok {
+		return blk, nil
+	}
+	blk, _, err := kv.loadAndCache(slot)
+	if errors.Is(err, storagedb.ErrNotFound) {
+		return blk, fmt.Errorf("block not found at slot: %d", slot)
+	}
+	return blk, err
+}
+
+// getSlot reads the slot stored at the key of an index.
+func (kv *KVStore[_, _, _]) getSlot(key []byte) (math.Slot, error) {
+	bz, err := kv.db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) != slotSize {
+		return 0, errors.Wrap(ErrInvalidRecord, "slot")
+	}
+	return math.Slot(binary.BigEndian.Uint64(bz)), nil
+}
+
+// loadAndCache reads the record of the slot and caches its blinded block and
+// proposer signature.
+func (kv *KVStore[_, BlindedBeaconBlockT, _]) loadAndCache(
+	slot math.Slot,
+) (BlindedBeaconBlockT, crypto.BLSSignature, error) {
+	blk, sig, err := kv.load(slot)
+	if err != nil {
+		return blk, sig, err
+	}
+	kv.blocks.Add(slot, blk)
+	kv.signatures.Add(slot, sig)
+	return blk, sig, nil
+}
+
+// load reads and decodes the record of the slot.
+func (kv *KVStore[_, BlindedBeaconBlockT, _]) load(
+	slot math.Slot,
+) (BlindedBeaconBlockT, crypto.BLSSignature, error) {
+	var (
+		blk BlindedBeaconBlockT
+		sig crypto.BLSSignature
+	)
+	record, err := kv.rangeDB.Get(slot.Unwrap(), []byte(blockKey))
+	if err != nil {
+		return blk, sig, err
+	}
+	if len(record) < recordHeaderSize {
+		return blk, sig, errors.Wrapf(ErrInvalidRecord, "slot %d", slot)
+	}
+	copy(sig[:], record[4:recordHeaderSize])
+	blk, err = blk.NewFromSSZ(
+		record[recordHeaderSize:], binary.BigEndian.Uint32(record),
+	)
+	if err != nil {
+		return blk, sig, errors.Wrapf(err, "failed to decode block %d", slot)
+	}
+	return blk, sig, nil
+}
+
+// Close closes the database of the store.
+func (kv *KVStore[_, _, _]) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.db.Close()
+}
+
+// GetBlockBySlot retrieves the full block at the given slot, rebuilding its
+// execution payload from the execution client.
+func (kv *KVStore[BeaconBlockT, _, _]) GetBlockBySlot(
+	ctx context.Context,
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	blks, err := kv.GetBlocksBySlot(ctx, slot)
+	if err != nil {
+		var blk BeaconBlockT
+		return blk, err
+	}
+	return blks[0], nil
+}
+
+// GetBlocksBySlot retrieves the full blocks at the given slots, fetching the
+// bodies of their execution payloads from the execution client at once.
+func (kv *KVStore[BeaconBlockT, BlindedBeaconBlockT, _]) GetBlocksBySlot(
+	ctx context.Context,
+	slots ...math.Slot,
+) ([]BeaconBlockT, error) {
+	var (
+		blinded    = make([]BlindedBeaconBlockT, len(slots))
+		signatures = make([]crypto.BLSSignature, len(slots))
+		hashes     = make([]common.ExecutionHash, len(slots))
+		err        error
+	)
+	for i, slot := range slots {
+		if blinded[i], err = kv.GetBlindedBlockBySlot(slot); err != nil {
+			return nil, err
+		}
+		if signatures[i], err = kv.GetSignatureBySlot(slot); err != nil {
+			return nil, err
+		}
+		hashes[i] = blinded[i].GetExecutionBlockHash()
+	}
+
+	bodies, err := kv.fetcher.GetPayloadBodiesByHash(ctx, hashes)
+	if err != nil {
+		return nil, err
+	} else if len(bodies) != len(hashes) {
+		return nil, fmt.Errorf(
+			"expected %d payload bodies, got %d", len(hashes), len(bodies),
+		)
+	}
+
+	blks := make([]BeaconBlockT, len(slots))
+	for i, body := range bodies {
+		blks[i], err = blinded[i].Unblind(body, signatures[i], kv.eth1ChainID)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err, "failed to rebuild block at slot %d", slots[i],
+			)
+		}
+	}
+	return blks, nil
+}
+
+// blockRootKey returns the key mapping the block root to its slot.
+func blockRootKey(blockRoot common.Root) []byte {
+	return append([]byte(blockRootPrefix), blockRoot[:]...)
+}
+
+// stateRootKey returns the key mapping the state root to its slot.
+func stateRootKey(stateRoot common.Root) []byte {
+	return append([]byte(stateRootPrefix), stateRoot[:]...)
+}
+
+// timestampKey returns the key mapping the timestamp to its slot.
+func timestampKey(timestamp math.U64) []byte {
+	return binary.BigEndian.AppendUint64(
+		[]byte(timestampPrefix), timestamp.Unwrap(),
+	)
+}
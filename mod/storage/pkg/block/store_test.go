@@ -21,12 +21,18 @@
 package block_test
 
 import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,8 +56,154 @@ func (m MockBeaconBlock) GetStateRoot() common.Root {
 	return [32]byte{byte(m.slot)}
 }
 
+func (m MockBeaconBlock) GetSignature() crypto.BLSSignature {
+	return crypto.BLSSignature{byte(m.slot)}
+}
+
+func (m MockBeaconBlock) ToBlinded(uint64) (*MockBlindedBeaconBlock, error) {
+	return &MockBlindedBeaconBlock{slot: m.slot}, nil
+}
+
+type MockBlindedBeaconBlock struct {
+	slot math.Slot
+}
+
+func (m *MockBlindedBeaconBlock) NewFromSSZ(
+	bz []byte, forkVersion uint32,
+) (*MockBlindedBeaconBlock, error) {
+	if len(bz) != 8 || forkVersion != m.Version() {
+		return nil, errors.New("invalid encoding")
+	}
+	return &MockBlindedBeaconBlock{
+		slot: math.Slot(binary.BigEndian.Uint64(bz)),
+	}, nil
+}
+
+func (m MockBlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	return binary.BigEndian.AppendUint64(nil, m.slot.Unwrap()), nil
+}
+
+func (*MockBlindedBeaconBlock) Version() uint32 {
+	return 1
+}
+
+func (m MockBlindedBeaconBlock) HashTreeRoot() common.Root {
+	return MockBeaconBlock(m).HashTreeRoot()
+}
+
+func (m MockBlindedBeaconBlock) GetParentBlockRoot() common.Root {
+	return [32]byte{byte(m.slot - 1)}
+}
+
+func (m MockBlindedBeaconBlock) GetTimestamp() math.U64 {
+	return MockBeaconBlock(m).GetTimestamp()
+}
+
+func (m MockBlindedBeaconBlock) GetStateRoot() common.Root {
+	return MockBeaconBlock(m).GetStateRoot()
+}
+
+func (m MockBlindedBeaconBlock) GetExecutionBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{byte(m.slot)}
+}
+
+func (m MockBlindedBeaconBlock) Unblind(
+	body *MockPayloadBody,
+	signature crypto.BLSSignature,
+	_ uint64,
+) (*MockBeaconBlock, error) {
+	if body.hash != m.GetExecutionBlockHash() ||
+		signature != (crypto.BLSSignature{byte(m.slot)}) {
+		return nil, errors.New("mismatch")
+	}
+	return &MockBeaconBlock{slot: m.slot}, nil
+}
+
+type MockPayloadBody struct {
+	hash common.ExecutionHash
+}
+
+// MockPayloadBodyFetcher serves the payload bodies of all blocks but the
+// pruned ones.
+type MockPayloadBodyFetcher struct {
+	pruned map[common.ExecutionHash]bool
+	calls  int
+}
+
+func (m *MockPayloadBodyFetcher) GetPayloadBodiesByHash(
+	_ context.Context, hashes []common.ExecutionHash,
+) ([]*MockPayloadBody, error) {
+	m.calls++
+	bodies := make([]*MockPayloadBody, len(hashes))
+	for i, hash := range hashes {
+		if m.pruned[hash] {
+			return nil, errors.New("payload body is not available")
+		}
+		bodies[i] = &MockPayloadBody{hash: hash}
+	}
+	return bodies, nil
+}
+
+// memDB is an in-memory key-value store.
+type memDB struct {
+	kvs map[string][]byte
+}
+
+func newMemDB() *memDB {
+	return &memDB{kvs: make(map[string][]byte)}
+}
+
+func (db *memDB) Get(key []byte) ([]byte, error) {
+	value, ok := db.kvs[string(key)]
+	if !ok {
+		return nil, storagedb.ErrNotFound
+	}
+	return value, nil
+}
+
+func (db *memDB) Has(key []byte) (bool, error) {
+	_, ok := db.kvs[string(key)]
+	return ok, nil
+}
+
+func (db *memDB) Set(key []byte, value []byte) error {
+	db.kvs[string(key)] = value
+	return nil
+}
+
+func (db *memDB) Delete(key []byte) error {
+	delete(db.kvs, string(key))
+	return nil
+}
+
+func (db *memDB) DeletePrefix(prefix []byte) error {
+	for key := range db.kvs {
+		if strings.HasPrefix(key, string(prefix)) {
+			delete(db.kvs, key)
+		}
+	}
+	return nil
+}
+
+func (*memDB) Close() error {
+	return nil
+}
+
+func newBlockStore(
+	t *testing.T, db storagedb.DB, fetcher *MockPayloadBodyFetcher,
+) *block.KVStore[
+	*MockBeaconBlock, *MockBlindedBeaconBlock, *MockPayloadBody,
+] {
+	t.Helper()
+	blockStore, err := block.NewStore[*MockBeaconBlock](
+		noop.NewLogger[any](), db, 5, 1, fetcher,
+	)
+	require.NoError(t, err)
+	return blockStore
+}
+
 func TestBlockStore(t *testing.T) {
-	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	blockStore := newBlockStore(t, newMemDB(), &MockPayloadBodyFetcher{})
 
 	var (
 		slot math.Slot
@@ -78,6 +230,16 @@ func TestBlockStore(t *testing.T) {
 		slot, err = blockStore.GetSlotByStateRoot([32]byte{byte(i)})
 		require.NoError(t, err)
 		require.Equal(t, i, slot)
+
+		var sig crypto.BLSSignature
+		sig, err = blockStore.GetSignatureBySlot(i)
+		require.NoError(t, err)
+		require.Equal(t, crypto.BLSSignature{byte(i)}, sig)
+
+		var parentRoot common.Root
+		parentRoot, err = blockStore.GetParentBlockRootBySlot(i)
+		require.NoError(t, err)
+		require.Equal(t, common.Root{byte(i - 1)}, parentRoot)
 	}
 
 	// Try getting a slot that doesn't exist.
@@ -85,4 +247,83 @@ func TestBlockStore(t *testing.T) {
 	require.ErrorContains(t, err, "not found")
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetSignatureBySlot(2)
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetParentBlockRootBySlot(2)
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestBlockStore_GetBlocksBySlot(t *testing.T) {
+	fetcher := &MockPayloadBodyFetcher{
+		pruned: map[common.ExecutionHash]bool{{byte(3)}: true},
+	}
+	blockStore := newBlockStore(t, newMemDB(), fetcher)
+	for i := 1; i <= 7; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+
+	// The bodies of all requested blocks are fetched at once.
+	blks, err := blockStore.GetBlocksBySlot(context.Background(), 4, 5, 6)
+	require.NoError(t, err)
+	require.Len(t, blks, 3)
+	for i, blk := range blks {
+		require.Equal(t, math.Slot(i+4), blk.GetSlot())
+	}
+	require.Equal(t, 1, fetcher.calls)
+
+	blk, err := blockStore.GetBlockBySlot(context.Background(), 7)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(7), blk.GetSlot())
+
+	// Blocks out of the window are not found.
+	_, err = blockStore.GetBlockBySlot(context.Background(), 2)
+	require.ErrorContains(t, err, "not found")
+
+	// Blocks with a pruned payload cannot be rebuilt.
+	_, err = blockStore.GetBlockBySlot(context.Background(), 3)
+	require.ErrorContains(t, err, "not available")
+}
+
+func TestBlockStore_Reopen(t *testing.T) {
+	db := newMemDB()
+	blockStore := newBlockStore(t, db, &MockPayloadBodyFetcher{})
+	for i := 1; i <= 7; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+
+	// The blocks of the window are served from the database, with empty
+	// caches, once the store is reopened.
+	blockStore = newBlockStore(t, db, &MockPayloadBodyFetcher{})
+	for i := math.Slot(3); i <= 7; i++ {
+		slot, err := blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, slot)
+
+		slot, err = blockStore.GetParentSlotByTimestamp(i)
+		require.NoError(t, err)
+		require.Equal(t, i-1, slot)
+
+		slot, err = blockStore.GetSlotByStateRoot([32]byte{byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, slot)
+
+		blk, err := blockStore.GetBlockBySlot(context.Background(), i)
+		require.NoError(t, err)
+		require.Equal(t, i, blk.GetSlot())
+	}
+
+	// The pruned blocks left no key behind.
+	_, err := blockStore.GetBlockBySlot(context.Background(), 2)
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetSlotByBlockRoot([32]byte{byte(2)})
+	require.ErrorContains(t, err, "not found")
+
+	// Pruning resumes from where the previous store stopped.
+	require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: 8}))
+	_, err = blockStore.GetBlockBySlot(context.Background(), 3)
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetParentSlotByTimestamp(3)
+	require.ErrorContains(t, err, "not found")
+	// The window of 5 blocks, their 3 indexes each and the first slot.
+	require.Len(t, db.kvs, 5*4+1)
 }
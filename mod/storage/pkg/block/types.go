@@ -21,15 +21,61 @@
 package block
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // BeaconBlock is a block in the beacon chain that has a slot, block root (hash
-// tree root), timestamp, and state root.
-type BeaconBlock interface {
+// tree root), timestamp, state root and proposer signature.
+type BeaconBlock[BlindedBeaconBlockT any] interface {
 	GetSlot() math.U64
 	HashTreeRoot() common.Root
 	GetTimestamp() math.U64
 	GetStateRoot() common.Root
+	GetSignature() crypto.BLSSignature
+	// ToBlinded returns the block with its execution payload replaced by the
+	// payload header.
+	ToBlinded(eth1ChainID uint64) (BlindedBeaconBlockT, error)
+}
+
+// BlindedBeaconBlock is a beacon block that only holds the header of its
+// execution payload.
+type BlindedBeaconBlock[
+	BlindedBeaconBlockT, BeaconBlockT, PayloadBodyT any,
+] interface {
+	// NewFromSSZ decodes a blinded block of the given fork version.
+	NewFromSSZ(bz []byte, forkVersion uint32) (BlindedBeaconBlockT, error)
+	// MarshalSSZ encodes the blinded block.
+	MarshalSSZ() ([]byte, error)
+	// Version returns the fork version the blinded block is laid out for.
+	Version() uint32
+	// HashTreeRoot returns the root of the block, which is the root of the
+	// full block as well.
+	HashTreeRoot() common.Root
+	GetParentBlockRoot() common.Root
+	GetTimestamp() math.U64
+	GetStateRoot() common.Root
+	// GetExecutionBlockHash returns the hash of the execution block the
+	// block commits to.
+	GetExecutionBlockHash() common.ExecutionHash
+	// Unblind returns the full block signed by the given signature, with its
+	// execution payload rebuilt from the given payload body.
+	Unblind(
+		body PayloadBodyT,
+		signature crypto.BLSSignature,
+		eth1ChainID uint64,
+	) (BeaconBlockT, error)
+}
+
+// PayloadBodyFetcher fetches the bodies of execution payloads, i.e. their
+// transactions and withdrawals, from the execution client.
+type PayloadBodyFetcher[PayloadBodyT any] interface {
+	// GetPayloadBodiesByHash returns the bodies of the execution payloads
+	// with the given block hashes, in the same order.
+	GetPayloadBodiesByHash(
+		ctx context.Context, hashes []common.ExecutionHash,
+	) ([]PayloadBodyT, error)
 }
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// Backend is the type of a key-value backend.
+type Backend string
+
+const (
+	// BackendFile stores every key as a file of its own.
+	BackendFile Backend = "file"
+	// BackendSegment appends the keys of a slot to a segment file, which is
+	// deleted as a whole when the slot is pruned.
+	BackendSegment Backend = "segment"
+	// BackendPebbleDB stores the keys in a PebbleDB database.
+	BackendPebbleDB Backend = "pebbledb"
+	// BackendGoLevelDB stores the keys in a GoLevelDB database.
+	BackendGoLevelDB Backend = "goleveldb"
+)
+
+var (
+	// ErrUnknownBackend is returned when parsing an unknown backend.
+	ErrUnknownBackend = errors.New("unknown storage backend")
+	// ErrUnsupportedBackend is returned when a store cannot run on a
+	// backend.
+	ErrUnsupportedBackend = errors.New("unsupported storage backend")
+)
+
+// BackendFromString parses a backend from its name.
+func BackendFromString(s string) (Backend, error) {
+	switch b := Backend(s); b {
+	case BackendFile, BackendSegment, BackendPebbleDB, BackendGoLevelDB:
+		return b, nil
+	default:
+		return "", errors.Wrapf(ErrUnknownBackend, "%q", s)
+	}
+}
+
+// DepositBackendFromString parses the backend of the deposit store. Deposit
+// keys are binary, which the file backend cannot store, and carry no segment
+// prefix, so the segment backend would append every deposit to a single file
+// that pruning never reclaims.
+func DepositBackendFromString(s string) (Backend, error) {
+	b, err := BackendFromString(s)
+	if err != nil {
+		return "", err
+	}
+	if b == BackendFile || b == BackendSegment {
+		return "", errors.Wrapf(ErrUnsupportedBackend, "%q for deposits", b)
+	}
+	return b, nil
+}
+
+// Config is the configuration of the storage backends.
+type Config struct {
+	// AvailabilityBackend is the backend the blob sidecars are stored in.
+	AvailabilityBackend string `mapstructure:"availability-backend"`
+	// DepositBackend is the backend the deposits are stored in.
+	DepositBackend string `mapstructure:"deposit-backend"`
+}
+
+// DefaultConfig returns the default storage configuration, which keeps the
+// layout of earlier releases.
+func DefaultConfig() Config {
+	return Config{
+		AvailabilityBackend: string(BackendFile),
+		DepositBackend:      string(BackendPebbleDB),
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"testing"
+
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositBackendFromString(t *testing.T) {
+	for _, backend := range []storagedb.Backend{
+		storagedb.BackendPebbleDB, storagedb.BackendGoLevelDB,
+	} {
+		b, err := storagedb.DepositBackendFromString(string(backend))
+		require.NoError(t, err)
+		require.Equal(t, backend, b)
+	}
+
+	for _, backend := range []storagedb.Backend{
+		storagedb.BackendFile, storagedb.BackendSegment,
+	} {
+		_, err := storagedb.DepositBackendFromString(string(backend))
+		require.ErrorIs(t, err, storagedb.ErrUnsupportedBackend)
+	}
+
+	_, err := storagedb.DepositBackendFromString("unknown")
+	require.ErrorIs(t, err, storagedb.ErrUnknownBackend)
+}
@@ -23,9 +23,30 @@ package db
 import (
 	"path/filepath"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
+// ErrNotFound is returned by Get when the key does not exist.
+var ErrNotFound = errors.New("key not found")
+
+// DB is a key-value store the range and deposit stores run on. Backends are
+// safe for concurrent use.
+type DB interface {
+	// Get returns the value of the key, or ErrNotFound if it does not exist.
+	Get(key []byte) ([]byte, error)
+	// Has returns true if the key exists.
+	Has(key []byte) (bool, error)
+	// Set stores the value of the key, overriding any previous value.
+	Set(key []byte, value []byte) error
+	// Delete removes the key, it is a no-op if the key does not exist.
+	Delete(key []byte) error
+	// DeletePrefix removes every key starting with the prefix.
+	DeletePrefix(prefix []byte) error
+	// Close releases the resources held by the database.
+	Close() error
+}
+
 // OpenDB opens the application database using the appropriate driver.
 func OpenDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
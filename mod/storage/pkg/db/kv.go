@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import "cosmossdk.io/core/store"
+
+// Compile-time assertion of the DB interface.
+var _ DB = (*KVDB)(nil)
+
+// KVDB is a DB backed by a key-value database such as PebbleDB or GoLevelDB.
+type KVDB struct {
+	store.KVStoreWithBatch
+}
+
+// NewKVDB creates a new DB on top of the given key-value database.
+func NewKVDB(kvsb store.KVStoreWithBatch) *KVDB {
+	return &KVDB{KVStoreWithBatch: kvsb}
+}
+
+// Get returns the value of the key, or ErrNotFound if it does not exist.
+func (db *KVDB) Get(key []byte) ([]byte, error) {
+	value, err := db.KVStoreWithBatch.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, ErrNotFound
+	}
+	return value, nil
+}
+
+// DeletePrefix removes every key starting with the prefix in one batch.
+func (db *KVDB) DeletePrefix(prefix []byte) error {
+	it, err := db.Iterator(prefix, prefixEnd(prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	for ; it.Valid(); it.Next() {
+		if err = batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+	if err = it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// prefixEnd returns the first key after every key starting with the prefix,
+// or nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
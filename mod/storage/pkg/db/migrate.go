@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+// Iterable is a DB whose keys can be listed.
+type Iterable interface {
+	DB
+	// Iterate calls fn with every key in the database.
+	Iterate(fn func(key []byte) error) error
+}
+
+// Copy copies every key of src into dst and returns the number of keys
+// copied. It is used to move a store to another backend.
+func Copy(dst DB, src Iterable) (uint64, error) {
+	var copied uint64
+	err := src.Iterate(func(key []byte) error {
+		value, err := src.Get(key)
+		if err != nil {
+			return err
+		}
+		if err = dst.Set(key, value); err != nil {
+			return err
+		}
+		copied++
+		return nil
+	})
+	return copied, err
+}
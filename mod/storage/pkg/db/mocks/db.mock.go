@@ -17,6 +17,51 @@ func (_m *DB) EXPECT() *DB_Expecter {
 	return &DB_Expecter{mock: &_m.Mock}
 }
 
+// Close provides a mock function with given fields:
+func (_m *DB) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DB_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type DB_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *DB_Expecter) Close() *DB_Close_Call {
+	return &DB_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *DB_Close_Call) Run(run func()) *DB_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DB_Close_Call) Return(_a0 error) *DB_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_Close_Call) RunAndReturn(run func() error) *DB_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: key
 func (_m *DB) Delete(key []byte) error {
 	ret := _m.Called(key)
@@ -63,6 +108,52 @@ func (_c *DB_Delete_Call) RunAndReturn(run func([]byte) error) *DB_Delete_Call {
 	return _c
 }
 
+// DeletePrefix provides a mock function with given fields: prefix
+func (_m *DB) DeletePrefix(prefix []byte) error {
+	ret := _m.Called(prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePrefix")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(prefix)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DB_DeletePrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePrefix'
+type DB_DeletePrefix_Call struct {
+	*mock.Call
+}
+
+// DeletePrefix is a helper method to define mock.On call
+//   - prefix []byte
+func (_e *DB_Expecter) DeletePrefix(prefix interface{}) *DB_DeletePrefix_Call {
+	return &DB_DeletePrefix_Call{Call: _e.mock.On("DeletePrefix", prefix)}
+}
+
+func (_c *DB_DeletePrefix_Call) Run(run func(prefix []byte)) *DB_DeletePrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *DB_DeletePrefix_Call) Return(_a0 error) *DB_DeletePrefix_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_DeletePrefix_Call) RunAndReturn(run func([]byte) error) *DB_DeletePrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: key
 func (_m *DB) Get(key []byte) ([]byte, error) {
 	ret := _m.Called(key)
@@ -21,12 +21,11 @@
 package deposit
 
 import (
-	"context"
-	"errors"
+	"encoding/binary"
 	"sync"
 
-	sdkcollections "cosmossdk.io/collections"
-	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -36,23 +35,15 @@ const KeyDepositPrefix = "deposit"
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit[DepositT]] struct {
-	store sdkcollections.Map[uint64, DepositT]
+	db    db.DB
+	codec encoding.SSZValueCodec[DepositT]
 	mu    sync.RWMutex
 }
 
-// NewStore creates a new deposit store.
-func NewStore[DepositT Deposit[DepositT]](
-	kvsp store.KVStoreService,
-) *KVStore[DepositT] {
-	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+// NewStore creates a new deposit store on top of the given database.
+func NewStore[DepositT Deposit[DepositT]](db db.DB) *KVStore[DepositT] {
 	return &KVStore[DepositT]{
-		store: sdkcollections.NewMap(
-			schemaBuilder,
-			sdkcollections.NewPrefix([]byte(KeyDepositPrefix)),
-			KeyDepositPrefix,
-			sdkcollections.Uint64Key,
-			encoding.SSZValueCodec[DepositT]{},
-		),
+		db: db,
 	}
 }
 
@@ -67,13 +58,17 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	defer kv.mu.RUnlock()
 	deposits := []DepositT{}
 	for i := range numView {
-		deposit, err := kv.store.Get(context.TODO(), startIndex+i)
-		if errors.Is(err, sdkcollections.ErrNotFound) {
+		bz, err := kv.db.Get(depositKey(startIndex + i))
+		if errors.Is(err, db.ErrNotFound) {
 			return deposits, nil
 		}
 		if err != nil {
 			return deposits, err
 		}
+		deposit, err := kv.codec.Decode(bz)
+		if err != nil {
+			return deposits, err
+		}
 		deposits = append(deposits, deposit)
 	}
 	return deposits, nil
@@ -100,7 +95,11 @@ func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 
 // setDeposit sets the deposit in the store.
 func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
-	return kv.store.Set(context.TODO(), deposit.GetIndex().Unwrap(), deposit)
+	bz, err := kv.codec.Encode(deposit)
+	if err != nil {
+		return err
+	}
+	return kv.db.Set(depositKey(deposit.GetIndex().Unwrap()), bz)
 }
 
 // Prune removes the [start, end) deposits from the store.
@@ -109,14 +108,25 @@ func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 		return pruner.ErrInvalidRange
 	}
 
-	var ctx = context.TODO()
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
 	for i := range end {
-		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(ctx, start+i); err != nil {
+		if err := kv.db.Delete(depositKey(start + i)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// Close closes the underlying database.
+func (kv *KVStore[DepositT]) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.db.Close()
+}
+
+// depositKey returns the key of the deposit with the given index. It matches
+// the layout of the collections map the deposits were previously stored in.
+func depositKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(KeyDepositPrefix), index)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/segmentdb"
+	"github.com/stretchr/testify/require"
+)
+
+type MockDeposit struct {
+	index  uint64
+	amount uint64
+}
+
+func (*MockDeposit) Empty() *MockDeposit {
+	return &MockDeposit{}
+}
+
+func (d *MockDeposit) GetIndex() math.U64 {
+	return math.U64(d.index)
+}
+
+func (d *MockDeposit) MarshalSSZ() ([]byte, error) {
+	bz := binary.LittleEndian.AppendUint64(nil, d.index)
+	return binary.LittleEndian.AppendUint64(bz, d.amount), nil
+}
+
+func (d *MockDeposit) UnmarshalSSZ(bz []byte) error {
+	//nolint:mnd // two uint64s.
+	if len(bz) != 16 {
+		return errors.New("invalid deposit size")
+	}
+	d.index = binary.LittleEndian.Uint64(bz)
+	d.amount = binary.LittleEndian.Uint64(bz[8:])
+	return nil
+}
+
+func TestKVStore(t *testing.T) {
+	db := segmentdb.NewDB(segmentdb.WithRootDirectory(t.TempDir()))
+	store := deposit.NewStore[*MockDeposit](db)
+
+	for i := range uint64(6) {
+		require.NoError(t, store.EnqueueDeposit(
+			&MockDeposit{index: i, amount: 32 + i},
+		))
+	}
+
+	deposits, err := store.GetDepositsByIndex(2, 3)
+	require.NoError(t, err)
+	require.Equal(t, []*MockDeposit{
+		{index: 2, amount: 34},
+		{index: 3, amount: 35},
+		{index: 4, amount: 36},
+	}, deposits)
+
+	// Reading past the last deposit returns up to the last deposit.
+	deposits, err = store.GetDepositsByIndex(4, 10)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+
+	// Deposits keep the key layout of the previous collections map.
+	has, err := db.Has(binary.BigEndian.AppendUint64([]byte("deposit"), 5))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, store.Prune(0, 3))
+	deposits, err = store.GetDepositsByIndex(0, 10)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+	deposits, err = store.GetDepositsByIndex(3, 10)
+	require.NoError(t, err)
+	require.Len(t, deposits, 3)
+
+	require.NoError(t, store.Close())
+}
@@ -21,14 +21,20 @@
 package filedb
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	"github.com/spf13/afero"
 )
 
+// Compile-time assertion of the DB interface.
+var _ storagedb.DB = (*DB)(nil)
+
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
@@ -55,7 +61,11 @@ func NewDB(opts ...Option) *DB {
 
 // Get retrieves the value for a key.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	value, err := afero.ReadFile(db.fs, db.pathForKey(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, storagedb.ErrNotFound
+	}
+	return value, err
 }
 
 // Has returns true if the key exists in the database.
@@ -101,6 +111,44 @@ func (db *DB) Delete(key []byte) error {
 	return db.fs.RemoveAll(db.pathForKey(key))
 }
 
+// DeletePrefix removes every key starting with the prefix. Prefixes ending
+// with a slash remove the whole directory.
+func (db *DB) DeletePrefix(prefix []byte) error {
+	if bytes.HasSuffix(prefix, []byte("/")) {
+		return db.fs.RemoveAll(string(prefix))
+	}
+	return db.Iterate(func(key []byte) error {
+		if !bytes.HasPrefix(key, prefix) {
+			return nil
+		}
+		return db.Delete(key)
+	})
+}
+
+// Iterate calls fn with every key in the database.
+func (db *DB) Iterate(fn func(key []byte) error) error {
+	suffix := "." + db.extension
+	return afero.Walk(db.fs, ".", func(
+		path string, info os.FileInfo, err error,
+	) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, suffix) {
+			return nil
+		}
+		return fn([]byte(filepath.ToSlash(strings.TrimSuffix(path, suffix))))
+	})
+}
+
+// Close is a no-op, as the files are closed once written.
+func (*DB) Close() error {
+	return nil
+}
+
 // pathForKey returns the path for a key.
 // TODO: for efficient storage we should expand this path
 func (db *DB) pathForKey(key []byte) string {
@@ -25,7 +25,9 @@ import (
 
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/errors"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/segmentdb"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
@@ -293,3 +295,30 @@ func TestDB_Set_MkDirError(t *testing.T) {
 		}
 	})
 }
+
+func TestDB_DeletePrefixAndCopy(t *testing.T) {
+	db := file.NewDB(
+		file.WithRootDirectory(t.TempDir()),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	)
+	for _, key := range []string{"1/a", "1/b", "2/a", "2/b", "3/a"} {
+		require.NoError(t, db.Set([]byte(key), []byte("value-"+key)))
+	}
+
+	require.NoError(t, db.DeletePrefix([]byte("1/")))
+	require.NoError(t, db.DeletePrefix([]byte("2/a")))
+	_, err := db.Get([]byte("1/a"))
+	require.ErrorIs(t, err, storagedb.ErrNotFound)
+
+	dst := segmentdb.NewDB(segmentdb.WithRootDirectory(t.TempDir()))
+	copied, err := storagedb.Copy(dst, db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), copied)
+	for _, key := range []string{"2/b", "3/a"} {
+		value, err := dst.Get([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-"+key), value)
+	}
+}
@@ -27,7 +27,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
-	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
+	db "github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
@@ -86,19 +86,16 @@ func (db *RangeDB) Delete(index uint64, key []byte) error {
 }
 
 // DeleteRange removes all values associated with the given index from the
-// filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
+// underlying database. It is INCLUSIVE of the `from` index and EXCLUSIVE of
 // the `to“ index.
 func (db *RangeDB) DeleteRange(from, to uint64) error {
-	f, ok := db.DB.(*DB)
-	if !ok {
-		return errors.New("rangedb: delete range not supported for this db")
-	}
 	if from > to {
 		return pruner.ErrInvalidRange
 	}
 	for ; from < to; from++ {
-		path := strconv.FormatUint(from, 10) + "/"
-		if err := f.fs.RemoveAll(path); err != nil {
+		if err := db.DB.DeletePrefix(
+			[]byte(strconv.FormatUint(from, 10) + "/"),
+		); err != nil {
 			return err
 		}
 	}
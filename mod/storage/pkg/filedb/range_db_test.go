@@ -26,10 +26,9 @@ import (
 
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db/mocks"
 	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/interfaces/mocks"
 	"github.com/spf13/afero"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -194,13 +193,13 @@ func TestExtractIndex(t *testing.T) {
 
 // =========================== PRUNING =====================================
 
-func TestRangeDB_DeleteRange_NotSupported(t *testing.T) {
+func TestRangeDB_DeleteRange_BackendError(t *testing.T) {
 	tests := []struct {
 		name string
 		db   *mocks.DB
 	}{
 		{
-			name: "DeleteRangeNotSupported",
+			name: "DeletePrefixError",
 			db:   new(mocks.DB),
 		},
 	}
@@ -208,16 +207,17 @@ func TestRangeDB_DeleteRange_NotSupported(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Helper()
-			tt.db.On("DeleteRange", mock.Anything, mock.Anything).
-				Return(errors.New("rangedb: delete range not supported for this db"))
+			tt.db.On("DeletePrefix", []byte("1/")).
+				Return(errors.New("rangedb: delete prefix failed"))
 
 			rdb := file.NewRangeDB(tt.db)
 
 			err := rdb.DeleteRange(1, 4)
 			require.Error(t, err)
 			require.Equal(t,
-				"rangedb: delete range not supported for this db",
+				"rangedb: delete prefix failed",
 				err.Error())
+			tt.db.AssertNumberOfCalls(t, "DeletePrefix", 1)
 		})
 	}
 }
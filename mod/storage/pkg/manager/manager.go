@@ -27,14 +27,17 @@ package manager
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
-// DBManager is a manager for all pruners.
+// DBManager is a manager for all pruners and the databases they prune.
 type DBManager struct {
 	pruners []pruner.Pruner[pruner.Prunable]
+	closers []io.Closer
 	logger  log.Logger
 }
 
@@ -48,6 +51,12 @@ func NewDBManager(
 	}, nil
 }
 
+// RegisterClosers registers databases to be closed once all pruners have
+// stopped.
+func (m *DBManager) RegisterClosers(closers ...io.Closer) {
+	m.closers = append(m.closers, closers...)
+}
+
 // Name returns the name of the Basic Service.
 func (m *DBManager) Name() string {
 	return "db-manager"
@@ -60,3 +69,20 @@ func (m *DBManager) Start(ctx context.Context) error {
 	}
 	return nil
 }
+
+// Stop waits for all pruners to finish their in-flight prunes, then closes
+// the registered databases.
+func (m *DBManager) Stop(ctx context.Context) error {
+	var errs []error
+	for _, pruner := range m.pruners {
+		if err := pruner.Stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, closer := range m.closers {
+		if err := closer.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
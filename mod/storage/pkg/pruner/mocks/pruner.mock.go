@@ -100,6 +100,52 @@ func (_c *Pruner_Start_Call[PrunableT]) RunAndReturn(run func(context.Context))
 	return _c
 }
 
+// Stop provides a mock function with given fields: ctx
+func (_m *Pruner[PrunableT]) Stop(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pruner_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type Pruner_Stop_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Pruner_Expecter[PrunableT]) Stop(ctx interface{}) *Pruner_Stop_Call[PrunableT] {
+	return &Pruner_Stop_Call[PrunableT]{Call: _e.mock.On("Stop", ctx)}
+}
+
+func (_c *Pruner_Stop_Call[PrunableT]) Run(run func(ctx context.Context)) *Pruner_Stop_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Pruner_Stop_Call[PrunableT]) Return(_a0 error) *Pruner_Stop_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Stop_Call[PrunableT]) RunAndReturn(run func(context.Context) error) *Pruner_Stop_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPruner creates a new instance of Pruner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPruner[PrunableT pruner.Prunable](t interface {
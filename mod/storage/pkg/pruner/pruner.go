@@ -47,6 +47,8 @@ type pruner[
 	name                    string
 	subBeaconBlockFinalized chan async.Event[BeaconBlockT]
 	pruneRangeFn            func(async.Event[BeaconBlockT]) (uint64, uint64)
+	// stopped is closed once the listener has exited.
+	stopped chan struct{}
 }
 
 // NewPruner creates a new Pruner.
@@ -66,6 +68,7 @@ func NewPruner[
 		name:                    name,
 		pruneRangeFn:            pruneRangeFn,
 		subBeaconBlockFinalized: subBeaconBlockFinalized,
+		stopped:                 make(chan struct{}),
 	}
 }
 
@@ -77,16 +80,31 @@ func (p *pruner[BeaconBlockT, PrunableT]) Start(ctx context.Context) {
 // listen listens for new finalized blocks and prunes the prunable store based
 // on the received finalized block event.
 func (p *pruner[_, PrunableT]) listen(ctx context.Context) {
+	defer close(p.stopped)
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-p.subBeaconBlockFinalized:
+		case event, ok := <-p.subBeaconBlockFinalized:
+			if !ok {
+				return
+			}
 			p.onFinalizeBlock(event)
 		}
 	}
 }
 
+// Stop waits for the listener to exit, which happens once the context passed
+// to Start is done and any in-flight prune has completed.
+func (p *pruner[_, _]) Stop(ctx context.Context) error {
+	select {
+	case <-p.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // onFinalizeBlock will prune the prunable store based on the received
 // finalized block event.
 func (p *pruner[BeaconBlockT, PrunableT]) onFinalizeBlock(
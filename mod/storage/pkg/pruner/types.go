@@ -59,4 +59,7 @@ type Prunable interface {
 type Pruner[PrunableT Prunable] interface {
 	Name() string
 	Start(ctx context.Context)
+	// Stop blocks until the in-flight prune, if any, has completed or the
+	// context is done.
+	Stop(ctx context.Context) error
 }
@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package segmentdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/spf13/afero"
+)
+
+const (
+	// extension is the file extension of the segment files.
+	extension = ".seg"
+	// unsegmented is the name of the segment of the keys without a valid
+	// segment prefix. It cannot collide with a segment name.
+	unsegmented = "_"
+	// headerSize is the size of the header of a record, holding the length
+	// of its key and of its value.
+	headerSize = 8
+	// tombstone is the value length of the records deleting their key.
+	tombstone = math.MaxUint32
+)
+
+// Compile-time assertion of the DB interface.
+var _ storagedb.DB = (*DB)(nil)
+
+// DB is a key-value store that appends the keys of a segment to a file of
+// its own. The segment of a key is the alphanumeric part before its first
+// slash, e.g. the index of the keys of a RangeDB, so deleting the prefix of
+// a segment removes a single file instead of one file per key.
+//
+// Segment files are append-only: overwritten and deleted values are only
+// reclaimed once the whole segment is deleted, which suits the write-once
+// blobs of a slot.
+type DB struct {
+	mu       sync.Mutex
+	fs       afero.Fs
+	rootDir  string
+	dirPerms os.FileMode
+	// segments caches the index of the segments read so far.
+	segments map[string]*segment
+}
+
+// segment is the index of a segment file.
+type segment struct {
+	// entries maps the keys of the segment to the location of their value.
+	entries map[string]entry
+	// size is the length of the segment file.
+	size int64
+}
+
+// entry is the location of a value in a segment file.
+type entry struct {
+	offset int64
+	length uint32
+}
+
+// NewDB creates a new instance of the DB.
+func NewDB(opts ...Option) *DB {
+	db := &DB{
+		dirPerms: os.ModePerm,
+		segments: make(map[string]*segment),
+	}
+	for _, opt := range opts {
+		if err := opt(db); err != nil {
+			panic(errors.Wrap(err, "failed to apply option"))
+		}
+	}
+
+	if db.fs == nil {
+		db.fs = afero.NewBasePathFs(afero.NewOsFs(), db.rootDir)
+	}
+	return db
+}
+
+// Get returns the value of the key, or ErrNotFound if it does not exist.
+func (db *DB) Get(key []byte) ([]byte, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	name := segmentName(key)
+	seg, err := db.load(name)
+	if err != nil {
+		return nil, err
+	}
+	e, ok := seg.entries[string(key)]
+	if !ok {
+		return nil, storagedb.ErrNotFound
+	}
+
+	file, err := db.fs.Open(fileName(name))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	value := make([]byte, e.length)
+	if _, err = file.ReadAt(value, e.offset); err != nil {
+		return nil, errors.Wrap(err, "failed to read segment")
+	}
+	return value, nil
+}
+
+// Has returns true if the key exists in the database.
+func (db *DB) Has(key []byte) (bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	seg, err := db.load(segmentName(key))
+	if err != nil {
+		return false, err
+	}
+	_, ok := seg.entries[string(key)]
+	return ok, nil
+}
+
+// Set appends the value of the key to its segment.
+func (db *DB) Set(key []byte, value []byte) error {
+	if len(key) >= tombstone || len(value) >= tombstone {
+		return errors.New("segmentdb: key or value too large")
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	name := segmentName(key)
+	seg, err := db.load(name)
+	if err != nil {
+		return err
+	}
+	//#nosec:G115 // checked above.
+	return db.append(name, seg, key, value, uint32(len(value)))
+}
+
+// Delete removes the key. The segment file is removed once all of its keys
+// are deleted.
+func (db *DB) Delete(key []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	name := segmentName(key)
+	seg, err := db.load(name)
+	if err != nil {
+		return err
+	}
+	return db.delete(name, seg, key)
+}
+
+// DeletePrefix removes every key starting with the prefix. Prefixes made of
+// a segment name and a slash remove the segment file.
+func (db *DB) DeletePrefix(prefix []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if name, ok := bytes.CutSuffix(prefix, []byte("/")); ok &&
+		isSegmentName(name) {
+		return db.removeSegment(string(name))
+	}
+
+	infos, err := afero.ReadDir(db.fs, ".")
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		name, ok := strings.CutSuffix(info.Name(), extension)
+		if info.IsDir() || !ok {
+			continue
+		}
+		seg, err := db.load(name)
+		if err != nil {
+			return err
+		}
+		for key := range seg.entries {
+			if !strings.HasPrefix(key, string(prefix)) {
+				continue
+			}
+			if err = db.delete(name, seg, []byte(key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close drops the cached segment indexes.
+func (db *DB) Close() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.segments = make(map[string]*segment)
+	return nil
+}
+
+// load returns the index of the segment, reading it from its file on first
+// use. A record left incomplete by a crash is truncated.
+func (db *DB) load(name string) (*segment, error) {
+	if seg, ok := db.segments[name]; ok {
+		return seg, nil
+	}
+
+	seg := &segment{entries: make(map[string]entry)}
+	bz, err := afero.ReadFile(db.fs, fileName(name))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	for int64(len(bz))-seg.size >= headerSize {
+		header := bz[seg.size : seg.size+headerSize]
+		keyLen := int64(binary.BigEndian.Uint32(header))
+		valueLen := binary.BigEndian.Uint32(header[4:])
+		recordLen := headerSize + keyLen
+		if valueLen != tombstone {
+			recordLen += int64(valueLen)
+		}
+		if int64(len(bz))-seg.size < recordLen {
+			break
+		}
+
+		key := string(bz[seg.size+headerSize : seg.size+headerSize+keyLen])
+		if valueLen == tombstone {
+			delete(seg.entries, key)
+		} else {
+			seg.entries[key] = entry{
+				offset: seg.size + headerSize + keyLen,
+				length: valueLen,
+			}
+		}
+		seg.size += recordLen
+	}
+	if seg.size < int64(len(bz)) {
+		if err = db.truncate(name, seg.size); err != nil {
+			return nil, errors.Wrap(err, "failed to truncate segment")
+		}
+	}
+
+	db.segments[name] = seg
+	return seg, nil
+}
+
+// truncate truncates the segment file to the given size.
+func (db *DB) truncate(name string, size int64) error {
+	file, err := db.fs.OpenFile(fileName(name), os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return file.Truncate(size)
+}
+
+// append writes a record to the segment file and indexes it. Records with a
+// tombstone value length delete their key.
+func (db *DB) append(
+	name string, seg *segment, key, value []byte, valueLen uint32,
+) error {
+	if err := db.fs.MkdirAll(".", db.dirPerms); err != nil {
+		return err
+	}
+	file, err := db.fs.OpenFile(
+		fileName(name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to open segment")
+	}
+	defer file.Close()
+
+	record := make([]byte, headerSize, headerSize+len(key)+len(value))
+	//#nosec:G115 // keys are checked to fit in a uint32.
+	binary.BigEndian.PutUint32(record, uint32(len(key)))
+	binary.BigEndian.PutUint32(record[4:], valueLen)
+	record = append(append(record, key...), value...)
+	if _, err = file.Write(record); err != nil {
+		// Drop the index so that the partial record is truncated on reload.
+		delete(db.segments, name)
+		return errors.Wrap(err, "failed to write to segment")
+	}
+
+	if valueLen == tombstone {
+		delete(seg.entries, string(key))
+	} else {
+		seg.entries[string(key)] = entry{
+			offset: seg.size + headerSize + int64(len(key)),
+			length: valueLen,
+		}
+	}
+	seg.size += int64(len(record))
+	return nil
+}
+
+// delete removes the key from the segment, removing the segment file once
+// it holds no key.
+func (db *DB) delete(name string, seg *segment, key []byte) error {
+	if _, ok := seg.entries[string(key)]; !ok {
+		return nil
+	}
+	if len(seg.entries) == 1 {
+		return db.removeSegment(name)
+	}
+	return db.append(name, seg, key, nil, tombstone)
+}
+
+// removeSegment removes the segment file and its index.
+func (db *DB) removeSegment(name string) error {
+	delete(db.segments, name)
+	if err := db.fs.Remove(fileName(name)); err != nil &&
+		!errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// segmentName returns the name of the segment the key is stored in.
+func segmentName(key []byte) string {
+	if name, _, ok := bytes.Cut(key, []byte("/")); ok && isSegmentName(name) {
+		return string(name)
+	}
+	return unsegmented
+}
+
+// isSegmentName returns true if the name is a non-empty alphanumeric string,
+// so it is a valid file name on every platform.
+func isSegmentName(name []byte) bool {
+	if len(name) == 0 {
+		return false
+	}
+	for _, c := range name {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' ||
+			'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// fileName returns the name of the file of the segment.
+func fileName(name string) string {
+	return name + extension
+}
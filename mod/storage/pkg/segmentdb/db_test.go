@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package segmentdb_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/segmentdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB(t *testing.T) {
+	dir := t.TempDir()
+	db := segmentdb.NewDB(segmentdb.WithRootDirectory(dir))
+
+	require.NoError(t, db.Set([]byte("1/a"), []byte("value1a")))
+	require.NoError(t, db.Set([]byte("1/b"), []byte("value1b")))
+	require.NoError(t, db.Set([]byte("2/a"), []byte("value2a")))
+	require.NoError(t, db.Set([]byte("1/a"), []byte("overridden")))
+	require.NoError(t, db.Set([]byte("unsegmented"), []byte("value")))
+	require.NoError(t, db.Set([]byte("1/empty"), nil))
+
+	for key, value := range map[string]string{
+		"1/a":         "overridden",
+		"1/b":         "value1b",
+		"2/a":         "value2a",
+		"unsegmented": "value",
+		"1/empty":     "",
+	} {
+		got, err := db.Get([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, value, string(got), key)
+	}
+	_, err := db.Get([]byte("1/missing"))
+	require.ErrorIs(t, err, storagedb.ErrNotFound)
+
+	// Keys are stored in one file per segment.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	require.NoError(t, db.Delete([]byte("1/b")))
+	has, err := db.Has([]byte("1/b"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Deleting the last key of a segment removes its file.
+	require.NoError(t, db.Delete([]byte("2/a")))
+	require.NoFileExists(t, filepath.Join(dir, "2.seg"))
+}
+
+func TestDB_Reopen(t *testing.T) {
+	dir := t.TempDir()
+	db := segmentdb.NewDB(segmentdb.WithRootDirectory(dir))
+	require.NoError(t, db.Set([]byte("1/a"), []byte("a")))
+	require.NoError(t, db.Set([]byte("1/b"), []byte("b")))
+	require.NoError(t, db.Set([]byte("1/a"), []byte("c")))
+	require.NoError(t, db.Delete([]byte("1/b")))
+	require.NoError(t, db.Close())
+
+	// A record left incomplete by a crash is discarded.
+	path := filepath.Join(dir, "1.seg")
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = file.Write([]byte{0, 0, 0, 3, 0, 0, 0, 5, '1', '/'})
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	db = segmentdb.NewDB(segmentdb.WithRootDirectory(dir))
+	value, err := db.Get([]byte("1/a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("c"), value)
+	has, err := db.Has([]byte("1/b"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, db.Set([]byte("1/d"), []byte("d")))
+	require.NoError(t, db.Close())
+	db = segmentdb.NewDB(segmentdb.WithRootDirectory(dir))
+	value, err = db.Get([]byte("1/d"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("d"), value)
+}
+
+func TestDB_DeletePrefix(t *testing.T) {
+	dir := t.TempDir()
+	db := segmentdb.NewDB(segmentdb.WithRootDirectory(dir))
+	for _, key := range []string{"1/a", "1/b", "10/a", "2/a", "2/b", "x"} {
+		require.NoError(t, db.Set([]byte(key), []byte(key)))
+	}
+
+	require.NoError(t, db.DeletePrefix([]byte("1/")))
+	require.NoFileExists(t, filepath.Join(dir, "1.seg"))
+	require.NoError(t, db.DeletePrefix([]byte("2/a")))
+
+	for key, exists := range map[string]bool{
+		"1/a":  false,
+		"1/b":  false,
+		"10/a": true,
+		"2/a":  false,
+		"2/b":  true,
+		"x":    true,
+	} {
+		has, err := db.Has([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, exists, has, key)
+	}
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec
+
+import (
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	zcommon "github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// chainSpecForPreset returns the chain spec of the beacond base spec with the
+// parameters of the consensus-spec preset the tests were generated with.
+func chainSpecForPreset(zspec *zcommon.Spec) common.ChainSpec {
+	data := spec.BaseSpec()
+
+	// Gwei values.
+	data.MinDepositAmount = uint64(zspec.MIN_DEPOSIT_AMOUNT)
+	data.MaxEffectiveBalance = uint64(zspec.MAX_EFFECTIVE_BALANCE)
+	data.EjectionBalance = uint64(zspec.EJECTION_BALANCE)
+	data.EffectiveBalanceIncrement = uint64(zspec.EFFECTIVE_BALANCE_INCREMENT)
+
+	// Time parameters.
+	data.SlotsPerEpoch = uint64(zspec.SLOTS_PER_EPOCH)
+	data.SlotsPerHistoricalRoot = uint64(zspec.SLOTS_PER_HISTORICAL_ROOT)
+	data.MinEpochsToInactivityPenalty = uint64(
+		zspec.MIN_EPOCHS_TO_INACTIVITY_PENALTY,
+	)
+
+	// Eth1 values.
+	data.DepositContractAddress = common.ExecutionAddress(
+		zspec.DEPOSIT_CONTRACT_ADDRESS,
+	)
+	data.DepositEth1ChainID = uint64(zspec.DEPOSIT_CHAIN_ID)
+	data.MaxDepositsPerBlock = uint64(zspec.MAX_DEPOSITS)
+	data.Eth1FollowDistance = uint64(zspec.ETH1_FOLLOW_DISTANCE)
+	data.TargetSecondsPerEth1Block = uint64(zspec.SECONDS_PER_ETH1_BLOCK)
+
+	// State list lengths.
+	data.EpochsPerHistoricalVector = uint64(
+		zspec.EPOCHS_PER_HISTORICAL_VECTOR,
+	)
+	data.EpochsPerSlashingsVector = uint64(zspec.EPOCHS_PER_SLASHINGS_VECTOR)
+	data.HistoricalRootsLimit = uint64(zspec.HISTORICAL_ROOTS_LIMIT)
+	data.ValidatorRegistryLimit = uint64(zspec.VALIDATOR_REGISTRY_LIMIT)
+
+	// Rewards and penalties, as of Bellatrix.
+	data.InactivityPenaltyQuotient = uint64(
+		zspec.INACTIVITY_PENALTY_QUOTIENT_BELLATRIX,
+	)
+	data.ProportionalSlashingMultiplier = uint64(
+		zspec.PROPORTIONAL_SLASHING_MULTIPLIER_BELLATRIX,
+	)
+
+	// Capella and Deneb values.
+	data.MaxWithdrawalsPerPayload = uint64(zspec.MAX_WITHDRAWALS_PER_PAYLOAD)
+	data.MaxValidatorsPerWithdrawalsSweep = uint64(
+		zspec.MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP,
+	)
+	data.MaxBlobCommitmentsPerBlock = uint64(
+		zspec.MAX_BLOB_COMMITMENTS_PER_BLOCK,
+	)
+	data.MaxBlobsPerBlock = uint64(zspec.MAX_BLOBS_PER_BLOCK)
+	data.FieldElementsPerBlob = uint64(zspec.FIELD_ELEMENTS_PER_BLOB)
+
+	// The tests run on DenebPlus, the first fork of beacond holding the
+	// historical summaries of the Deneb states of the spec tests.
+	data.DenebPlusForkEpoch = 0
+	data.ElectraForkEpoch = math.Epoch(^uint64(0))
+
+	return chain.NewChainSpec(data)
+}
+
+// depositChainSpec is the chain spec deposits are processed with. beacond
+// verifies deposits over the active fork version, while the spec tests sign
+// them over the genesis fork version.
+type depositChainSpec struct {
+	common.ChainSpec
+	genesisForkVersion uint32
+}
+
+// depositChainSpecForPreset returns the chain spec of the preset with the
+// genesis fork version of the consensus-spec preset as active fork version.
+func depositChainSpecForPreset(
+	cs common.ChainSpec,
+	zspec *zcommon.Spec,
+) common.ChainSpec {
+	return depositChainSpec{
+		ChainSpec: cs,
+		genesisForkVersion: version.ToUint32(
+			common.Version(zspec.GENESIS_FORK_VERSION),
+		),
+	}
+}
+
+// ActiveForkVersionForEpoch returns the genesis fork version, whatever the
+// epoch.
+func (cs depositChainSpec) ActiveForkVersionForEpoch(math.Epoch) uint32 {
+	return cs.genesisForkVersion
+}
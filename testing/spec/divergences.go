@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec
+
+import "path"
+
+// Divergence is an intentional difference of beacond from the consensus
+// specification, which the spec test cases it matches are allowed to show.
+type Divergence struct {
+	// Pattern matches the IDs of the cases, as a path.Match pattern.
+	Pattern string
+	// Fields are the beacon state fields the post states are allowed to
+	// differ in. Without fields, the cases must fail altogether.
+	Fields []string
+	// Reason explains the divergence.
+	Reason string
+}
+
+// Divergences are the known divergences of beacond from the consensus
+// specification.
+//
+//nolint:gochecknoglobals // read-only allow-list.
+var Divergences = []Divergence{
+	{
+		Pattern: "*/ssz_static/AttestationData/*/*",
+		Reason:  "beacond attestation data has no source and target checkpoints",
+	},
+	{
+		Pattern: "*/ssz_static/BeaconBlockBody/*/*",
+		Reason: "beacond block bodies carry no operations besides deposits, " +
+			"the execution payload and blob KZG commitments",
+	},
+	{
+		Pattern: "*/ssz_static/BeaconBlock/*/*",
+		Reason:  "beacond block bodies differ, see BeaconBlockBody",
+	},
+	{
+		Pattern: "*/ssz_static/BeaconState/*/*",
+		Reason: "beacond states have no participation, justification, " +
+			"inactivity or sync committee fields",
+	},
+	{
+		Pattern: "minimal/ssz_static/ExecutionPayload/*/*",
+		Reason:  "beacond limits withdrawals to the mainnet preset",
+	},
+	{
+		Pattern: "*/operations/deposit/*/bad_merkle_proof",
+		Reason: "beacond takes the deposits from the deposit contract logs " +
+			"and verifies no deposit proofs",
+	},
+	{
+		Pattern: "*/operations/deposit/*/invalid_sig_*",
+		Reason: "beacond rejects deposits with invalid signatures instead of " +
+			"skipping them",
+	},
+	{
+		Pattern: "*/operations/deposit/*/top_up_*",
+		Fields:  []string{"validators", "balances"},
+		Reason: "beacond credits top ups to the effective balance rather " +
+			"than the balance",
+	},
+	{
+		Pattern: "*/operations/withdrawals/*/success_one_full_withdrawal",
+		Fields: []string{
+			"balances",
+			"next_withdrawal_index", "next_withdrawal_validator_index",
+		},
+		Reason: "beacond ends the sweep after MAX_WITHDRAWALS_PER_PAYLOAD " +
+			"validators, missing the withdrawals past them",
+	},
+	{
+		Pattern: "*/operations/withdrawals/*/success_max_partial_withdrawals",
+		Fields: []string{
+			"balances",
+			"next_withdrawal_index", "next_withdrawal_validator_index",
+		},
+		Reason: "beacond ends the sweep early, see success_one_full_withdrawal",
+	},
+	{
+		Pattern: "*/operations/withdrawals/*/success_*",
+		Fields: []string{
+			"next_withdrawal_index", "next_withdrawal_validator_index",
+		},
+		Reason: "beacond expects a withdrawal for every validator in the " +
+			"sweep, of nothing if need be, and advances the indices past them",
+	},
+	{
+		Pattern: "*/epoch_processing/rewards_and_penalties/*/*",
+		Fields:  []string{"balances"},
+		Reason:  "beacond pays no attestation rewards or penalties",
+	},
+	{
+		Pattern: "*/epoch_processing/slashings/*/*",
+		Fields:  []string{"balances"},
+		Reason: "beacond computes the slashable epoch as half of the epoch " +
+			"plus EPOCHS_PER_SLASHINGS_VECTOR",
+	},
+	{
+		Pattern: "*/epoch_processing/justification_and_finalization/*/*",
+		Reason:  "beacond finalizes through CometBFT",
+	},
+	{
+		Pattern: "*/epoch_processing/inactivity_updates/*/*",
+		Reason:  "beacond has no inactivity scores",
+	},
+	{
+		Pattern: "*/epoch_processing/participation_flag_updates/*/*",
+		Reason:  "beacond has no participation flags",
+	},
+	{
+		Pattern: "*/epoch_processing/sync_committee_updates/*/*",
+		Reason:  "beacond has no sync committees",
+	},
+	{
+		Pattern: "*/epoch_processing/effective_balance_updates/*/*",
+		Reason:  "beacond updates effective balances when processing deposits",
+	},
+	{
+		Pattern: "*/epoch_processing/registry_updates/*/*",
+		Reason:  "beacond has no activation and exit queues",
+	},
+	{
+		Pattern: "*/epoch_processing/eth1_data_reset/*/*",
+		Reason:  "beacond has no eth1 data votes",
+	},
+	{
+		Pattern: "*/sanity/slots/*/*",
+		Fields: []string{
+			"latest_block_header", "block_roots", "state_roots", "balances",
+		},
+		Reason: "beacond states have another hash tree root, and epochs " +
+			"differ as in epoch_processing",
+	},
+}
+
+// MatchDivergence returns the first divergence matching the case, or nil if
+// the case must pass as is.
+func MatchDivergence(c *Case) *Divergence {
+	for i := range Divergences {
+		if ok, _ := path.Match(Divergences[i].Pattern, c.ID()); ok {
+			return &Divergences[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package spec runs the consensus-spec tests of the Deneb fork against the
+// types and the state processor of beacond.
+//
+// The tests are read from directories laid out as the releases of
+// ethereum/consensus-spec-tests, tests/<preset>/deneb/<runner>/<handler>/
+// <suite>/<case>. The ssz_static, operations (deposit and withdrawals),
+// epoch_processing and sanity (slots) runners are supported. Divergences lists
+// the cases in which beacond intentionally departs from the specification.
+//
+// testdata only holds vectors generated by ./gen with zrnt, an independent
+// implementation of the specification, and no vectors of an upstream release:
+// only the generated cases are covered unless CONSENSUS_SPEC_TESTS_DIR points
+// to a checkout of a release.
+//
+// The state processor only exposes the steps the tests run in isolation under
+// the conformance build tag, so the package builds with it as well:
+//
+//	go test -tags conformance ./testing/spec/.
+package spec
+
+//go:generate go run ./gen -out testdata
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNotSerializable is returned when a zrnt value can not be SSZ
+	// encoded.
+	ErrNotSerializable = errors.New("value is not SSZ serializable")
+
+	// ErrUnknownHandler is returned when a test case has no handler.
+	ErrUnknownHandler = errors.New("unknown spec test handler")
+
+	// ErrUnknownPreset is returned when a test case is for a preset without
+	// a spec.
+	ErrUnknownPreset = errors.New("unknown spec test preset")
+
+	// ErrUnknownType is returned when an ssz_static test case is for a type
+	// without a counterpart in beacond.
+	ErrUnknownType = errors.New("unknown SSZ type")
+
+	// ErrUnexpectedSuccess is returned when a test case without a post
+	// state does not fail.
+	ErrUnexpectedSuccess = errors.New(
+		"expected the test case to fail but it succeeded",
+	)
+
+	// ErrRootMismatch is returned when the hash tree root of a decoded
+	// object does not match the expected root.
+	ErrRootMismatch = errors.New("hash tree root mismatch")
+
+	// ErrEncodingMismatch is returned when the SSZ encoding of a decoded
+	// object does not match the serialized bytes.
+	ErrEncodingMismatch = errors.New("SSZ encoding mismatch")
+
+	// ErrSigningUnsupported is returned when signing with the verifier of
+	// the spec tests.
+	ErrSigningUnsupported = errors.New("signing is not supported")
+
+	// ErrInvalidSignature is returned when a BLS signature does not verify.
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+
+	// ErrStateMismatch is returned when the post state does not match the
+	// expected post state.
+	ErrStateMismatch = errors.New("post state mismatch")
+)
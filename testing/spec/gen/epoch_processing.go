@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"context"
+
+	"github.com/protolambda/zrnt/eth2/beacon/altair"
+	"github.com/protolambda/zrnt/eth2/beacon/capella"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/zrnt/eth2/beacon/phase0"
+)
+
+// epochCase is an epoch_processing test case.
+type epochCase struct {
+	handler string
+	name    string
+	// slot is the slot of the pre state, the last slot of an epoch.
+	slot common.Slot
+	// prepare sets up the pre state.
+	prepare func(st *deneb.BeaconStateView) error
+}
+
+// epochProcessing writes the epoch_processing tests, running a single step
+// of the epoch processing on a state at the last slot of an epoch.
+//
+//nolint:funlen // one block per case.
+func (g *generator) epochProcessing() error {
+	var (
+		spe       = g.spec.SLOTS_PER_EPOCH
+		increment = g.spec.EFFECTIVE_BALANCE_INCREMENT
+		// The pre states are at the last slot of the second epoch.
+		slot  = 2*spe - 1
+		epoch = g.spec.SlotToEpoch(slot)
+	)
+
+	steps := map[string]func(
+		ctx context.Context, st *deneb.BeaconStateView,
+		epc *common.EpochsContext, flats []common.FlatValidator,
+	) error{
+		"rewards_and_penalties": func(
+			ctx context.Context, st *deneb.BeaconStateView,
+			epc *common.EpochsContext, flats []common.FlatValidator,
+		) error {
+			data, err := altair.ComputeEpochAttesterData(
+				ctx, g.spec, epc, flats, st,
+			)
+			if err != nil {
+				return err
+			}
+			return altair.ProcessEpochRewardsAndPenalties(
+				ctx, g.spec, epc, data, st,
+			)
+		},
+		"slashings": func(
+			ctx context.Context, st *deneb.BeaconStateView,
+			epc *common.EpochsContext, flats []common.FlatValidator,
+		) error {
+			return phase0.ProcessEpochSlashings(ctx, g.spec, epc, flats, st)
+		},
+		"effective_balance_updates": func(
+			ctx context.Context, st *deneb.BeaconStateView,
+			epc *common.EpochsContext, flats []common.FlatValidator,
+		) error {
+			return phase0.ProcessEffectiveBalanceUpdates(
+				ctx, g.spec, epc, flats, st,
+			)
+		},
+		"slashings_reset": func(
+			ctx context.Context, st *deneb.BeaconStateView,
+			epc *common.EpochsContext, _ []common.FlatValidator,
+		) error {
+			return phase0.ProcessSlashingsReset(ctx, g.spec, epc, st)
+		},
+		"randao_mixes_reset": func(
+			ctx context.Context, st *deneb.BeaconStateView,
+			epc *common.EpochsContext, _ []common.FlatValidator,
+		) error {
+			return phase0.ProcessRandaoMixesReset(ctx, g.spec, epc, st)
+		},
+		"historical_summaries_update": func(
+			ctx context.Context, st *deneb.BeaconStateView,
+			epc *common.EpochsContext, _ []common.FlatValidator,
+		) error {
+			return capella.ProcessHistoricalSummariesUpdate(
+				ctx, g.spec, epc, st,
+			)
+		},
+	}
+
+	slash := func(indices ...common.ValidatorIndex) func(
+		*deneb.BeaconStateView,
+	) error {
+		return func(st *deneb.BeaconStateView) error {
+			slashings, err := st.Slashings()
+			if err != nil {
+				return err
+			}
+			for _, idx := range indices {
+				val, err := validator(st, idx)
+				if err != nil {
+					return err
+				}
+				if err = val.MakeSlashed(); err != nil {
+					return err
+				}
+				if err = val.SetExitEpoch(epoch); err != nil {
+					return err
+				}
+				if err = val.SetWithdrawableEpoch(
+					epoch + g.spec.EPOCHS_PER_SLASHINGS_VECTOR/2,
+				); err != nil {
+					return err
+				}
+				if err = slashings.AddSlashing(
+					epoch, g.spec.MAX_EFFECTIVE_BALANCE,
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	for _, c := range []epochCase{
+		{handler: "rewards_and_penalties", name: "empty_participation"},
+		{handler: "slashings", name: "no_slashings"},
+		{handler: "slashings", name: "low_penalty", prepare: slash(1)},
+		{
+			handler: "slashings", name: "max_penalties",
+			prepare: slash(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14,
+				15, 16, 17, 18, 19, 20, 21),
+		},
+		{
+			handler: "effective_balance_updates",
+			name:    "effective_balance_hysteresis",
+			prepare: func(st *deneb.BeaconStateView) error {
+				maxBalance := g.spec.MAX_EFFECTIVE_BALANCE
+				for idx, balance := range []common.Gwei{
+					maxBalance - increment/2,
+					maxBalance - increment + 1,
+					maxBalance - 2*increment,
+					maxBalance + increment,
+					increment,
+				} {
+					if err := setBalance(
+						st, common.ValidatorIndex(idx), balance, false,
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			handler: "slashings_reset", name: "flush_slashings",
+			prepare: func(st *deneb.BeaconStateView) error {
+				slashings, err := st.Slashings()
+				if err != nil {
+					return err
+				}
+				return slashings.AddSlashing(epoch+1, 100*increment)
+			},
+		},
+		{
+			handler: "randao_mixes_reset", name: "updated_randao_mixes",
+			prepare: func(st *deneb.BeaconStateView) error {
+				mixes, err := st.RandaoMixes()
+				if err != nil {
+					return err
+				}
+				return mixes.SetRandomMix(epoch, common.Root{0x56})
+			},
+		},
+		{
+			handler: "historical_summaries_update",
+			name:    "historical_summaries_accumulator",
+			slot:    g.spec.SLOTS_PER_HISTORICAL_ROOT - 1,
+		},
+		{
+			handler: "historical_summaries_update",
+			name:    "no_update_mid_period",
+		},
+	} {
+		if c.slot == 0 {
+			c.slot = slot
+		}
+		if err := g.epochCase(c, steps[c.handler]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// epochCase writes an epoch_processing test case.
+func (g *generator) epochCase(
+	c epochCase,
+	step func(
+		ctx context.Context, st *deneb.BeaconStateView,
+		epc *common.EpochsContext, flats []common.FlatValidator,
+	) error,
+) error {
+	pre, err := g.genesisState()
+	if err != nil {
+		return err
+	}
+	if err = processSlots(g.spec, pre, c.slot); err != nil {
+		return err
+	}
+	if c.prepare != nil {
+		if err = c.prepare(pre); err != nil {
+			return err
+		}
+	}
+	return g.stateCase(
+		"epoch_processing", c.handler, c.name, pre,
+		func(st *deneb.BeaconStateView, epc *common.EpochsContext) error {
+			vals, err := st.Validators()
+			if err != nil {
+				return err
+			}
+			flats, err := common.FlattenValidators(vals)
+			if err != nil {
+				return err
+			}
+			return step(context.Background(), st, epc, flats)
+		},
+	)
+}
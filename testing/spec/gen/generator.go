@@ -0,0 +1,337 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/snappy"
+	blsu "github.com/protolambda/bls12-381-util"
+	"github.com/protolambda/zrnt/eth2/beacon"
+	"github.com/protolambda/zrnt/eth2/beacon/altair"
+	"github.com/protolambda/zrnt/eth2/beacon/bellatrix"
+	"github.com/protolambda/zrnt/eth2/beacon/capella"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/zrnt/eth2/beacon/phase0"
+	"github.com/protolambda/ztyp/codec"
+	"github.com/protolambda/ztyp/tree"
+	"gopkg.in/yaml.v3"
+)
+
+// numGenesisValidators is the number of validators in the genesis state all
+// the state tests start from.
+const numGenesisValidators = 64
+
+// generator writes the test vectors of the minimal Deneb preset.
+type generator struct {
+	spec *common.Spec
+	// root is the fork directory of the tests.
+	root string
+	// keys are the secret keys of the genesis validators.
+	keys []*blsu.SecretKey
+	// leaves are the hash tree roots of the genesis deposits.
+	leaves []common.Root
+	// genesis is the SSZ encoding of the Deneb genesis state.
+	genesis []byte
+}
+
+// newGenerator builds the genesis state the state tests start from.
+func newGenerator(spec *common.Spec, root string) (*generator, error) {
+	g := &generator{spec: spec, root: root}
+
+	deposits := make([]common.Deposit, 0, numGenesisValidators)
+	for i := range numGenesisValidators {
+		sk, err := secretKey(uint64(i) + 1)
+		if err != nil {
+			return nil, err
+		}
+		data, err := g.depositData(sk, sk, spec.MAX_EFFECTIVE_BALANCE)
+		if err != nil {
+			return nil, err
+		}
+		g.keys = append(g.keys, sk)
+		g.leaves = append(g.leaves, data.HashTreeRoot(tree.GetHashFn()))
+		deposits = append(deposits, common.Deposit{Data: *data})
+	}
+
+	st, err := genesisState(spec, deposits)
+	if err != nil {
+		return nil, err
+	}
+	g.genesis, err = encode(spec, st)
+	return g, err
+}
+
+// genesisState returns the phase0 genesis state of the deposits, upgraded to
+// Deneb.
+func genesisState(
+	spec *common.Spec,
+	deposits []common.Deposit,
+) (*deneb.BeaconStateView, error) {
+	phase0St, epc, err := phase0.GenesisFromEth1(
+		spec, common.Root{0x42}, 0, deposits, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	altairSt, err := altair.UpgradeToAltair(spec, epc, phase0St)
+	if err != nil {
+		return nil, err
+	}
+	bellatrixSt, err := bellatrix.UpgradeToBellatrix(spec, epc, altairSt)
+	if err != nil {
+		return nil, err
+	}
+	capellaSt, err := capella.UpgradeToCapella(spec, epc, bellatrixSt)
+	if err != nil {
+		return nil, err
+	}
+	return deneb.UpgradeToDeneb(spec, epc, capellaSt)
+}
+
+// depositData returns the deposit data of the key signed by the signer, with
+// BLS withdrawal credentials.
+func (g *generator) depositData(
+	sk, signer *blsu.SecretKey,
+	amount common.Gwei,
+) (*common.DepositData, error) {
+	pk, err := blsu.SkToPk(sk)
+	if err != nil {
+		return nil, err
+	}
+	data := &common.DepositData{
+		Pubkey: pk.Serialize(),
+		Amount: amount,
+	}
+	creds := sha256.Sum256(data.Pubkey[:])
+	creds[0] = common.BLS_WITHDRAWAL_PREFIX
+	data.WithdrawalCredentials = creds
+
+	// Deposits are signed over the genesis fork version.
+	root := common.ComputeSigningRoot(
+		data.MessageRoot(),
+		common.ComputeDomain(
+			common.DOMAIN_DEPOSIT, g.spec.GENESIS_FORK_VERSION, common.Root{},
+		),
+	)
+	data.Signature = blsu.Sign(signer, root[:]).Serialize()
+	return data, nil
+}
+
+// deposit returns the deposit following the genesis deposits, with its
+// proof against the deposit root of the returned eth1 data.
+func (g *generator) deposit(
+	data *common.DepositData,
+) (*common.Deposit, common.Eth1Data) {
+	leaves := append(
+		append([]common.Root{}, g.leaves...),
+		data.HashTreeRoot(tree.GetHashFn()),
+	)
+	proof, root := depositProof(leaves, uint64(len(g.leaves)))
+	return &common.Deposit{Proof: proof, Data: *data},
+		common.Eth1Data{
+			DepositRoot:  root,
+			DepositCount: common.DepositIndex(len(leaves)),
+			BlockHash:    common.Root{0x42},
+		}
+}
+
+// genesisState returns a copy of the Deneb genesis state.
+func (g *generator) genesisState() (*deneb.BeaconStateView, error) {
+	return decodeState(g.spec, g.genesis)
+}
+
+// stateCase writes a state test case. The case is run on a copy of the pre
+// state, the post state is only written if it succeeds.
+func (g *generator) stateCase(
+	runner, handler, name string,
+	pre *deneb.BeaconStateView,
+	run func(st *deneb.BeaconStateView, epc *common.EpochsContext) error,
+) error {
+	dir := filepath.Join(g.root, runner, handler, "pyspec_tests", name)
+	preBz, err := encode(g.spec, pre)
+	if err != nil {
+		return err
+	}
+	if err = writeSSZSnappy(dir, "pre", preBz); err != nil {
+		return err
+	}
+
+	post, err := decodeState(g.spec, preBz)
+	if err != nil {
+		return err
+	}
+	epc, err := common.NewEpochsContext(g.spec, post)
+	if err != nil {
+		return err
+	}
+	if err = run(post, epc); err != nil {
+		// Invalid cases have no post state.
+		return nil //nolint:nilerr // the failure is the expected outcome.
+	}
+	postBz, err := encode(g.spec, post)
+	if err != nil {
+		return err
+	}
+	return writeSSZSnappy(dir, "post", postBz)
+}
+
+// secretKey returns the BLS secret key of the scalar.
+func secretKey(scalar uint64) (*blsu.SecretKey, error) {
+	var bz [32]byte
+	binary.BigEndian.PutUint64(bz[24:], scalar)
+	sk := new(blsu.SecretKey)
+	return sk, sk.Deserialize(&bz)
+}
+
+// depositProof returns the proof of the leaf at the index of the deposit
+// tree, followed by the length mix-in, and the root of the tree.
+func depositProof(
+	leaves []common.Root,
+	index uint64,
+) (common.DepositProof, common.Root) {
+	var (
+		proof common.DepositProof
+		zero  common.Root
+		layer = leaves
+	)
+	for depth := range common.DEPOSIT_CONTRACT_TREE_DEPTH {
+		if sibling := index ^ 1; sibling < uint64(len(layer)) {
+			proof[depth] = layer[sibling]
+		} else {
+			proof[depth] = zero
+		}
+
+		next := make([]common.Root, 0, (len(layer)+1)/2)
+		for i := 0; i < len(layer); i += 2 {
+			right := zero
+			if i+1 < len(layer) {
+				right = layer[i+1]
+			}
+			next = append(next, hash(layer[i], right))
+		}
+		layer, zero, index = next, hash(zero, zero), index/2
+	}
+
+	root := zero
+	if len(layer) > 0 {
+		root = layer[0]
+	}
+	binary.LittleEndian.PutUint64(
+		proof[common.DEPOSIT_CONTRACT_TREE_DEPTH][:], uint64(len(leaves)),
+	)
+	return proof, hash(root, proof[common.DEPOSIT_CONTRACT_TREE_DEPTH])
+}
+
+// hash returns the SHA-256 hash of the concatenated roots.
+func hash(a, b common.Root) common.Root {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// decodeState decodes the SSZ encoding of a Deneb state.
+func decodeState(
+	spec *common.Spec,
+	bz []byte,
+) (*deneb.BeaconStateView, error) {
+	return deneb.AsBeaconStateView(deneb.BeaconStateType(spec).Deserialize(
+		codec.NewDecodingReader(bytes.NewReader(bz), uint64(len(bz))),
+	))
+}
+
+// encode returns the SSZ encoding of a zrnt value.
+func encode(spec *common.Spec, obj any) ([]byte, error) {
+	var (
+		buf bytes.Buffer
+		err error
+		w   = codec.NewEncodingWriter(&buf)
+	)
+	switch obj := obj.(type) {
+	case common.SpecObj:
+		err = obj.Serialize(spec, w)
+	case interface {
+		Serialize(w *codec.EncodingWriter) error
+	}:
+		err = obj.Serialize(w)
+	default:
+		return nil, fmt.Errorf("%T is not serializable", obj)
+	}
+	return buf.Bytes(), err
+}
+
+// hashTreeRoot returns the hash tree root of a zrnt value.
+func hashTreeRoot(spec *common.Spec, obj any) (common.Root, error) {
+	switch obj := obj.(type) {
+	case common.SpecObj:
+		return obj.HashTreeRoot(spec, tree.GetHashFn()), nil
+	case tree.HTR:
+		return obj.HashTreeRoot(tree.GetHashFn()), nil
+	default:
+		return common.Root{}, fmt.Errorf("%T has no hash tree root", obj)
+	}
+}
+
+// writeSSZSnappy writes the snappy compressed SSZ encoding to the file of
+// the case.
+func writeSSZSnappy(dir, name string, bz []byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(
+		filepath.Join(dir, name+".ssz_snappy"),
+		snappy.Encode(nil, bz),
+		0o600,
+	)
+}
+
+// writeYAML writes the value to the YAML file of the case.
+func writeYAML(dir, name string, v any) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	bz, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, name+".yaml"), bz, 0o600)
+}
+
+// processSlots advances the state to the slot.
+func processSlots(
+	spec *common.Spec,
+	st *deneb.BeaconStateView,
+	slot common.Slot,
+) error {
+	epc, err := common.NewEpochsContext(spec, st)
+	if err != nil {
+		return err
+	}
+	return common.ProcessSlots(
+		context.Background(), spec, epc,
+		&beacon.StandardUpgradeableBeaconState{BeaconState: st}, slot,
+	)
+}
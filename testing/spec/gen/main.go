@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Command gen writes the consensus-spec test vectors run by the conformance
+// tests. The vectors follow the layout and the encoding of the
+// ethereum/consensus-spec-tests releases and are produced with zrnt, the Go
+// implementation of the consensus specification, for the minimal preset of
+// the Deneb fork.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/protolambda/zrnt/eth2/configs"
+)
+
+func main() {
+	out := flag.String("out", "testdata", "directory to write the tests to")
+	flag.Parse()
+
+	// Every fork is active from genesis, as on a Deneb network.
+	spec := *configs.Minimal
+	spec.ALTAIR_FORK_EPOCH = 0
+	spec.BELLATRIX_FORK_EPOCH = 0
+	spec.CAPELLA_FORK_EPOCH = 0
+	spec.DENEB_FORK_EPOCH = 0
+
+	root := filepath.Join(*out, "tests", "minimal", "deneb")
+	if err := os.RemoveAll(root); err != nil {
+		log.Fatal(err)
+	}
+
+	g, err := newGenerator(&spec, root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, fn := range []func() error{
+		g.sszStatic,
+		g.depositOperations,
+		g.withdrawalsOperations,
+		g.epochProcessing,
+		g.sanitySlots,
+	} {
+		if err = fn(); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
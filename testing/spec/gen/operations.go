@@ -0,0 +1,333 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"context"
+	"path/filepath"
+
+	blsu "github.com/protolambda/bls12-381-util"
+	"github.com/protolambda/zrnt/eth2/beacon/capella"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/zrnt/eth2/beacon/phase0"
+)
+
+// depositCase is an operations/deposit test case.
+type depositCase struct {
+	name string
+	// key is the key of the depositing validator.
+	key *blsu.SecretKey
+	// signer is the key signing the deposit, the validator key if nil.
+	signer *blsu.SecretKey
+	amount common.Gwei
+	// prepare sets up the pre state.
+	prepare func(st *deneb.BeaconStateView) error
+	// badProof makes the proof of the deposit invalid.
+	badProof bool
+}
+
+// depositOperations writes the operations/deposit tests, processing a single
+// deposit on top of the genesis state.
+func (g *generator) depositOperations() error {
+	var (
+		maxBalance = g.spec.MAX_EFFECTIVE_BALANCE
+		increment  = g.spec.EFFECTIVE_BALANCE_INCREMENT
+	)
+	newKey, err := secretKey(numGenesisValidators + 1)
+	if err != nil {
+		return err
+	}
+	otherKey, err := secretKey(numGenesisValidators + 2)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range []depositCase{
+		{name: "new_deposit_max", key: newKey, amount: maxBalance},
+		{
+			name: "new_deposit_under_max", key: newKey,
+			amount: maxBalance - increment,
+		},
+		{
+			name: "new_deposit_over_max", key: newKey,
+			amount: maxBalance + increment,
+		},
+		{
+			name: "top_up__max_effective_balance", key: g.keys[0],
+			amount: increment,
+		},
+		{
+			name: "top_up__less_effective_balance", key: g.keys[0],
+			amount: increment,
+			prepare: func(st *deneb.BeaconStateView) error {
+				return setBalance(st, 0, maxBalance-2*increment, true)
+			},
+		},
+		// A new validator with an invalid signature is skipped.
+		{
+			name: "invalid_sig_new_deposit", key: newKey, signer: otherKey,
+			amount: maxBalance,
+		},
+		// A deposit with an invalid proof invalidates the block.
+		{
+			name: "bad_merkle_proof", key: newKey, amount: maxBalance,
+			badProof: true,
+		},
+	} {
+		if err = g.depositCase(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// depositCase writes a deposit test case, the eth1 data of the pre state
+// carrying the deposit root the deposit is proven against.
+func (g *generator) depositCase(c depositCase) error {
+	if c.signer == nil {
+		c.signer = c.key
+	}
+	data, err := g.depositData(c.key, c.signer, c.amount)
+	if err != nil {
+		return err
+	}
+	dep, eth1Data := g.deposit(data)
+	if c.badProof {
+		dep.Proof[0] = common.Root{0x01}
+	}
+
+	pre, err := g.genesisState()
+	if err != nil {
+		return err
+	}
+	if err = pre.SetEth1Data(eth1Data); err != nil {
+		return err
+	}
+	if c.prepare != nil {
+		if err = c.prepare(pre); err != nil {
+			return err
+		}
+	}
+
+	bz, err := encode(g.spec, dep)
+	if err != nil {
+		return err
+	}
+	if err = writeSSZSnappy(
+		filepath.Join(g.root, "operations", "deposit", "pyspec_tests", c.name),
+		"deposit", bz,
+	); err != nil {
+		return err
+	}
+	return g.stateCase(
+		"operations", "deposit", c.name, pre,
+		func(st *deneb.BeaconStateView, epc *common.EpochsContext) error {
+			return phase0.ProcessDeposit(g.spec, epc, st, dep, false)
+		},
+	)
+}
+
+// withdrawalsOperations writes the operations/withdrawals tests, processing
+// the withdrawals of an execution payload on top of the genesis state.
+func (g *generator) withdrawalsOperations() error {
+	var (
+		maxBalance = g.spec.MAX_EFFECTIVE_BALANCE
+		increment  = g.spec.EFFECTIVE_BALANCE_INCREMENT
+		partial    = func(indices ...common.ValidatorIndex) func(
+			*deneb.BeaconStateView,
+		) error {
+			return func(st *deneb.BeaconStateView) error {
+				for _, idx := range indices {
+					if err := setEth1Credentials(st, idx); err != nil {
+						return err
+					}
+					if err := setBalance(
+						st, idx, maxBalance+increment, false,
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+		}
+	)
+
+	maxPartials := make([]common.ValidatorIndex, 0)
+	for i := range g.spec.MAX_WITHDRAWALS_PER_PAYLOAD + 1 {
+		maxPartials = append(maxPartials, common.ValidatorIndex(2*i))
+	}
+
+	for _, c := range []struct {
+		name    string
+		prepare func(st *deneb.BeaconStateView) error
+		corrupt func(withdrawals []common.Withdrawal) []common.Withdrawal
+	}{
+		{name: "success_zero_expected_withdrawals"},
+		{name: "success_one_partial_withdrawal", prepare: partial(3)},
+		{
+			name: "success_one_full_withdrawal",
+			prepare: func(st *deneb.BeaconStateView) error {
+				if err := setEth1Credentials(st, 5); err != nil {
+					return err
+				}
+				return setWithdrawable(st, 5)
+			},
+		},
+		{name: "success_max_partial_withdrawals", prepare: partial(
+			maxPartials...,
+		)},
+		{
+			name:    "invalid_one_expected_partial_withdrawal_and_none_in_payload",
+			prepare: partial(3),
+			corrupt: func([]common.Withdrawal) []common.Withdrawal {
+				return nil
+			},
+		},
+		{
+			name:    "invalid_incorrect_withdrawal_amount",
+			prepare: partial(3),
+			corrupt: func(w []common.Withdrawal) []common.Withdrawal {
+				w[0].Amount++
+				return w
+			},
+		},
+	} {
+		if err := g.withdrawalsCase(c.name, c.prepare, c.corrupt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withdrawalsCase writes a withdrawals test case, the payload carrying the
+// withdrawals expected by the pre state.
+func (g *generator) withdrawalsCase(
+	name string,
+	prepare func(st *deneb.BeaconStateView) error,
+	corrupt func(withdrawals []common.Withdrawal) []common.Withdrawal,
+) error {
+	pre, err := g.genesisState()
+	if err != nil {
+		return err
+	}
+	if prepare != nil {
+		if err = prepare(pre); err != nil {
+			return err
+		}
+	}
+
+	withdrawals, err := capella.GetExpectedWithdrawals(pre, g.spec)
+	if err != nil {
+		return err
+	}
+	if corrupt != nil {
+		withdrawals = corrupt(withdrawals)
+	}
+	payload := &deneb.ExecutionPayload{Withdrawals: withdrawals}
+
+	bz, err := encode(g.spec, payload)
+	if err != nil {
+		return err
+	}
+	if err = writeSSZSnappy(
+		filepath.Join(
+			g.root, "operations", "withdrawals", "pyspec_tests", name,
+		),
+		"execution_payload", bz,
+	); err != nil {
+		return err
+	}
+	return g.stateCase(
+		"operations", "withdrawals", name, pre,
+		func(st *deneb.BeaconStateView, _ *common.EpochsContext) error {
+			return capella.ProcessWithdrawals(
+				context.Background(), g.spec, st, payload,
+			)
+		},
+	)
+}
+
+// setBalance sets the balance of the validator, and its effective balance
+// if requested.
+func setBalance(
+	st *deneb.BeaconStateView,
+	idx common.ValidatorIndex,
+	balance common.Gwei,
+	effective bool,
+) error {
+	balances, err := st.Balances()
+	if err != nil {
+		return err
+	}
+	if err = balances.SetBalance(idx, balance); err != nil {
+		return err
+	}
+	if !effective {
+		return nil
+	}
+	val, err := validator(st, idx)
+	if err != nil {
+		return err
+	}
+	return val.SetEffectiveBalance(balance)
+}
+
+// setEth1Credentials gives the validator eth1 withdrawal credentials.
+func setEth1Credentials(
+	st *deneb.BeaconStateView,
+	idx common.ValidatorIndex,
+) error {
+	val, err := validator(st, idx)
+	if err != nil {
+		return err
+	}
+	creds := common.Root{common.ETH1_ADDRESS_WITHDRAWAL_PREFIX}
+	creds[31] = byte(idx) + 1
+	return val.SetWithdrawalCredentials(creds)
+}
+
+// setWithdrawable exits the validator and makes it withdrawable at genesis.
+func setWithdrawable(
+	st *deneb.BeaconStateView,
+	idx common.ValidatorIndex,
+) error {
+	val, err := validator(st, idx)
+	if err != nil {
+		return err
+	}
+	if err = val.SetExitEpoch(common.GENESIS_EPOCH); err != nil {
+		return err
+	}
+	return val.SetWithdrawableEpoch(common.GENESIS_EPOCH)
+}
+
+// validator returns the validator at the index of the state.
+func validator(
+	st *deneb.BeaconStateView,
+	idx common.ValidatorIndex,
+) (common.Validator, error) {
+	vals, err := st.Validators()
+	if err != nil {
+		return nil, err
+	}
+	return vals.Validator(idx)
+}
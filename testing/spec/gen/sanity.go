@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"path/filepath"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+)
+
+// sanitySlots writes the sanity/slots tests, advancing the genesis state
+// through empty slots.
+func (g *generator) sanitySlots() error {
+	spe := g.spec.SLOTS_PER_EPOCH
+	for _, c := range []struct {
+		name  string
+		start common.Slot
+		slots common.Slot
+	}{
+		{name: "slots_1", slots: 1},
+		{name: "slots_2", slots: 2},
+		{name: "empty_epoch", slots: spe},
+		{name: "double_empty_epoch", slots: 2 * spe},
+		{name: "over_epoch_boundary", start: spe / 2, slots: spe},
+	} {
+		pre, err := g.genesisState()
+		if err != nil {
+			return err
+		}
+		if c.start != 0 {
+			if err = processSlots(g.spec, pre, c.start); err != nil {
+				return err
+			}
+		}
+		if err = writeYAML(
+			filepath.Join(
+				g.root, "sanity", "slots", "pyspec_tests", c.name,
+			),
+			"slots", uint64(c.slots),
+		); err != nil {
+			return err
+		}
+		if err = g.stateCase(
+			"sanity", "slots", c.name, pre,
+			func(st *deneb.BeaconStateView, _ *common.EpochsContext) error {
+				return processSlots(g.spec, st, c.start+c.slots)
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+	"path/filepath"
+	"strconv"
+
+	"github.com/holiman/uint256"
+	"github.com/protolambda/zrnt/eth2/beacon/altair"
+	"github.com/protolambda/zrnt/eth2/beacon/capella"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/zrnt/eth2/beacon/phase0"
+	"github.com/protolambda/ztyp/view"
+)
+
+// numRandomCases is the number of random cases written per SSZ type.
+const numRandomCases = 4
+
+// sszStatic writes the ssz_static tests of the SSZ types, as random values
+// of the type together with their hash tree root.
+func (g *generator) sszStatic() error {
+	types := map[string]func(r *random) any{
+		"Fork": func(r *random) any {
+			return &common.Fork{
+				PreviousVersion: r.version(),
+				CurrentVersion:  r.version(),
+				Epoch:           common.Epoch(r.Uint64()),
+			}
+		},
+		"ForkData": func(r *random) any {
+			return &common.ForkData{
+				CurrentVersion:        r.version(),
+				GenesisValidatorsRoot: r.root(),
+			}
+		},
+		"BeaconBlockHeader": func(r *random) any {
+			return r.header()
+		},
+		"Eth1Data": func(r *random) any {
+			data := r.eth1Data()
+			return &data
+		},
+		"Validator": func(r *random) any {
+			return &phase0.Validator{
+				Pubkey:                     r.pubkey(),
+				WithdrawalCredentials:      r.root(),
+				EffectiveBalance:           common.Gwei(r.Uint64()),
+				Slashed:                    r.IntN(2) == 1,
+				ActivationEligibilityEpoch: common.Epoch(r.Uint64()),
+				ActivationEpoch:            common.Epoch(r.Uint64()),
+				ExitEpoch:                  common.Epoch(r.Uint64()),
+				WithdrawableEpoch:          common.Epoch(r.Uint64()),
+			}
+		},
+		"DepositMessage": func(r *random) any {
+			return &common.DepositMessage{
+				Pubkey:                r.pubkey(),
+				WithdrawalCredentials: r.root(),
+				Amount:                common.Gwei(r.Uint64()),
+			}
+		},
+		"SigningData": func(r *random) any {
+			return &common.SigningData{
+				ObjectRoot: r.root(),
+				Domain:     common.BLSDomain(r.root()),
+			}
+		},
+		"AttestationData": func(r *random) any {
+			return &phase0.AttestationData{
+				Slot:            common.Slot(r.Uint64()),
+				Index:           common.CommitteeIndex(r.Uint64()),
+				BeaconBlockRoot: r.root(),
+				Source: common.Checkpoint{
+					Epoch: common.Epoch(r.Uint64()), Root: r.root(),
+				},
+				Target: common.Checkpoint{
+					Epoch: common.Epoch(r.Uint64()), Root: r.root(),
+				},
+			}
+		},
+		"Withdrawal": func(r *random) any {
+			withdrawal := r.withdrawal()
+			return &withdrawal
+		},
+		"HistoricalSummary": func(r *random) any {
+			return &capella.HistoricalSummary{
+				BlockSummaryRoot: r.root(),
+				StateSummaryRoot: r.root(),
+			}
+		},
+		"ExecutionPayload": func(r *random) any {
+			return r.payload()
+		},
+		"ExecutionPayloadHeader": func(r *random) any {
+			return r.payload().Header(g.spec)
+		},
+		"BeaconBlockBody": func(r *random) any {
+			return r.body(g.spec)
+		},
+		"BeaconBlock": func(r *random) any {
+			header := r.header()
+			return &deneb.BeaconBlock{
+				Slot:          header.Slot,
+				ProposerIndex: header.ProposerIndex,
+				ParentRoot:    header.ParentRoot,
+				StateRoot:     header.StateRoot,
+				Body:          *r.body(g.spec),
+			}
+		},
+	}
+
+	for name, fn := range types {
+		seed := fnv.New64a()
+		_, _ = seed.Write([]byte(name))
+		r := &random{rand.New(rand.NewPCG(seed.Sum64(), 0))}
+		for i := range numRandomCases {
+			if err := g.sszStaticCase(
+				name, "ssz_random", i, fn(r),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The beacon state is the genesis state and the state it advances to.
+	st, err := g.genesisState()
+	if err != nil {
+		return err
+	}
+	if err = g.sszStaticCase("BeaconState", "ssz_random", 0, st); err != nil {
+		return err
+	}
+	if err = processSlots(
+		g.spec, st, 2*g.spec.SLOTS_PER_EPOCH+1,
+	); err != nil {
+		return err
+	}
+	return g.sszStaticCase("BeaconState", "ssz_random", 1, st)
+}
+
+// sszStaticCase writes the SSZ encoding and the hash tree root of the value.
+func (g *generator) sszStaticCase(
+	typ, suite string,
+	i int,
+	value any,
+) error {
+	dir := filepath.Join(
+		g.root, "ssz_static", typ, suite, "case_"+strconv.Itoa(i),
+	)
+	bz, err := encode(g.spec, value)
+	if err != nil {
+		return err
+	}
+	root, err := hashTreeRoot(g.spec, value)
+	if err != nil {
+		return err
+	}
+	if err = writeSSZSnappy(dir, "serialized", bz); err != nil {
+		return err
+	}
+	return writeYAML(dir, "roots", map[string]string{"root": root.String()})
+}
+
+// random generates random values of the SSZ types.
+type random struct {
+	*rand.Rand
+}
+
+// bytes returns n random bytes.
+func (r *random) bytes(n int) []byte {
+	bz := make([]byte, n)
+	for i := range bz {
+		bz[i] = byte(r.UintN(256))
+	}
+	return bz
+}
+
+func (r *random) root() (root common.Root) {
+	copy(root[:], r.bytes(len(root)))
+	return root
+}
+
+func (r *random) version() (v common.Version) {
+	copy(v[:], r.bytes(len(v)))
+	return v
+}
+
+func (r *random) pubkey() (pk common.BLSPubkey) {
+	copy(pk[:], r.bytes(len(pk)))
+	return pk
+}
+
+func (r *random) signature() (sig common.BLSSignature) {
+	copy(sig[:], r.bytes(len(sig)))
+	return sig
+}
+
+func (r *random) address() (addr common.Eth1Address) {
+	copy(addr[:], r.bytes(len(addr)))
+	return addr
+}
+
+func (r *random) header() *common.BeaconBlockHeader {
+	return &common.BeaconBlockHeader{
+		Slot:          common.Slot(r.Uint64()),
+		ProposerIndex: common.ValidatorIndex(r.Uint64()),
+		ParentRoot:    r.root(),
+		StateRoot:     r.root(),
+		BodyRoot:      r.root(),
+	}
+}
+
+func (r *random) eth1Data() common.Eth1Data {
+	return common.Eth1Data{
+		DepositRoot:  r.root(),
+		DepositCount: common.DepositIndex(r.Uint64()),
+		BlockHash:    r.root(),
+	}
+}
+
+func (r *random) withdrawal() common.Withdrawal {
+	return common.Withdrawal{
+		Index:          common.WithdrawalIndex(r.Uint64()),
+		ValidatorIndex: common.ValidatorIndex(r.Uint64()),
+		Address:        r.address(),
+		Amount:         common.Gwei(r.Uint64()),
+	}
+}
+
+func (r *random) payload() *deneb.ExecutionPayload {
+	payload := &deneb.ExecutionPayload{
+		ParentHash:    r.root(),
+		FeeRecipient:  r.address(),
+		StateRoot:     r.root(),
+		ReceiptsRoot:  r.root(),
+		PrevRandao:    r.root(),
+		BlockNumber:   view.Uint64View(r.Uint64()),
+		GasLimit:      view.Uint64View(r.Uint64()),
+		GasUsed:       view.Uint64View(r.Uint64()),
+		Timestamp:     common.Timestamp(r.Uint64()),
+		ExtraData:     r.bytes(r.IntN(33)),
+		BlockHash:     r.root(),
+		BlobGasUsed:   view.Uint64View(r.Uint64()),
+		ExcessBlobGas: view.Uint64View(r.Uint64()),
+	}
+	copy(payload.LogsBloom[:], r.bytes(len(payload.LogsBloom)))
+	(*uint256.Int)(&payload.BaseFeePerGas).SetUint64(r.Uint64())
+	for range r.IntN(4) {
+		payload.Transactions = append(
+			payload.Transactions, r.bytes(1+r.IntN(64)),
+		)
+	}
+	for range r.IntN(4) {
+		payload.Withdrawals = append(payload.Withdrawals, r.withdrawal())
+	}
+	return payload
+}
+
+func (r *random) body(spec *common.Spec) *deneb.BeaconBlockBody {
+	body := &deneb.BeaconBlockBody{
+		RandaoReveal: r.signature(),
+		Eth1Data:     r.eth1Data(),
+		Graffiti:     r.root(),
+		SyncAggregate: altair.SyncAggregate{
+			SyncCommitteeBits: altair.SyncCommitteeBits(
+				make([]byte, spec.SYNC_COMMITTEE_SIZE/8),
+			),
+		},
+		ExecutionPayload: *r.payload(),
+	}
+	for range r.IntN(4) {
+		var commitment common.KZGCommitment
+		copy(commitment[:], r.bytes(len(commitment)))
+		body.BlobKZGCommitments = append(body.BlobKZGCommitments, commitment)
+	}
+	return body
+}
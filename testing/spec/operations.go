@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	zcommon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/ztyp/codec"
+)
+
+// runOperation runs an operations test case, processing a single operation
+// on the pre state.
+func (p *preset) runOperation(
+	ctx context.Context, c *Case, ignored []string,
+) error {
+	switch c.Handler {
+	case "deposit":
+		return p.runDeposit(c, ignored)
+	case "withdrawals":
+		return p.runWithdrawals(ctx, c, ignored)
+	default:
+		return errors.Wrapf(ErrUnknownHandler, "%s/%s", c.Runner, c.Handler)
+	}
+}
+
+// runDeposit processes the deposit of the case. The deposit proof has no
+// counterpart in beacond, which takes the deposits from the deposit contract
+// logs, and the deposit gets the index the pre state expects next. The
+// deposit signature is verified over the genesis fork version the spec tests
+// sign with.
+func (p *preset) runDeposit(c *Case, ignored []string) error {
+	bz, err := c.readSSZ("deposit")
+	if err != nil {
+		return err
+	}
+	var dep zcommon.Deposit
+	if err = dep.Deserialize(
+		codec.NewDecodingReader(bytes.NewReader(bz), uint64(len(bz))),
+	); err != nil {
+		return err
+	}
+
+	return p.runStateCase(
+		c, ignored,
+		func(st *BeaconState, pre *deneb.BeaconState) error {
+			return p.depositSP.ProcessDeposit(st, types.NewDeposit(
+				crypto.BLSPubkey(dep.Data.Pubkey),
+				types.WithdrawalCredentials(dep.Data.WithdrawalCredentials),
+				math.Gwei(dep.Data.Amount),
+				crypto.BLSSignature(dep.Data.Signature),
+				uint64(pre.Eth1DepositIndex),
+			))
+		},
+	)
+}
+
+// runWithdrawals processes the withdrawals of the execution payload of the
+// case, carried by a Deneb block at the slot of the pre state.
+//
+// beacond withdraws to eth1 credentials only, and expects a withdrawal for
+// every validator in the sweep, of nothing if need be. For the cases the spec
+// accepts, the validators with BLS credentials are processed with eth1
+// credentials, restored afterwards, and the block carries the withdrawals
+// beacond expects, the post state telling whether they withdraw the same.
+func (p *preset) runWithdrawals(
+	ctx context.Context, c *Case, ignored []string,
+) error {
+	bz, err := c.readSSZ("execution_payload")
+	if err != nil {
+		return err
+	}
+	payload := new(types.ExecutionPayload)
+	if err = payload.UnmarshalSSZ(bz); err != nil {
+		return err
+	}
+	accepted := c.has("post")
+
+	return p.runStateCase(
+		c, ignored,
+		func(st *BeaconState, pre *deneb.BeaconState) error {
+			blk := &types.BeaconBlock{
+				Slot: math.Slot(pre.Slot),
+				Body: (&types.BeaconBlockBody{}).Empty(version.Deneb),
+			}
+			blk.Body.SetExecutionPayload(payload)
+			if !accepted {
+				return p.sp.ProcessWithdrawals(ctx, st, blk)
+			}
+
+			creds, alignErr := alignWithdrawalCredentials(st)
+			if alignErr != nil {
+				return alignErr
+			}
+			expected, alignErr := st.ExpectedWithdrawals()
+			if alignErr != nil {
+				return alignErr
+			}
+			payload.Withdrawals = expected
+			if alignErr = p.sp.ProcessWithdrawals(ctx, st, blk); alignErr != nil {
+				return alignErr
+			}
+			return restoreWithdrawalCredentials(st, creds)
+		},
+	)
+}
+
+// alignWithdrawalCredentials gives the validators with BLS withdrawal
+// credentials eth1 credentials, returning their original credentials.
+func alignWithdrawalCredentials(
+	st *BeaconState,
+) (map[math.ValidatorIndex]types.WithdrawalCredentials, error) {
+	total, err := st.GetTotalValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	creds := make(map[math.ValidatorIndex]types.WithdrawalCredentials)
+	for i := range math.ValidatorIndex(total) {
+		val, valErr := st.ValidatorByIndex(i)
+		if valErr != nil {
+			return nil, valErr
+		}
+		if val.HasEth1WithdrawalCredentials() {
+			continue
+		}
+		creds[i] = val.WithdrawalCredentials
+		val.WithdrawalCredentials[0] = types.EthSecp256k1CredentialPrefix
+		if err = st.UpdateValidatorAtIndex(i, val); err != nil {
+			return nil, err
+		}
+	}
+	return creds, nil
+}
+
+// restoreWithdrawalCredentials sets back the credentials of the validators.
+func restoreWithdrawalCredentials(
+	st *BeaconState,
+	creds map[math.ValidatorIndex]types.WithdrawalCredentials,
+) error {
+	for i, cred := range creds {
+		val, err := st.ValidatorByIndex(i)
+		if err != nil {
+			return err
+		}
+		val.WithdrawalCredentials = cred
+		if err = st.UpdateValidatorAtIndex(i, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/golang/snappy"
+	zcommon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/zrnt/eth2/configs"
+	"github.com/protolambda/ztyp/codec"
+	"gopkg.in/yaml.v3"
+)
+
+// Fork is the fork the spec tests are run for.
+const Fork = "deneb"
+
+// Case is a test case of the consensus-spec tests, laid out as
+// tests/<preset>/<fork>/<runner>/<handler>/<suite>/<case>.
+type Case struct {
+	Preset  string
+	Runner  string
+	Handler string
+	Suite   string
+	Name    string
+	// Dir is the directory holding the files of the case.
+	Dir string
+}
+
+// ID returns the path of the case without its fork, which divergences are
+// matched against.
+func (c *Case) ID() string {
+	return path.Join(c.Preset, c.Runner, c.Handler, c.Suite, c.Name)
+}
+
+// Cases returns the test cases of the fork found in the directory of the
+// consensus-spec tests, sorted by ID.
+func Cases(dir string) ([]*Case, error) {
+	matches, err := filepath.Glob(
+		filepath.Join(dir, "tests", "*", Fork, "*", "*", "*", "*"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cases := make([]*Case, 0, len(matches))
+	for _, match := range matches {
+		if info, statErr := os.Stat(match); statErr != nil || !info.IsDir() {
+			continue
+		}
+		rel, relErr := filepath.Rel(dir, match)
+		if relErr != nil {
+			return nil, relErr
+		}
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		cases = append(cases, &Case{
+			Preset:  parts[1],
+			Runner:  parts[3],
+			Handler: parts[4],
+			Suite:   parts[5],
+			Name:    parts[6],
+			Dir:     match,
+		})
+	}
+
+	sort.Slice(cases, func(i, j int) bool {
+		return cases[i].ID() < cases[j].ID()
+	})
+	return cases, nil
+}
+
+// readSSZ returns the decompressed SSZ encoding of the file of the case.
+// Missing files are reported with an error wrapping os.ErrNotExist.
+func (c *Case) readSSZ(name string) ([]byte, error) {
+	bz, err := os.ReadFile(filepath.Join(c.Dir, name+".ssz_snappy"))
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Decode(nil, bz)
+}
+
+// has reports whether the case has the SSZ file.
+func (c *Case) has(name string) bool {
+	_, err := os.Stat(filepath.Join(c.Dir, name+".ssz_snappy"))
+	return err == nil
+}
+
+// readYAML decodes the YAML file of the case into the value.
+func (c *Case) readYAML(name string, v any) error {
+	bz, err := os.ReadFile(filepath.Join(c.Dir, name+".yaml"))
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(bz, v)
+}
+
+// Runner runs the consensus-spec test cases against the consensus types and
+// the state processor of beacond.
+type Runner struct {
+	presets map[string]*preset
+}
+
+// NewRunner returns a new runner.
+func NewRunner() *Runner {
+	return &Runner{presets: make(map[string]*preset)}
+}
+
+// Run runs the case. The listed beacon state fields are left out when
+// comparing the post states.
+func (r *Runner) Run(ctx context.Context, c *Case, ignored []string) error {
+	if c.Runner == "ssz_static" {
+		return runSSZStatic(c)
+	}
+
+	p, err := r.preset(c.Preset)
+	if err != nil {
+		return err
+	}
+	switch c.Runner {
+	case "operations":
+		return p.runOperation(ctx, c, ignored)
+	case "epoch_processing":
+		return p.runEpochProcessing(c, ignored)
+	case "sanity":
+		return p.runSanity(c, ignored)
+	default:
+		return errors.Wrapf(ErrUnknownHandler, "%s/%s", c.Runner, c.Handler)
+	}
+}
+
+// preset returns the specs and the state processor of the preset.
+func (r *Runner) preset(name string) (*preset, error) {
+	if p, ok := r.presets[name]; ok {
+		return p, nil
+	}
+
+	var zspec *zcommon.Spec
+	switch name {
+	case "minimal":
+		zspec = configs.Minimal
+	case "mainnet":
+		zspec = configs.Mainnet
+	default:
+		return nil, errors.Wrapf(ErrUnknownPreset, "%q", name)
+	}
+
+	cs := chainSpecForPreset(zspec)
+	p := &preset{
+		spec: zspec,
+		cs:   cs,
+		sp:   newStateProcessor(cs, verifier{}),
+		depositSP: newStateProcessor(
+			depositChainSpecForPreset(cs, zspec), verifier{},
+		),
+	}
+	r.presets[name] = p
+	return p, nil
+}
+
+// preset holds the specs of a preset and the state processors running them.
+type preset struct {
+	spec *zcommon.Spec
+	cs   common.ChainSpec
+	sp   *StateProcessor
+	// depositSP processes the deposits, verified over the deposit domain of
+	// the spec tests.
+	depositSP *StateProcessor
+}
+
+// runStateCase runs a state test case: the case is run on the pre state and
+// must fail if the case has no post state, or match the post state
+// otherwise.
+func (p *preset) runStateCase(
+	c *Case,
+	ignored []string,
+	run func(st *BeaconState, pre *deneb.BeaconState) error,
+) error {
+	pre, err := p.readState(c, "pre")
+	if err != nil {
+		return err
+	}
+	st, err := loadBeaconState(p.cs, p.spec, pre)
+	if err != nil {
+		return err
+	}
+	runErr := run(st, pre)
+
+	post, err := p.readState(c, "post")
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if runErr == nil {
+			return ErrUnexpectedSuccess
+		}
+		return nil
+	case err != nil:
+		return err
+	case runErr != nil:
+		return runErr
+	}
+
+	expected, err := convertBeaconState(p.spec, post)
+	if err != nil {
+		return err
+	}
+	actual, err := st.GetMarshallable()
+	if err != nil {
+		return err
+	}
+	if diff := diffBeaconStates(expected, actual, ignored); len(diff) > 0 {
+		return errors.Wrapf(ErrStateMismatch, "fields %v", diff)
+	}
+	return nil
+}
+
+// readState returns the Deneb state of the file of the case.
+func (p *preset) readState(c *Case, name string) (*deneb.BeaconState, error) {
+	bz, err := c.readSSZ(name)
+	if err != nil {
+		return nil, err
+	}
+	st := new(deneb.BeaconState)
+	return st, st.Deserialize(
+		p.spec, codec.NewDecodingReader(bytes.NewReader(bz), uint64(len(bz))),
+	)
+}
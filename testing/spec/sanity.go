@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+)
+
+// runSanity runs a sanity test case.
+func (p *preset) runSanity(c *Case, ignored []string) error {
+	if c.Handler != "slots" {
+		return errors.Wrapf(ErrUnknownHandler, "%s/%s", c.Runner, c.Handler)
+	}
+
+	var slots uint64
+	if err := c.readYAML("slots", &slots); err != nil {
+		return err
+	}
+	return p.runStateCase(
+		c, ignored,
+		func(st *BeaconState, pre *deneb.BeaconState) error {
+			_, err := p.sp.ProcessSlots(
+				st, math.Slot(uint64(pre.Slot)+slots),
+			)
+			return err
+		},
+	)
+}
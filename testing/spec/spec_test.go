@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/testing/spec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConformance runs the consensus-spec tests of testdata, or of the
+// checkout of ethereum/consensus-spec-tests pointed to by
+// CONSENSUS_SPEC_TESTS_DIR.
+func TestConformance(t *testing.T) {
+	dir := os.Getenv("CONSENSUS_SPEC_TESTS_DIR")
+	if dir == "" {
+		dir = "testdata"
+	}
+	cases, err := spec.Cases(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, cases, "no spec tests found in %s", dir)
+
+	runner := spec.NewRunner()
+	for _, c := range cases {
+		t.Run(c.ID(), func(t *testing.T) {
+			div := spec.MatchDivergence(c)
+			var ignored []string
+			if div != nil {
+				ignored = div.Fields
+			}
+
+			err := runner.Run(context.Background(), c, ignored)
+			switch {
+			case errors.IsAny(
+				err, spec.ErrUnknownHandler, spec.ErrUnknownType,
+			):
+				t.Skip(err)
+			case div == nil || len(div.Fields) > 0:
+				require.NoError(t, err)
+			default:
+				require.Error(
+					t, err, "stale divergence %q: %s", div.Pattern, div.Reason,
+				)
+			}
+		})
+	}
+}
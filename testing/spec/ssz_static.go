@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// sszObject is an SSZ type of beacond.
+type sszObject interface {
+	MarshalSSZ() ([]byte, error)
+	HashTreeRoot() common.Root
+}
+
+// sszTypes decodes the SSZ encoding of the spec types into their beacond
+// counterparts.
+//
+//nolint:gochecknoglobals // read-only registry.
+var sszTypes = map[string]func(bz []byte) (sszObject, error){
+	"Fork":                   decodeSSZ[types.Fork],
+	"ForkData":               decodeSSZ[types.ForkData],
+	"BeaconBlockHeader":      decodeSSZ[types.BeaconBlockHeader],
+	"Eth1Data":               decodeSSZ[types.Eth1Data],
+	"Validator":              decodeSSZ[types.Validator],
+	"DepositMessage":         decodeSSZ[types.DepositMessage],
+	"SigningData":            decodeSSZ[types.SigningData],
+	"AttestationData":        decodeSSZ[types.AttestationData],
+	"ExecutionPayload":       decodeSSZ[types.ExecutionPayload],
+	"ExecutionPayloadHeader": decodeSSZ[types.ExecutionPayloadHeader],
+	"Withdrawal":             decodeSSZ[engineprimitives.Withdrawal],
+	"HistoricalSummary":      decodeSSZ[common.HistoricalSummary],
+	"BeaconState":            decodeSSZ[BeaconStateMarshallable],
+	"BeaconBlockBody": func(bz []byte) (sszObject, error) {
+		body := (&types.BeaconBlockBody{}).Empty(version.Deneb)
+		return body, body.UnmarshalSSZ(bz)
+	},
+	"BeaconBlock": func(bz []byte) (sszObject, error) {
+		return (&types.BeaconBlock{}).NewFromSSZ(bz, version.Deneb)
+	},
+}
+
+// decodeSSZ decodes the SSZ encoding into a new value of the type.
+func decodeSSZ[T any, PT interface {
+	*T
+	sszObject
+	UnmarshalSSZ(bz []byte) error
+}](bz []byte) (sszObject, error) {
+	obj := PT(new(T))
+	return obj, obj.UnmarshalSSZ(bz)
+}
+
+// runSSZStatic runs an ssz_static test case: the serialized value must
+// decode into the beacond type, encode back to the same bytes and have the
+// same hash tree root.
+func runSSZStatic(c *Case) error {
+	decode, ok := sszTypes[c.Handler]
+	if !ok {
+		return errors.Wrapf(ErrUnknownType, "%q", c.Handler)
+	}
+
+	bz, err := c.readSSZ("serialized")
+	if err != nil {
+		return err
+	}
+	var roots struct {
+		Root string `yaml:"root"`
+	}
+	if err = c.readYAML("roots", &roots); err != nil {
+		return err
+	}
+	var expectedRoot common.Root
+	if err = expectedRoot.UnmarshalText([]byte(roots.Root)); err != nil {
+		return err
+	}
+
+	obj, err := decode(bz)
+	if err != nil {
+		return err
+	}
+	encoded, err := obj.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(encoded, bz) {
+		return ErrEncodingMismatch
+	}
+	if root := obj.HashTreeRoot(); root != expectedRoot {
+		return errors.Wrapf(
+			ErrRootMismatch, "expected %s, got %s", expectedRoot, root,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,366 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	zcommon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/ztyp/codec"
+)
+
+// newStateProcessor returns the state processor of beacond, without an
+// execution engine as the spec tests do not run blocks.
+func newStateProcessor(
+	cs common.ChainSpec,
+	signer crypto.BLSSigner,
+) *StateProcessor {
+	return core.NewStateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*BeaconState,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*KVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	](cs, nil, signer)
+}
+
+// newBeaconState returns an empty beacon state backed by an in-memory store.
+func newBeaconState(cs common.ChainSpec) (*BeaconState, error) {
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
+	if err != nil {
+		return nil, err
+	}
+	storeKey := storetypes.NewKVStoreKey("beacon")
+	cms := store.NewCommitMultiStore(
+		memDB, log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	kv := beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](
+		components.NewKVStoreService(storeKey),
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	).WithContext(sdk.NewContext(cms, true, log.NewNopLogger()))
+	return (&BeaconState{}).NewFromDB(kv, cs), nil
+}
+
+// loadBeaconState returns a beacon state holding the Deneb state of the spec
+// tests.
+func loadBeaconState(
+	cs common.ChainSpec,
+	zspec *zcommon.Spec,
+	zst *deneb.BeaconState,
+) (*BeaconState, error) {
+	cp, err := convertBeaconState(zspec, zst)
+	if err != nil {
+		return nil, err
+	}
+	st, err := newBeaconState(cs)
+	if err != nil {
+		return nil, err
+	}
+	return st, seedBeaconState(st, cp)
+}
+
+// convertBeaconState converts the Deneb state of the spec tests into the
+// beacon state of beacond, dropping the fields the latter does not have.
+//
+//nolint:funlen // one conversion per field.
+func convertBeaconState(
+	zspec *zcommon.Spec,
+	zst *deneb.BeaconState,
+) (*BeaconStateMarshallable, error) {
+	st := &BeaconStateMarshallable{
+		GenesisValidatorsRoot: common.Root(zst.GenesisValidatorsRoot),
+		Slot:                  math.Slot(zst.Slot),
+		Fork:                  &types.Fork{},
+		LatestBlockHeader:     &types.BeaconBlockHeader{},
+		Eth1Data:              &types.Eth1Data{},
+		Eth1DepositIndex:      uint64(zst.Eth1DepositIndex),
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			ExtraData: []byte{},
+		},
+		Validators:          make([]*types.Validator, 0),
+		Balances:            make([]uint64, 0),
+		NextWithdrawalIndex: uint64(zst.NextWithdrawalIndex),
+		NextWithdrawalValidatorIndex: math.ValidatorIndex(
+			zst.NextWithdrawalValidatorIndex,
+		),
+		HistoricalSummaries: make([]*common.HistoricalSummary, 0),
+	}
+
+	if err := fromZrnt(zspec, st.Fork, &zst.Fork); err != nil {
+		return nil, err
+	}
+	if err := fromZrnt(
+		zspec, st.LatestBlockHeader, &zst.LatestBlockHeader,
+	); err != nil {
+		return nil, err
+	}
+	if err := fromZrnt(zspec, st.Eth1Data, &zst.Eth1Data); err != nil {
+		return nil, err
+	}
+	if err := fromZrnt(
+		zspec, st.LatestExecutionPayloadHeader,
+		&zst.LatestExecutionPayloadHeader,
+	); err != nil {
+		return nil, err
+	}
+
+	for _, root := range zst.BlockRoots {
+		st.BlockRoots = append(st.BlockRoots, common.Root(root))
+	}
+	for _, root := range zst.StateRoots {
+		st.StateRoots = append(st.StateRoots, common.Root(root))
+	}
+	for _, zval := range zst.Validators {
+		val := &types.Validator{}
+		if err := fromZrnt(zspec, val, zval); err != nil {
+			return nil, err
+		}
+		st.Validators = append(st.Validators, val)
+	}
+	for _, balance := range zst.Balances {
+		st.Balances = append(st.Balances, uint64(balance))
+	}
+	for _, mix := range zst.RandaoMixes {
+		st.RandaoMixes = append(st.RandaoMixes, common.Bytes32(mix))
+	}
+	// The total slashing is tracked by beacond only.
+	for _, slashing := range zst.Slashings {
+		st.Slashings = append(st.Slashings, math.Gwei(slashing))
+		st.TotalSlashing += math.Gwei(slashing)
+	}
+	for i := range zst.HistoricalSummaries {
+		summary := &common.HistoricalSummary{}
+		if err := fromZrnt(
+			zspec, summary, &zst.HistoricalSummaries[i],
+		); err != nil {
+			return nil, err
+		}
+		st.HistoricalSummaries = append(st.HistoricalSummaries, summary)
+	}
+	return st, nil
+}
+
+// seedBeaconState writes every field of the marshallable state into the
+// beacon state.
+//
+//nolint:gocognit // one check per field.
+func seedBeaconState(st *BeaconState, cp *BeaconStateMarshallable) error {
+	if err := st.SetGenesisValidatorsRoot(
+		cp.GenesisValidatorsRoot,
+	); err != nil {
+		return err
+	}
+	if err := st.SetSlot(cp.Slot); err != nil {
+		return err
+	}
+	if err := st.SetFork(cp.Fork); err != nil {
+		return err
+	}
+	if err := st.SetLatestBlockHeader(cp.LatestBlockHeader); err != nil {
+		return err
+	}
+	for i, root := range cp.BlockRoots {
+		//#nosec:G115 // index is always positive.
+		if err := st.UpdateBlockRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	for i, root := range cp.StateRoots {
+		//#nosec:G115 // index is always positive.
+		if err := st.UpdateStateRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	if err := st.SetEth1Data(cp.Eth1Data); err != nil {
+		return err
+	}
+	if err := st.SetEth1DepositIndex(cp.Eth1DepositIndex); err != nil {
+		return err
+	}
+	if err := st.SetLatestExecutionPayloadHeader(
+		cp.LatestExecutionPayloadHeader,
+	); err != nil {
+		return err
+	}
+	for i, val := range cp.Validators {
+		if err := st.AddValidator(val); err != nil {
+			return err
+		}
+		if err := st.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(cp.Balances[i]),
+		); err != nil {
+			return err
+		}
+	}
+	for i, mix := range cp.RandaoMixes {
+		//#nosec:G115 // index is always positive.
+		if err := st.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return err
+		}
+	}
+	if err := st.SetNextWithdrawalIndex(cp.NextWithdrawalIndex); err != nil {
+		return err
+	}
+	if err := st.SetNextWithdrawalValidatorIndex(
+		cp.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return err
+	}
+	for i, amount := range cp.Slashings {
+		//#nosec:G115 // index is always positive.
+		if err := st.SetSlashingAtIndex(uint64(i), amount); err != nil {
+			return err
+		}
+	}
+	// The spec tests do not look the historical summaries up by slot.
+	for _, summary := range cp.HistoricalSummaries {
+		if err := st.AddHistoricalSummary(summary, 0); err != nil {
+			return err
+		}
+	}
+	return st.SetTotalSlashing(cp.TotalSlashing)
+}
+
+// diffBeaconStates returns the JSON names of the fields of the states that
+// differ, skipping the ignored ones.
+func diffBeaconStates(
+	expected, actual *BeaconStateMarshallable,
+	ignored []string,
+) []string {
+	var (
+		diff []string
+		ev   = reflect.ValueOf(expected).Elem()
+		av   = reflect.ValueOf(actual).Elem()
+	)
+	for i := range ev.NumField() {
+		// The layout of the states is not part of their content.
+		if !ev.Type().Field(i).IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(ev.Type().Field(i).Tag.Get("json"), ",")
+		if contains(ignored, name) {
+			continue
+		}
+		if !equalField(ev.Field(i), av.Field(i)) {
+			diff = append(diff, name)
+		}
+	}
+	return diff
+}
+
+// equalField returns true if the fields are equal, treating nil and empty
+// slices alike.
+func equalField(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Slice && a.Len() == 0 && b.Len() == 0 {
+		return true
+	}
+	// Containers compare by their hash tree root so that values with the
+	// same encoding, e.g. nil and empty byte lists, are equal.
+	if ar, ok := a.Interface().(interface{ HashTreeRoot() common.Root }); ok {
+		br, bok := b.Interface().(interface{ HashTreeRoot() common.Root })
+		return bok && ar.HashTreeRoot() == br.HashTreeRoot()
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// fromZrnt converts a zrnt value into its beacond counterpart through their
+// SSZ encoding.
+func fromZrnt(
+	zspec *zcommon.Spec,
+	dst interface{ UnmarshalSSZ([]byte) error },
+	src any,
+) error {
+	var (
+		buf bytes.Buffer
+		err error
+		w   = codec.NewEncodingWriter(&buf)
+	)
+	switch src := src.(type) {
+	case zcommon.SpecObj:
+		err = src.Serialize(zspec, w)
+	case interface {
+		Serialize(w *codec.EncodingWriter) error
+	}:
+		err = src.Serialize(w)
+	default:
+		return ErrNotSerializable
+	}
+	if err != nil {
+		return err
+	}
+	return dst.UnmarshalSSZ(buf.Bytes())
+}
+
+// contains returns true if the name is in the list.
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build conformance
+
+package spec
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	blsu "github.com/protolambda/bls12-381-util"
+)
+
+// verifier verifies BLS signatures with the pure Go implementation used by
+// zrnt, so that the spec tests run without the bls12381 build tag beacond
+// needs for its signer. It does not sign.
+type verifier struct{}
+
+// PublicKey returns an empty public key.
+func (verifier) PublicKey() crypto.BLSPubkey {
+	return crypto.BLSPubkey{}
+}
+
+// Sign always fails, the spec tests only verify signatures.
+func (verifier) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrSigningUnsupported
+}
+
+// VerifySignature verifies the signature of the message by the public key.
+func (verifier) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	var (
+		pk  blsu.Pubkey
+		sig blsu.Signature
+	)
+	pkBytes, sigBytes := [48]byte(pubKey), [96]byte(signature)
+	if err := pk.Deserialize(&pkBytes); err != nil {
+		return err
+	}
+	if err := sig.Deserialize(&sigBytes); err != nil {
+		return err
+	}
+	if !blsu.Verify(&pk, msg, &sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}